@@ -5,6 +5,7 @@ import (
 	"instagram-go/domain"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -40,10 +41,89 @@ func (ch *CommentHandler) Comment(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (ch *CommentHandler) CommentReplies(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ch.getCommentReplies(w, r)
+		return
+	}
+}
+
+func (ch *CommentHandler) CommentRevisions(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ch.getCommentRevisions(w, r)
+		return
+	}
+}
+
+func (ch *CommentHandler) CommentPin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		ch.putCommentPin(w, r)
+		return
+	case "DELETE":
+		ch.deleteCommentPin(w, r)
+		return
+	}
+}
+
+func (ch *CommentHandler) CommentHide(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		ch.putCommentHide(w, r)
+		return
+	case "DELETE":
+		ch.deleteCommentHide(w, r)
+		return
+	}
+}
+
+func (ch *CommentHandler) CommentRestore(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		ch.postCommentRestore(w, r)
+		return
+	}
+}
+
+func (ch *CommentHandler) UserDeletedComments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ch.getUserDeletedComments(w, r)
+		return
+	}
+}
+
 func (ch *CommentHandler) getComments(w http.ResponseWriter, r *http.Request) {
-	urlParts := strings.Split(r.URL.String(), "/")
+	urlParts := strings.Split(r.URL.Path, "/")
 	postId := urlParts[2]
-	comments, err := ch.commentUsecase.FindComments(postId)
+
+	query := r.URL.Query()
+	sort := query.Get("sort")
+	if sort == "" {
+		sort = domain.CommentSortOldest
+	}
+	limit := domain.DefaultPageLimit
+	var err error
+	if limitQuery := query.Get("limit"); limitQuery != "" {
+		limit, err = strconv.Atoi(limitQuery)
+	}
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	tokenString := r.Header.Get("Authorization")
+	comments, nextCursor, err := ch.commentUsecase.FindComments(r.Context(), postId, sort, query.Get("cursor"), limit, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -57,6 +137,7 @@ func (ch *CommentHandler) getComments(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	dataComments := domain.NewDataComments(comments)
+	dataComments.NextCursor = nextCursor
 	response := domain.NewDataResponseComments(dataComments)
 	responseBytes, errMarshal := json.Marshal(response)
 	if errMarshal != nil {
@@ -68,6 +149,81 @@ func (ch *CommentHandler) getComments(w http.ResponseWriter, r *http.Request) {
 	w.Write(responseBytes)
 }
 
+func (ch *CommentHandler) getCommentReplies(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	postId := urlParts[2]
+	commentId := urlParts[4]
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	tokenString := r.Header.Get("Authorization")
+	replies, err := ch.commentUsecase.FindCommentReplies(r.Context(), postId, commentId, page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataComments := domain.NewDataComments(replies)
+	response := domain.NewDataResponseComments(dataComments)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ch *CommentHandler) getCommentRevisions(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	postId := urlParts[2]
+	commentId := urlParts[4]
+	tokenString := r.Header.Get("Authorization")
+	revisions, err := ch.commentUsecase.FindCommentRevisions(r.Context(), postId, commentId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataRevisions := domain.NewDataCommentRevisions(revisions)
+	response := domain.NewDataResponseCommentRevisions(dataRevisions)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
 func (ch *CommentHandler) postComment(w http.ResponseWriter, r *http.Request) {
 	tokenString := r.Header.Get("Authorization")
 	urlParts := strings.Split(r.URL.String(), "/")
@@ -99,7 +255,7 @@ func (ch *CommentHandler) postComment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	comment.PostId = postId
-	err = ch.commentUsecase.PostComment(&comment, tokenString)
+	err = ch.commentUsecase.PostComment(r.Context(), &comment, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -168,7 +324,7 @@ func (ch *CommentHandler) putComment(w http.ResponseWriter, r *http.Request) {
 		w.Write(responseBytes)
 		return
 	}
-	err = ch.commentUsecase.PutComment(&comment, tokenString)
+	err = ch.commentUsecase.PutComment(r.Context(), &comment, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -196,7 +352,7 @@ func (ch *CommentHandler) deleteComment(w http.ResponseWriter, r *http.Request)
 	urlParts := strings.Split(r.URL.String(), "/")
 	commentId := urlParts[4]
 	tokenString := r.Header.Get("Authorization")
-	err := ch.commentUsecase.DeleteComment(commentId, tokenString)
+	err := ch.commentUsecase.DeleteComment(r.Context(), commentId, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -221,16 +377,201 @@ func (ch *CommentHandler) deleteComment(w http.ResponseWriter, r *http.Request)
 	w.Write(responseBytes)
 }
 
+func (ch *CommentHandler) putCommentPin(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	commentId := urlParts[4]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ch.commentUsecase.PinComment(r.Context(), commentId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Comment successfully Pinned")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ch *CommentHandler) deleteCommentPin(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	commentId := urlParts[4]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ch.commentUsecase.UnpinComment(r.Context(), commentId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Comment successfully Unpinned")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ch *CommentHandler) putCommentHide(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	commentId := urlParts[4]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ch.commentUsecase.HideComment(r.Context(), commentId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Comment successfully Hidden")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ch *CommentHandler) deleteCommentHide(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	commentId := urlParts[4]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ch.commentUsecase.UnhideComment(r.Context(), commentId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Comment successfully Unhidden")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ch *CommentHandler) postCommentRestore(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	commentId := urlParts[4]
+	tokenString := r.Header.Get("Authorization")
+	err := ch.commentUsecase.RestoreComment(r.Context(), commentId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Comment successfully Restored")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ch *CommentHandler) getUserDeletedComments(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+	comments, err := ch.commentUsecase.FindDeletedComments(r.Context(), userId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(commentGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataComments := domain.NewDataComments(comments)
+	response := domain.NewDataResponseComments(dataComments)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
 func commentGetStatusCode(err error) int {
 	switch err {
 	case domain.ErrInternalServerError:
 		return http.StatusInternalServerError
 	case domain.ErrPostNotFound, domain.ErrCommentNotFound:
 		return http.StatusNotFound
-	case domain.ErrUnauthorizedCommentUpdate, domain.ErrUnauthorizedCommentDelete:
+	case domain.ErrUnauthorizedCommentUpdate, domain.ErrUnauthorizedCommentDelete, domain.ErrUnauthorizedCommentRestore,
+		domain.ErrUnauthorizedCommentRevisions, domain.ErrUnauthorizedCommentModerate, domain.ErrUnauthorizedTrashView:
 		return http.StatusUnauthorized
-	case domain.ErrMissingCommentInput:
+	case domain.ErrMissingCommentInput, domain.ErrInvalidPagination, domain.ErrInvalidCommentSort, domain.ErrInvalidCursor,
+		domain.ErrReplyPin, domain.ErrHiddenCommentPin:
 		return http.StatusBadRequest
+	case domain.ErrCommentNotDeleted, domain.ErrCommentParentDeleted, domain.ErrCommentPostDeleted, domain.ErrCommentPinConflict, domain.ErrCommentNotPinned,
+		domain.ErrCommentHideConflict, domain.ErrCommentNotHidden:
+		return http.StatusConflict
+	case domain.ErrPostCommentsDisabled, domain.ErrCommentRejected:
+		return http.StatusForbidden
 	}
 	return http.StatusOK
 }
@@ -29,7 +29,7 @@ func (ch *CommentHandlerSuite) SetupTest() {
 }
 
 func (ch *CommentHandlerSuite) TestGetCommentsFindCommentsError() {
-	ch.commentUsecase.On("FindComments", mock.AnythingOfType("string")).Return(nil, domain.ErrInternalServerError)
+	ch.commentUsecase.On("FindComments", mock.Anything, "postid1", domain.CommentSortOldest, "", domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, "", domain.ErrInternalServerError)
 	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
 	req, _ := http.NewRequest("GET", "/posts/postid1/comments", nil)
 	rr := httptest.NewRecorder()
@@ -41,8 +41,31 @@ func (ch *CommentHandlerSuite) TestGetCommentsFindCommentsError() {
 	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
 
+func (ch *CommentHandlerSuite) TestGetCommentsInvalidLimit() {
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/comments?limit=abc", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.Comments)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPagination.Error() + `"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestGetCommentsInvalidSort() {
+	ch.commentUsecase.On("FindComments", mock.Anything, "postid1", "popular", "", domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, "", domain.ErrInvalidCommentSort)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/comments?sort=popular", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.Comments)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+}
+
 func (ch *CommentHandlerSuite) TestGetCommentsSuccessful() {
-	ch.commentUsecase.On("FindComments", mock.AnythingOfType("string")).Return(&[]domain.Comment{}, nil)
+	ch.commentUsecase.On("FindComments", mock.Anything, "postid1", domain.CommentSortOldest, "", domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(&[]domain.Comment{}, "", nil)
 	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
 	req, _ := http.NewRequest("GET", "/posts/postid1/comments", nil)
 	rr := httptest.NewRecorder()
@@ -50,6 +73,21 @@ func (ch *CommentHandlerSuite) TestGetCommentsSuccessful() {
 	handler.ServeHTTP(rr, req)
 
 	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %s but got %s", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"comments":[]}}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestGetCommentsNextPageSuccessful() {
+	ch.commentUsecase.On("FindComments", mock.Anything, "postid1", domain.CommentSortTop, "cursor1", 10, mock.AnythingOfType("string")).Return(&[]domain.Comment{}, "cursor2", nil)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/comments?sort=top&cursor=cursor1&limit=10", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.Comments)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"comments":[],"next_cursor":"cursor2"}}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
 
 func (ch *CommentHandlerSuite) TestPostCommentCommentNotProvided() {
@@ -71,7 +109,7 @@ func (ch *CommentHandlerSuite) TestPostCommentPostCommentError() {
 	requestBody, _ := json.Marshal(map[string]string{
 		"comment": "a new comment",
 	})
-	ch.commentUsecase.On("PostComment", mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	ch.commentUsecase.On("PostComment", mock.Anything, mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
 	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
 	req, _ := http.NewRequest("POST", "/posts/postid1/comments", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
@@ -83,12 +121,28 @@ func (ch *CommentHandlerSuite) TestPostCommentPostCommentError() {
 	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
 
+func (ch *CommentHandlerSuite) TestPostCommentPostCommentsDisabled() {
+	requestBody, _ := json.Marshal(map[string]string{
+		"comment": "a new comment",
+	})
+	ch.commentUsecase.On("PostComment", mock.Anything, mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(domain.ErrPostCommentsDisabled)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("POST", "/posts/postid1/comments", bytes.NewBuffer(requestBody))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.Comments)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusForbidden, rr.Code, "Should have responded with http status code %v but got %v", http.StatusForbidden, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrPostCommentsDisabled.Error() + `"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
 func (ch *CommentHandlerSuite) TestPostCommentSuccessful() {
 	requestBody, _ := json.Marshal(map[string]string{
 		"comment": "a new comment",
 	})
 
-	ch.commentUsecase.On("PostComment", mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(nil)
+	ch.commentUsecase.On("PostComment", mock.Anything, mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(nil)
 	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
 	req, _ := http.NewRequest("POST", "/posts/postid1/comments", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
@@ -120,7 +174,7 @@ func (ch *CommentHandlerSuite) TestPutCommentPutCommentError() {
 	requestBody, _ := json.Marshal(map[string]string{
 		"comment": "a new comment",
 	})
-	ch.commentUsecase.On("PutComment", mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	ch.commentUsecase.On("PutComment", mock.Anything, mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
 	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
 	req, _ := http.NewRequest("PUT", "/posts/postid1/comments/commentid1", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
@@ -136,7 +190,7 @@ func (ch *CommentHandlerSuite) TestPutCommentSuccessful() {
 	requestBody, _ := json.Marshal(map[string]string{
 		"comment": "a new comment",
 	})
-	ch.commentUsecase.On("PutComment", mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(nil)
+	ch.commentUsecase.On("PutComment", mock.Anything, mock.AnythingOfType("*domain.Comment"), mock.AnythingOfType("string")).Return(nil)
 	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
 	req, _ := http.NewRequest("PUT", "/posts/postid1/comments/commentid1", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
@@ -159,7 +213,7 @@ func (dcs *DeleteCommentSuite) SetupTest() {
 }
 
 func (ch *CommentHandlerSuite) TestDeleteCommentDeleteCommentError() {
-	ch.commentUsecase.On("DeleteComment", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	ch.commentUsecase.On("DeleteComment", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
 	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
 	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1", nil)
 	rr := httptest.NewRecorder()
@@ -172,7 +226,7 @@ func (ch *CommentHandlerSuite) TestDeleteCommentDeleteCommentError() {
 }
 
 func (ch *DeleteCommentSuite) TestDeleteCommentSuccessful() {
-	ch.commentUsecase.On("DeleteComment", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	ch.commentUsecase.On("DeleteComment", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
 	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1", nil)
 	rr := httptest.NewRecorder()
@@ -183,3 +237,172 @@ func (ch *DeleteCommentSuite) TestDeleteCommentSuccessful() {
 	expectedBody := `{"message":"Comment successfully Deleted"}`
 	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
+
+func (ch *CommentHandlerSuite) TestPostCommentRestoreCommentNotDeleted() {
+	ch.commentUsecase.On("RestoreComment", mock.Anything, "commentid1", mock.AnythingOfType("string")).Return(domain.ErrCommentNotDeleted)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("POST", "/posts/postid1/comments/commentid1/restore", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentRestore)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusConflict, rr.Code, "Should have responded with http status code %v but got %v", http.StatusConflict, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrCommentNotDeleted.Error() + `"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestPostCommentRestoreSuccessful() {
+	ch.commentUsecase.On("RestoreComment", mock.Anything, "commentid1", mock.AnythingOfType("string")).Return(nil)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("POST", "/posts/postid1/comments/commentid1/restore", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentRestore)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Comment successfully Restored"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestGetUserDeletedCommentsUnauthorizedTrashView() {
+	ch.commentUsecase.On("FindDeletedComments", mock.Anything, "userid1", mock.AnythingOfType("string")).Return(nil, domain.ErrUnauthorizedTrashView)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/users/userid1/recently-deleted/comments", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.UserDeletedComments)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnauthorizedTrashView.Error() + `"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestGetUserDeletedCommentsSuccessful() {
+	ch.commentUsecase.On("FindDeletedComments", mock.Anything, "userid1", mock.AnythingOfType("string")).Return(&[]domain.Comment{}, nil)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/users/userid1/recently-deleted/comments", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.UserDeletedComments)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+}
+
+func (ch *CommentHandlerSuite) TestGetCommentRevisionsUnauthorized() {
+	ch.commentUsecase.On("FindCommentRevisions", mock.Anything, "postid1", "commentid1", "token1").Return(nil, domain.ErrUnauthorizedCommentRevisions)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/comments/commentid1/revisions", nil)
+	req.Header.Set("Authorization", "token1")
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentRevisions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnauthorizedCommentRevisions.Error() + `"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestGetCommentRevisionsSuccessful() {
+	ch.commentUsecase.On("FindCommentRevisions", mock.Anything, "postid1", "commentid1", "token1").Return(&[]domain.CommentRevision{}, nil)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/comments/commentid1/revisions", nil)
+	req.Header.Set("Authorization", "token1")
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentRevisions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"revisions":[]}}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestPutCommentPinUnauthorized() {
+	ch.commentUsecase.On("PinComment", mock.Anything, "commentid1", "token1").Return(domain.ErrUnauthorizedCommentModerate)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("PUT", "/posts/postid1/comments/commentid1/pin", nil)
+	req.Header.Set("Authorization", "token1")
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentPin)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+}
+
+func (ch *CommentHandlerSuite) TestPutCommentPinSuccessful() {
+	ch.commentUsecase.On("PinComment", mock.Anything, "commentid1", "token1").Return(nil)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("PUT", "/posts/postid1/comments/commentid1/pin", nil)
+	req.Header.Set("Authorization", "token1")
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentPin)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Comment successfully Pinned"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestDeleteCommentPinNotPinned() {
+	ch.commentUsecase.On("UnpinComment", mock.Anything, "commentid1", "token1").Return(domain.ErrCommentNotPinned)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/pin", nil)
+	req.Header.Set("Authorization", "token1")
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentPin)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusConflict, rr.Code, "Should have responded with http status code %v but got %v", http.StatusConflict, rr.Code)
+}
+
+func (ch *CommentHandlerSuite) TestPutCommentHideSuccessful() {
+	ch.commentUsecase.On("HideComment", mock.Anything, "commentid1", "token1").Return(nil)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("PUT", "/posts/postid1/comments/commentid1/hide", nil)
+	req.Header.Set("Authorization", "token1")
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentHide)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Comment successfully Hidden"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestDeleteCommentHideSuccessful() {
+	ch.commentUsecase.On("UnhideComment", mock.Anything, "commentid1", "token1").Return(nil)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/hide", nil)
+	req.Header.Set("Authorization", "token1")
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentHide)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Comment successfully Unhidden"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestGetCommentRepliesInvalidPagination() {
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/comments/commentid1/replies?page=first", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentReplies)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPagination.Error() + `"}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ch *CommentHandlerSuite) TestGetCommentRepliesSuccessful() {
+	ch.commentUsecase.On("FindCommentReplies", mock.Anything, "postid1", "commentid1", 2, 10, mock.AnythingOfType("string")).Return(&[]domain.Comment{}, nil)
+	commentHandler := commentHttp.NewCommentHandler(ch.commentUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/comments/commentid1/replies?page=2&limit=10", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(commentHandler.CommentReplies)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ch.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"comments":[]}}`
+	assert.Equalf(ch.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
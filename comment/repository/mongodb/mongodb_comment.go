@@ -3,10 +3,12 @@ package mongodb
 import (
 	"context"
 	"instagram-go/domain"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type mongodbCommentRepository struct {
@@ -19,54 +21,261 @@ func NewMongodbCommentRepository(collection *mongo.Collection) domain.CommentRep
 	}
 }
 
-func (mcr *mongodbCommentRepository) FindComments(filter interface{}) (*[]bson.M, error) {
-	cursor, err := mcr.collection.Find(context.TODO(), filter)
+func (mcr *mongodbCommentRepository) CreateIndexes(ctx context.Context) error {
+	topIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "post_id", Value: 1},
+			primitive.E{Key: "score", Value: -1},
+			primitive.E{Key: "created_date", Value: -1},
+			primitive.E{Key: "_id", Value: -1},
+		},
+	}
+	dateIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "post_id", Value: 1},
+			primitive.E{Key: "created_date", Value: 1},
+			primitive.E{Key: "_id", Value: 1},
+		},
+	}
+	replyIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "parent_comment_id", Value: 1},
+			primitive.E{Key: "created_date", Value: 1},
+		},
+	}
+	_, err := mcr.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{topIndex, dateIndex, replyIndex})
+	return err
+}
+
+func (mcr *mongodbCommentRepository) FindComments(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	cursor, err := mcr.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (mcr *mongodbCommentRepository) FindPaginatedComments(ctx context.Context, filter interface{}, skip int64, limit int64) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "created_date", Value: 1}}).SetSkip(skip).SetLimit(limit)
+	cursor, err := mcr.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (mcr *mongodbCommentRepository) FindSortedComments(ctx context.Context, filter interface{}, sort interface{}, limit int64) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(sort).SetLimit(limit)
+	cursor, err := mcr.collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, err
 	}
 	var queryResult []bson.M
-	if err = cursor.All(context.TODO(), &queryResult); err != nil {
+	if err = cursor.All(ctx, &queryResult); err != nil {
 		return nil, err
 	}
 	return &queryResult, nil
 }
 
-func (mcr *mongodbCommentRepository) InsertComment(comment *domain.Comment) error {
+// Top level comments have no parent_comment_id, like the ones stored before replies existed.
+func (mcr *mongodbCommentRepository) InsertComment(ctx context.Context, comment *domain.Comment) error {
 	newComment := bson.D{
 		primitive.E{Key: "_id", Value: comment.Id},
 		primitive.E{Key: "post_id", Value: comment.PostId},
 		primitive.E{Key: "user_id", Value: comment.UserId},
 		primitive.E{Key: "comment", Value: comment.Comment},
+		primitive.E{Key: "hashtags", Value: comment.Hashtags},
+		primitive.E{Key: "mentions", Value: comment.Mentions},
+		primitive.E{Key: "score", Value: 0},
+		primitive.E{Key: "hidden", Value: comment.Hidden},
 		primitive.E{Key: "created_date", Value: comment.CreatedDate},
 		primitive.E{Key: "updated_date", Value: comment.UpdatedDate},
 	}
+	if comment.ParentCommentId != "" {
+		newComment = append(newComment,
+			primitive.E{Key: "parent_comment_id", Value: comment.ParentCommentId},
+			primitive.E{Key: "replying_to", Value: comment.ReplyingTo},
+		)
+	}
 
-	_, err := mcr.collection.InsertOne(context.TODO(), newComment)
+	_, err := mcr.collection.InsertOne(ctx, newComment)
 	return err
 }
 
-func (mcr *mongodbCommentRepository) FindOneComment(commentId string) (*domain.Comment, error) {
+func (mcr *mongodbCommentRepository) FindOneComment(ctx context.Context, commentId string) (*domain.Comment, error) {
 	var comment domain.Comment
 	filter := bson.M{"_id": commentId}
-	err := mcr.collection.FindOne(context.TODO(), filter).Decode(&comment)
+	err := mcr.collection.FindOne(ctx, filter).Decode(&comment)
 	return &comment, err
 }
 
-func (mcr *mongodbCommentRepository) UpdateComment(commentId string, commentContent string) error {
+// UpdateComment keeps the replaced text as a revision and marks the comment edited.
+func (mcr *mongodbCommentRepository) UpdateComment(ctx context.Context, commentId string, commentContent string, hashtags []string, mentions []domain.Mention, revision *domain.CommentRevision) error {
 	filter := bson.M{"_id": commentId}
 	update := bson.D{primitive.E{
 		Key: "$set",
 		Value: bson.D{primitive.E{
 			Key:   "comment",
-			Value: commentContent},
+			Value: commentContent}, primitive.E{
+			Key:   "hashtags",
+			Value: hashtags}, primitive.E{
+			Key:   "mentions",
+			Value: mentions}, primitive.E{
+			Key:   "edited",
+			Value: true}, primitive.E{
+			Key:   "updated_date",
+			Value: revision.EditedDate},
+		},
+	}, primitive.E{
+		Key: "$push",
+		Value: bson.D{primitive.E{
+			Key:   "revisions",
+			Value: revision,
+		},
+		},
+	}}
+	_, err := mcr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (mcr *mongodbCommentRepository) UpdateCommentDeletedAt(ctx context.Context, commentId string, deletedAt *time.Time) error {
+	filter := bson.M{"_id": commentId}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "deleted_at",
+			Value: deletedAt,
+		},
+		},
+	}}
+	_, err := mcr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (mcr *mongodbCommentRepository) UpdateCommentDeletedBy(ctx context.Context, commentId string, deletedBy string) error {
+	filter := bson.M{"_id": commentId}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "deleted_by",
+			Value: deletedBy,
+		},
+		},
+	}}
+	_, err := mcr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (mcr *mongodbCommentRepository) UpdateCommentPinned(ctx context.Context, commentId string, pinned bool) error {
+	filter := bson.M{"_id": commentId}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "pinned",
+			Value: pinned,
+		},
 		},
 	}}
-	_, err := mcr.collection.UpdateOne(context.TODO(), filter, update)
+	_, err := mcr.collection.UpdateOne(ctx, filter, update)
 	return err
 }
 
-func (mcr *mongodbCommentRepository) DeleteComment(commentId string) error {
+func (mcr *mongodbCommentRepository) UpdateCommentHidden(ctx context.Context, commentId string, hidden bool) error {
 	filter := bson.M{"_id": commentId}
-	_, err := mcr.collection.DeleteOne(context.TODO(), filter)
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "hidden",
+			Value: hidden,
+		},
+		},
+	}}
+	_, err := mcr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// Comments without a score are left to MigrateCommentScores, which counts them in full.
+func (mcr *mongodbCommentRepository) IncrementCommentScore(ctx context.Context, commentId string, delta int) error {
+	filter := bson.M{"_id": commentId, "score": bson.M{"$exists": true}}
+	update := bson.D{primitive.E{
+		Key: "$inc",
+		Value: bson.D{primitive.E{
+			Key:   "score",
+			Value: delta,
+		},
+		},
+	}}
+	_, err := mcr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (mcr *mongodbCommentRepository) UpdateCommentScores(ctx context.Context, scores map[string]int) error {
+	if len(scores) == 0 {
+		return nil
+	}
+	var models []mongo.WriteModel
+	for commentId, score := range scores {
+		model := mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": commentId}).SetUpdate(bson.M{"$set": bson.M{"score": score}})
+		models = append(models, model)
+	}
+	_, err := mcr.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
 	return err
 }
+
+func (mcr *mongodbCommentRepository) UpdateCommentsDeletedAt(ctx context.Context, filter interface{}, deletedAt *time.Time) error {
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "deleted_at",
+			Value: deletedAt,
+		},
+		},
+	}}
+	_, err := mcr.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+func (mcr *mongodbCommentRepository) DeleteComment(ctx context.Context, commentId string) error {
+	filter := bson.M{"_id": commentId}
+	_, err := mcr.collection.DeleteOne(ctx, filter)
+	return err
+}
+
+func (mcr *mongodbCommentRepository) DeleteComments(ctx context.Context, filter interface{}) error {
+	_, err := mcr.collection.DeleteMany(ctx, filter)
+	return err
+}
+
+func (mcr *mongodbCommentRepository) CountReplies(ctx context.Context, parentCommentIds []string) (map[string]int, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{primitive.E{Key: "$match", Value: bson.M{"parent_comment_id": bson.M{"$in": parentCommentIds}, "deleted_at": nil}}},
+		bson.D{primitive.E{Key: "$group", Value: bson.M{
+			"_id":   "$parent_comment_id",
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+	cursor, err := mcr.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var groups []struct {
+		ParentCommentId string `bson:"_id"`
+		Count           int    `bson:"count"`
+	}
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+	replyCounts := make(map[string]int)
+	for _, group := range groups {
+		replyCounts[group.ParentCommentId] = group.Count
+	}
+	return replyCounts, nil
+}
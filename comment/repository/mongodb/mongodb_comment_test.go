@@ -2,6 +2,7 @@ package mongodb_test
 
 import (
 	"context"
+	"fmt"
 	"instagram-go/comment/repository/mongodb"
 	"instagram-go/domain"
 	"testing"
@@ -46,7 +47,7 @@ func (cr *CommentRepoSuite) TestFindNotExistComment() {
 
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
 	filter := bson.M{"_id": "notExistCommentId"}
-	queryResult, err := commentRepo.FindComments(filter)
+	queryResult, err := commentRepo.FindComments(context.TODO(), filter)
 
 	assert.Equalf(cr.T(), 0, len(*queryResult), "Should have return the correct amount of comments %v but got %v", 0, len(*queryResult))
 	assert.NoError(cr.T(), err, "Should have not return error")
@@ -65,7 +66,7 @@ func (cr *CommentRepoSuite) TestFindCommentsSuccessful() {
 
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
 	filter := bson.M{"_id": "commentid1"}
-	queryResult, err := commentRepo.FindComments(filter)
+	queryResult, err := commentRepo.FindComments(context.TODO(), filter)
 
 	assert.Equalf(cr.T(), 1, len(*queryResult), "Should have return the correct amount of comments %v but got %v", 1, len(*queryResult))
 	assert.Equalf(cr.T(), "commentid1", (*queryResult)[0]["_id"], "Should have return the correct comment id %s but got %s", "commentid1", (*queryResult)[0]["_id"])
@@ -78,8 +79,8 @@ func (cr *CommentRepoSuite) TestFindCommentsSuccessful() {
 func (cr *CommentRepoSuite) TestInsertDuplicateComment() {
 	newComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
-	_ = commentRepo.InsertComment(newComment)
-	err := commentRepo.InsertComment(newComment)
+	_ = commentRepo.InsertComment(context.TODO(), newComment)
+	err := commentRepo.InsertComment(context.TODO(), newComment)
 
 	assert.Error(cr.T(), err, "Should have return an error but didn't")
 }
@@ -87,7 +88,7 @@ func (cr *CommentRepoSuite) TestInsertDuplicateComment() {
 func (cr *CommentRepoSuite) TestInsertCommentSuccessful() {
 	newComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
-	err := commentRepo.InsertComment(newComment)
+	err := commentRepo.InsertComment(context.TODO(), newComment)
 
 	var insertedComment domain.Comment
 	cr.collection.FindOne(context.TODO(), bson.M{"_id": "commentid1"}).Decode(&insertedComment)
@@ -100,7 +101,7 @@ func (cr *CommentRepoSuite) TestInsertCommentSuccessful() {
 
 func (cr *CommentRepoSuite) TestFindOneNotExistComment() {
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
-	_, err := commentRepo.FindOneComment("notExistCommentId")
+	_, err := commentRepo.FindOneComment(context.TODO(), "notExistCommentId")
 
 	assert.Error(cr.T(), err, "Should have return an error but didn't")
 }
@@ -117,7 +118,7 @@ func (cr *CommentRepoSuite) TestFindOneCommentSuccessful() {
 	_, _ = cr.collection.InsertOne(context.TODO(), comment)
 
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
-	foundComment, err := commentRepo.FindOneComment("commentid1")
+	foundComment, err := commentRepo.FindOneComment(context.TODO(), "commentid1")
 
 	assert.Equalf(cr.T(), "commentid1", foundComment.Id, "Should have return the correct comment id %s but got %s", "commentid1", foundComment.Id)
 	assert.Equalf(cr.T(), "postid1", foundComment.PostId, "Should have return the correct post id %s but got %s", "postid1", foundComment.PostId)
@@ -138,7 +139,7 @@ func (cr *CommentRepoSuite) TestUpdateNotExistComment() {
 	_, _ = cr.collection.InsertOne(context.TODO(), comment)
 
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
-	err := commentRepo.UpdateComment("notExistCommentId", "newcomment1")
+	err := commentRepo.UpdateComment(context.TODO(), "notExistCommentId", "newcomment1", nil, nil, domain.NewCommentRevision("comment1", time.Now(), time.Now()))
 
 	var notUpdatedComment domain.Comment
 	cr.collection.FindOne(context.TODO(), bson.M{"_id": "commentid1"}).Decode(&notUpdatedComment)
@@ -162,7 +163,8 @@ func (cr *CommentRepoSuite) TestUpdateCommentSuccessful() {
 	_, _ = cr.collection.InsertOne(context.TODO(), comment)
 
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
-	err := commentRepo.UpdateComment("commentid1", "newcomment1")
+	editedDate := time.Now().Add(time.Hour)
+	err := commentRepo.UpdateComment(context.TODO(), "commentid1", "newcomment1 #golang", []string{"golang"}, nil, domain.NewCommentRevision("comment1", time.Now(), editedDate))
 
 	var updatedComment domain.Comment
 	cr.collection.FindOne(context.TODO(), bson.M{"_id": "commentid1"}).Decode(&updatedComment)
@@ -170,7 +172,38 @@ func (cr *CommentRepoSuite) TestUpdateCommentSuccessful() {
 	assert.Equalf(cr.T(), "commentid1", updatedComment.Id, "Should have received the correct comment id %s but got %s", "commentid1", updatedComment.Id)
 	assert.Equalf(cr.T(), "postid1", updatedComment.PostId, "Should have received the correct post id %s but got %s", "postid1", updatedComment.PostId)
 	assert.Equalf(cr.T(), "userid1", updatedComment.UserId, "Should have received the correct user id %s but got %s", "userid1", updatedComment.UserId)
-	assert.Equalf(cr.T(), "newcomment1", updatedComment.Comment, "Should have received the correct comment %s but got %s", "newcomment1", updatedComment.Comment)
+	assert.Equalf(cr.T(), "newcomment1 #golang", updatedComment.Comment, "Should have received the correct comment %s but got %s", "newcomment1 #golang", updatedComment.Comment)
+	assert.Equalf(cr.T(), []string{"golang"}, updatedComment.Hashtags, "Should have received the correct hashtags %v but got %v", []string{"golang"}, updatedComment.Hashtags)
+	assert.True(cr.T(), updatedComment.Edited, "Should have marked the comment edited")
+	assert.WithinDurationf(cr.T(), editedDate, updatedComment.UpdatedDate, time.Millisecond, "Should have updated the updated date to %v but got %v", editedDate, updatedComment.UpdatedDate)
+	assert.Equalf(cr.T(), 1, len(updatedComment.Revisions), "Should have kept %d revision but got %d", 1, len(updatedComment.Revisions))
+	assert.Equalf(cr.T(), "comment1", updatedComment.Revisions[0].Comment, "Should have kept the old comment %s but got %s", "comment1", updatedComment.Revisions[0].Comment)
+	assert.NoError(cr.T(), err, "Should have not return error")
+}
+
+func (cr *CommentRepoSuite) TestUpdateCommentDeletedAtSuccessful() {
+	comment := bson.M{
+		"_id":          "commentid1",
+		"post_id":      "postid1",
+		"user_id":      "userid1",
+		"comment":      "comment1",
+		"created_date": primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date": primitive.NewDateTimeFromTime(time.Now()),
+	}
+	_, _ = cr.collection.InsertOne(context.TODO(), comment)
+	deletedAt := time.Now()
+
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	err := commentRepo.UpdateCommentDeletedAt(context.TODO(), "commentid1", &deletedAt)
+
+	queryResult, _ := commentRepo.FindComments(context.TODO(), bson.M{"deleted_at": nil})
+	assert.Equalf(cr.T(), 0, len(*queryResult), "Should have return the correct amount of comments %v but got %v", 0, len(*queryResult))
+	assert.NoError(cr.T(), err, "Should have not return error")
+
+	err = commentRepo.UpdateCommentDeletedAt(context.TODO(), "commentid1", nil)
+
+	queryResult, _ = commentRepo.FindComments(context.TODO(), bson.M{"deleted_at": nil})
+	assert.Equalf(cr.T(), 1, len(*queryResult), "Should have return the correct amount of comments %v but got %v", 1, len(*queryResult))
 	assert.NoError(cr.T(), err, "Should have not return error")
 }
 
@@ -186,7 +219,7 @@ func (cr *CommentRepoSuite) TestDeleteNotExistComment() {
 	_, _ = cr.collection.InsertOne(context.TODO(), comment)
 
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
-	err := commentRepo.DeleteComment("notExistCommentId")
+	err := commentRepo.DeleteComment(context.TODO(), "notExistCommentId")
 
 	filter := bson.M{}
 	cursor, _ := cr.collection.Find(context.TODO(), filter)
@@ -209,7 +242,7 @@ func (cr *CommentRepoSuite) TestDeleteCommentSuccessful() {
 	_, _ = cr.collection.InsertOne(context.TODO(), comment)
 
 	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
-	err := commentRepo.DeleteComment("commentid1")
+	err := commentRepo.DeleteComment(context.TODO(), "commentid1")
 
 	filter := bson.M{"_id": "commentid1"}
 	cursor, _ := cr.collection.Find(context.TODO(), filter)
@@ -219,3 +252,133 @@ func (cr *CommentRepoSuite) TestDeleteCommentSuccessful() {
 	assert.Equalf(cr.T(), 0, len(queryResult), "Should have return the correct amount of comments %v but got %v", 0, len(queryResult))
 	assert.NoError(cr.T(), err, "Should have not return error")
 }
+
+func (cr *CommentRepoSuite) TestDeleteCommentsSuccessful() {
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	_ = commentRepo.InsertComment(context.TODO(), domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now()))
+	_ = commentRepo.InsertComment(context.TODO(), domain.NewComment("commentid2", "postid1", "userid1", "comment2", 0, time.Now(), time.Now()))
+	_ = commentRepo.InsertComment(context.TODO(), domain.NewComment("commentid3", "postid2", "userid1", "comment3", 0, time.Now(), time.Now()))
+
+	err := commentRepo.DeleteComments(context.TODO(), bson.M{"post_id": "postid1"})
+	queryResult, _ := commentRepo.FindComments(context.TODO(), bson.M{})
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cr.T(), 1, len(*queryResult), "Should have return %d comments but got %d", 1, len(*queryResult))
+}
+
+func (cr *CommentRepoSuite) TestFindPaginatedCommentsOldestFirst() {
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	for i, createdDate := range []time.Time{time.Now(), time.Now().Add(-2 * time.Hour), time.Now().Add(-time.Hour)} {
+		reply := domain.NewComment(fmt.Sprintf("commentid%d", i+2), "postid1", "userid1", "reply", 0, createdDate, createdDate)
+		reply.ParentCommentId = "commentid1"
+		_ = commentRepo.InsertComment(context.TODO(), reply)
+	}
+
+	queryResult, err := commentRepo.FindPaginatedComments(context.TODO(), bson.M{"parent_comment_id": "commentid1"}, 1, 1)
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cr.T(), 1, len(*queryResult), "Should have return %d comments but got %d", 1, len(*queryResult))
+	assert.Equalf(cr.T(), "commentid4", (*queryResult)[0]["_id"], "Should have return %s but got %s", "commentid4", (*queryResult)[0]["_id"])
+}
+
+func (cr *CommentRepoSuite) TestInsertTopLevelCommentWithoutParent() {
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	_ = commentRepo.InsertComment(context.TODO(), domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now()))
+
+	queryResult, err := commentRepo.FindComments(context.TODO(), bson.M{"post_id": "postid1", "parent_comment_id": nil})
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cr.T(), 1, len(*queryResult), "Should have return %d comments but got %d", 1, len(*queryResult))
+}
+
+func (cr *CommentRepoSuite) TestUpdateCommentsDeletedAtSuccessful() {
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	for _, id := range []string{"commentid2", "commentid3"} {
+		reply := domain.NewComment(id, "postid1", "userid1", "reply", 0, time.Now(), time.Now())
+		reply.ParentCommentId = "commentid1"
+		_ = commentRepo.InsertComment(context.TODO(), reply)
+	}
+
+	deletedAt := time.Now()
+	err := commentRepo.UpdateCommentsDeletedAt(context.TODO(), bson.M{"parent_comment_id": "commentid1"}, &deletedAt)
+	queryResult, _ := commentRepo.FindComments(context.TODO(), bson.M{"deleted_at": nil})
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cr.T(), 0, len(*queryResult), "Should have return %d comments but got %d", 0, len(*queryResult))
+}
+
+func (cr *CommentRepoSuite) TestFindSortedCommentsByScore() {
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	for _, id := range []string{"commentid1", "commentid2", "commentid3"} {
+		_ = commentRepo.InsertComment(context.TODO(), domain.NewComment(id, "postid1", "userid1", "comment", 0, time.Now(), time.Now()))
+	}
+	_ = commentRepo.IncrementCommentScore(context.TODO(), "commentid2", 3)
+	_ = commentRepo.IncrementCommentScore(context.TODO(), "commentid3", 1)
+
+	sort := bson.D{primitive.E{Key: "score", Value: -1}, primitive.E{Key: "_id", Value: -1}}
+	queryResult, err := commentRepo.FindSortedComments(context.TODO(), bson.M{"post_id": "postid1"}, sort, 2)
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cr.T(), 2, len(*queryResult), "Should have return %d comments but got %d", 2, len(*queryResult))
+	assert.Equalf(cr.T(), "commentid2", (*queryResult)[0]["_id"], "Should have return %s but got %s", "commentid2", (*queryResult)[0]["_id"])
+	assert.Equalf(cr.T(), "commentid3", (*queryResult)[1]["_id"], "Should have return %s but got %s", "commentid3", (*queryResult)[1]["_id"])
+}
+
+func (cr *CommentRepoSuite) TestIncrementCommentScoreSkipsUnscoredComment() {
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	_, _ = cr.collection.InsertOne(context.TODO(), bson.M{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1"})
+
+	err := commentRepo.IncrementCommentScore(context.TODO(), "commentid1", domain.CommentLikeScore)
+	count, _ := cr.collection.CountDocuments(context.TODO(), bson.M{"score": bson.M{"$exists": true}})
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cr.T(), int64(0), count, "Should have left the score to the migration but %v comments have one", count)
+}
+
+func (cr *CommentRepoSuite) TestUpdateCommentScoresSuccessful() {
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	for _, id := range []string{"commentid1", "commentid2"} {
+		_ = commentRepo.InsertComment(context.TODO(), domain.NewComment(id, "postid1", "userid1", "comment", 0, time.Now(), time.Now()))
+	}
+
+	err := commentRepo.UpdateCommentScores(context.TODO(), map[string]int{"commentid1": 3, "commentid2": 1})
+	queryResult, _ := commentRepo.FindComments(context.TODO(), bson.M{"score": 3})
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cr.T(), 1, len(*queryResult), "Should have return %d comments but got %d", 1, len(*queryResult))
+	assert.Equalf(cr.T(), "commentid1", (*queryResult)[0]["_id"], "Should have return %s but got %s", "commentid1", (*queryResult)[0]["_id"])
+}
+
+func (cr *CommentRepoSuite) TestUpdateCommentPinnedAndHiddenSuccessful() {
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	_ = commentRepo.InsertComment(context.TODO(), domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now()))
+
+	errPinned := commentRepo.UpdateCommentPinned(context.TODO(), "commentid1", true)
+	errHidden := commentRepo.UpdateCommentHidden(context.TODO(), "commentid1", true)
+	errDeletedBy := commentRepo.UpdateCommentDeletedBy(context.TODO(), "commentid1", "userid2")
+	comment, _ := commentRepo.FindOneComment(context.TODO(), "commentid1")
+
+	assert.NoErrorf(cr.T(), errPinned, "Should have not return error but got %s", errPinned)
+	assert.NoErrorf(cr.T(), errHidden, "Should have not return error but got %s", errHidden)
+	assert.NoErrorf(cr.T(), errDeletedBy, "Should have not return error but got %s", errDeletedBy)
+	assert.True(cr.T(), comment.Pinned, "Should have pinned the comment")
+	assert.True(cr.T(), comment.Hidden, "Should have hidden the comment")
+	assert.Equalf(cr.T(), "userid2", comment.DeletedBy, "Should have deleted by %s but got %s", "userid2", comment.DeletedBy)
+}
+
+func (cr *CommentRepoSuite) TestCountRepliesSuccessful() {
+	comments := []interface{}{
+		bson.M{"_id": "commentid2", "post_id": "postid1", "parent_comment_id": "commentid1", "deleted_at": nil},
+		bson.M{"_id": "commentid3", "post_id": "postid1", "parent_comment_id": "commentid1", "deleted_at": nil},
+		bson.M{"_id": "commentid4", "post_id": "postid1", "parent_comment_id": "commentid1", "deleted_at": time.Now()},
+		bson.M{"_id": "commentid6", "post_id": "postid1", "parent_comment_id": "commentid5", "deleted_at": nil},
+	}
+	_, _ = cr.collection.InsertMany(context.TODO(), comments)
+
+	commentRepo := mongodb.NewMongodbCommentRepository(cr.collection)
+	replyCounts, err := commentRepo.CountReplies(context.TODO(), []string{"commentid1", "commentid7"})
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	expectedReplyCounts := map[string]int{"commentid1": 2}
+	assert.Equalf(cr.T(), expectedReplyCounts, replyCounts, "Should have return the reply counts %v but got %v", expectedReplyCounts, replyCounts)
+}
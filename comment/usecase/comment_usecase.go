@@ -1,9 +1,11 @@
 package usecase
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"instagram-go/domain"
-	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,138 +14,788 @@ import (
 )
 
 type commentUsecase struct {
-	sync.Mutex
 	commentRepository domain.CommentRepository
 	postRepository    domain.PostRepository
 	likeRepository    domain.LikeRepository
+	hashtagRepository domain.HashtagRepository
+	userRepository    domain.UserRepository
+	mentionRepository domain.MentionRepository
+	contentModerator  domain.ContentModerator
 	headerHelper      domain.IHeaderHelper
 }
 
-func NewCommentUsecase(commentRepository domain.CommentRepository, postRepository domain.PostRepository, likeRepository domain.LikeRepository, headerHelper domain.IHeaderHelper) domain.CommentUsecase {
+func NewCommentUsecase(commentRepository domain.CommentRepository, postRepository domain.PostRepository, likeRepository domain.LikeRepository, hashtagRepository domain.HashtagRepository, userRepository domain.UserRepository, mentionRepository domain.MentionRepository, contentModerator domain.ContentModerator, headerHelper domain.IHeaderHelper) domain.CommentUsecase {
 	return &commentUsecase{
 		commentRepository: commentRepository,
 		postRepository:    postRepository,
 		likeRepository:    likeRepository,
+		hashtagRepository: hashtagRepository,
+		userRepository:    userRepository,
+		mentionRepository: mentionRepository,
+		contentModerator:  contentModerator,
 		headerHelper:      headerHelper,
 	}
 }
 
-func (cu *commentUsecase) FindComments(postId string) (*[]domain.Comment, error) {
-	filter := bson.M{"_id": postId}
-	cu.Lock()
-	queryResult, err := cu.postRepository.FindPosts(filter)
-	cu.Unlock()
+// The pinned comment leads the first page on top of its limit.
+func (cu *commentUsecase) FindComments(ctx context.Context, postId string, sort string, cursor string, limit int, tokenString string) (*[]domain.Comment, string, error) {
+	_, pageLimit, err := domain.Paginate(1, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	sortKeys, direction, err := commentSortKeys(sort)
+	if err != nil {
+		return nil, "", err
+	}
+	viewerId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, "", domain.ErrInternalServerError
+	}
+	filter := domain.VisiblePostFilter(bson.M{"_id": postId}, viewerId)
+	queryResult, err := cu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, "", domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, "", domain.ErrPostNotFound
+	}
+	visibility, err := cu.visibleCommentsFilter(ctx, postId, viewerId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filter = bson.M{"post_id": postId, "parent_comment_id": nil, "pinned": bson.M{"$ne": true}, "deleted_at": nil, "$and": bson.A{visibility}}
+	if cursor != "" {
+		after, err := decodeCommentCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["$or"] = afterCommentCursor(sortKeys, direction, after)
+	}
+	sortOrder := bson.D{}
+	for _, key := range sortKeys {
+		sortOrder = append(sortOrder, primitive.E{Key: key, Value: direction})
+	}
+	// One more comment than asked for tells whether there is a next page.
+	queryResult, err = cu.commentRepository.FindSortedComments(ctx, filter, sortOrder, pageLimit+1)
+	if err != nil {
+		return nil, "", domain.ErrInternalServerError
+	}
+
+	page := *queryResult
+	var nextCursor string
+	if int64(len(page)) > pageLimit {
+		page = page[:pageLimit]
+		nextCursor, err = encodeCommentCursor(page[len(page)-1])
+		if err != nil {
+			return nil, "", domain.ErrInternalServerError
+		}
+	}
+	if cursor == "" {
+		filter = bson.M{"post_id": postId, "parent_comment_id": nil, "pinned": true, "deleted_at": nil, "$and": bson.A{visibility}}
+		pinnedQueryResult, err := cu.commentRepository.FindComments(ctx, filter)
+		if err != nil {
+			return nil, "", domain.ErrInternalServerError
+		}
+		page = append(*pinnedQueryResult, page...)
+	}
+	comments, err := cu.buildComments(ctx, &page)
+	if err != nil {
+		return nil, "", err
+	}
+	err = cu.enrichComments(ctx, comments, viewerId)
+	if err != nil {
+		return nil, "", err
+	}
+	return comments, nextCursor, nil
+}
+
+func (cu *commentUsecase) enrichComments(ctx context.Context, comments *[]domain.Comment, viewerId string) error {
+	var commentIds []string
+	for _, comment := range *comments {
+		commentIds = append(commentIds, comment.Id)
+	}
+	reactions, err := domain.FindViewerReactions(ctx, cu.likeRepository, viewerId, domain.LikeResourceComment, commentIds)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	for i := range *comments {
+		comment := &(*comments)[i]
+		liked := reactions[comment.Id] != ""
+		comment.ViewerHasLiked = &liked
+		comment.ViewerReaction = reactions[comment.Id]
+	}
+	return nil
+}
+
+// Hidden comments are only shown to their author and the post owner.
+func (cu *commentUsecase) visibleCommentsFilter(ctx context.Context, postId string, viewerId string) (bson.M, error) {
+	post, err := cu.postRepository.FindOnePost(ctx, postId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if post.UserId == viewerId {
+		return bson.M{}, nil
+	}
+	return bson.M{"$or": bson.A{bson.M{"hidden": bson.M{"$ne": true}}, bson.M{"user_id": viewerId}}}, nil
+}
+
+type commentCursor struct {
+	Score       *int      `json:"score"`
+	CreatedDate time.Time `json:"created_date"`
+	Id          string    `json:"id"`
+}
+
+// _id comes last so that comments never tie.
+func commentSortKeys(sort string) ([]string, int, error) {
+	switch sort {
+	case domain.CommentSortNewest:
+		return []string{"created_date", "_id"}, -1, nil
+	case domain.CommentSortOldest:
+		return []string{"created_date", "_id"}, 1, nil
+	case domain.CommentSortTop:
+		return []string{"score", "created_date", "_id"}, -1, nil
+	}
+	return nil, 0, domain.ErrInvalidCommentSort
+}
+
+// Comments the score migration has not reached yet sort after every scored one.
+func afterCommentCursor(sortKeys []string, direction int, after *commentCursor) bson.A {
+	values := bson.M{"score": nil, "created_date": after.CreatedDate, "_id": after.Id}
+	if after.Score != nil {
+		values["score"] = *after.Score
+	}
+	operator := "$gt"
+	if direction < 0 {
+		operator = "$lt"
+	}
+	conditions := bson.A{}
+	for i, key := range sortKeys {
+		if key == "score" {
+			if after.Score == nil {
+				continue
+			}
+			conditions = append(conditions, bson.M{"score": nil})
+		}
+		condition := bson.M{}
+		for _, previousKey := range sortKeys[:i] {
+			condition[previousKey] = values[previousKey]
+		}
+		condition[key] = bson.M{operator: values[key]}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+func encodeCommentCursor(v bson.M) (string, error) {
+	cursor := commentCursor{
+		Score:       commentScore(v["score"]),
+		CreatedDate: v["created_date"].(primitive.DateTime).Time(),
+		Id:          fmt.Sprintf("%v", v["_id"]),
+	}
+	cursorJSON, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(cursorJSON), nil
+}
+
+func decodeCommentCursor(cursor string) (*commentCursor, error) {
+	cursorJSON, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+	var decodedCursor commentCursor
+	err = json.Unmarshal(cursorJSON, &decodedCursor)
+	if err != nil || decodedCursor.Id == "" {
+		return nil, domain.ErrInvalidCursor
+	}
+	return &decodedCursor, nil
+}
+
+// Comments created before scores were introduced may not have one yet.
+func commentScore(score interface{}) *int {
+	var value int
+	switch score := score.(type) {
+	case int32:
+		value = int(score)
+	case int64:
+		value = int(score)
+	case float64:
+		value = int(score)
+	default:
+		return nil
+	}
+	return &value
+}
+
+func (cu *commentUsecase) FindCommentReplies(ctx context.Context, postId string, commentId string, page int, limit int, tokenString string) (*[]domain.Comment, error) {
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := domain.VisiblePostFilter(bson.M{"_id": postId}, viewerId)
+	queryResult, err := cu.postRepository.FindPosts(ctx, filter)
 	if err != nil {
 		return nil, domain.ErrInternalServerError
 	}
 	if len(*queryResult) == 0 {
 		return nil, domain.ErrPostNotFound
 	}
-	filter = bson.M{"post_id": postId}
-	cu.Lock()
-	queryResult, err = cu.commentRepository.FindComments(filter)
-	cu.Unlock()
+	visibility, err := cu.visibleCommentsFilter(ctx, postId, viewerId)
+	if err != nil {
+		return nil, err
+	}
+	filter = bson.M{"_id": commentId, "post_id": postId, "parent_comment_id": nil, "deleted_at": nil, "$and": bson.A{visibility}}
+	queryResult, err = cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrCommentNotFound
+	}
+
+	filter = bson.M{"parent_comment_id": commentId, "deleted_at": nil, "$and": bson.A{visibility}}
+	queryResult, err = cu.commentRepository.FindPaginatedComments(ctx, filter, skip, pageLimit)
 	if err != nil {
 		return nil, domain.ErrInternalServerError
 	}
+	replies, err := cu.buildComments(ctx, queryResult)
+	if err != nil {
+		return nil, err
+	}
+	err = cu.enrichComments(ctx, replies, viewerId)
+	if err != nil {
+		return nil, err
+	}
+	return replies, nil
+}
+
+func (cu *commentUsecase) buildComments(ctx context.Context, queryResult *[]bson.M) (*[]domain.Comment, error) {
 	var comments []domain.Comment
+	if len(*queryResult) == 0 {
+		return &comments, nil
+	}
+	var commentIds, topLevelCommentIds []string
+	for _, v := range *queryResult {
+		id := fmt.Sprintf("%v", v["_id"])
+		commentIds = append(commentIds, id)
+		if _, ok := v["parent_comment_id"].(string); !ok {
+			topLevelCommentIds = append(topLevelCommentIds, id)
+		}
+	}
+	commentReactionCounts, err := cu.likeRepository.CountReactions(ctx, domain.LikeResourceComment, commentIds)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	replyCounts := map[string]int{}
+	if len(topLevelCommentIds) > 0 {
+		replyCounts, err = cu.commentRepository.CountReplies(ctx, topLevelCommentIds)
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+	}
 	for _, v := range *queryResult {
 		id := fmt.Sprintf("%v", v["_id"])
 		postId := fmt.Sprintf("%v", v["post_id"])
 		userId := fmt.Sprintf("%v", v["user_id"])
 		commentContent := fmt.Sprintf("%v", v["comment"])
-		filter = bson.M{"resource_id": id, "resource_type": "comment"}
-		cu.Lock()
-		likeQueryResult, err := cu.likeRepository.FindLikes(filter)
-		cu.Unlock()
-		if err != nil {
-			return nil, domain.ErrInternalServerError
+		reactionCounts := commentReactionCounts[id]
+		if reactionCounts == nil {
+			reactionCounts = map[string]int{}
 		}
-		likeCount := len(*likeQueryResult)
 		createdDate := v["created_date"].(primitive.DateTime).Time()
 		updatedDate := v["updated_date"].(primitive.DateTime).Time()
+		comment := domain.NewComment(id, postId, userId, commentContent, reactionCounts[domain.ReactionHeart], createdDate, updatedDate)
+		comment.ReactionCounts = reactionCounts
+		if hashtags, ok := v["hashtags"].(primitive.A); ok {
+			for _, hashtag := range hashtags {
+				comment.Hashtags = append(comment.Hashtags, fmt.Sprintf("%v", hashtag))
+			}
+		}
+		comment.Edited, _ = v["edited"].(bool)
+		comment.Pinned, _ = v["pinned"].(bool)
+		comment.Hidden, _ = v["hidden"].(bool)
+		comment.Mentions, err = domain.DecodeMentions(v["mentions"])
 		if err != nil {
-			return nil, err
+			return nil, domain.ErrInternalServerError
+		}
+		if parentCommentId, ok := v["parent_comment_id"].(string); ok {
+			comment.ParentCommentId = parentCommentId
+			if replyingTo, ok := v["replying_to"].(bson.M); ok {
+				comment.ReplyingTo = domain.NewCommentReplyingTo(fmt.Sprintf("%v", replyingTo["user_id"]), fmt.Sprintf("%v", replyingTo["username"]))
+			}
+		} else {
+			comment.ReplyCount = replyCounts[id]
+		}
+		if deletedAt, ok := v["deleted_at"].(primitive.DateTime); ok {
+			deletedAtTime := deletedAt.Time()
+			comment.DeletedAt = &deletedAtTime
 		}
-		comment := domain.NewComment(id, postId, userId, commentContent, likeCount, createdDate, updatedDate)
 		comments = append(comments, *comment)
 	}
 	return &comments, nil
 }
 
-func (cu *commentUsecase) PostComment(comment *domain.Comment, tokenString string) error {
+func (cu *commentUsecase) PostComment(ctx context.Context, comment *domain.Comment, tokenString string) error {
 	userId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	newCommentId := "comment-" + uuid.NewString()
-	filter := bson.M{"_id": comment.PostId}
-	cu.Lock()
-	queryResult, err := cu.postRepository.FindPosts(filter)
-	cu.Unlock()
+	filter := domain.VisiblePostFilter(bson.M{"_id": comment.PostId}, userId)
+	queryResult, err := cu.postRepository.FindPosts(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	if len(*queryResult) == 0 {
 		return domain.ErrPostNotFound
 	}
+	if commentsDisabled, _ := (*queryResult)[0]["comments_disabled"].(bool); commentsDisabled {
+		return domain.ErrPostCommentsDisabled
+	}
+	comment.ReplyingTo = nil
+	if comment.ParentCommentId != "" {
+		err = cu.resolveReply(ctx, comment)
+		if err != nil {
+			return err
+		}
+	}
 	comment.Id = newCommentId
 	comment.UserId = userId
+	comment.Hashtags = domain.ExtractHashtags(comment.Comment)
+	comment.Mentions, err = domain.ResolveMentions(ctx, cu.userRepository, comment.Comment)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	postOwnerId, _ := (*queryResult)[0]["user_id"].(string)
+	comment.Hidden, err = cu.moderateComment(ctx, comment.Comment, userId, postOwnerId)
+	if err != nil {
+		return err
+	}
 	comment.CreatedDate = time.Now()
 	comment.UpdatedDate = comment.CreatedDate
 
-	cu.Lock()
-	err = cu.commentRepository.InsertComment(comment)
-	cu.Unlock()
+	err = cu.commentRepository.InsertComment(ctx, comment)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if comment.ParentCommentId != "" {
+		err = cu.commentRepository.IncrementCommentScore(ctx, comment.ParentCommentId, domain.CommentReplyScore)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+
+	err = cu.hashtagRepository.UpdateHashtagCommentCounts(ctx, comment.Hashtags, 1)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	userMentions := domain.NewUserMentions(comment.Mentions, comment.UserId, comment.PostId, comment.Id, "comment", comment.CreatedDate)
+	err = cu.mentionRepository.InsertMentions(ctx, userMentions)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	return nil
 }
 
-func (cu *commentUsecase) PutComment(comment *domain.Comment, tokenString string) error {
+// The post owner's own comments are never filtered.
+func (cu *commentUsecase) moderateComment(ctx context.Context, text string, userId string, postOwnerId string) (bool, error) {
+	if userId == postOwnerId {
+		return false, nil
+	}
+	postOwner, err := cu.userRepository.FindOneUser(ctx, bson.M{"_id": postOwnerId})
+	if err != nil {
+		return false, domain.ErrInternalServerError
+	}
+	commentFilter := postOwner.CommentFilter
+	if commentFilter == nil {
+		commentFilter = domain.DefaultCommentFilter()
+	}
+	filtered, err := cu.contentModerator.Moderate(ctx, text, commentFilter)
+	if err != nil {
+		return false, domain.ErrInternalServerError
+	}
+	if !filtered {
+		return false, nil
+	}
+	if commentFilter.Action == domain.CommentFilterActionReject {
+		return false, domain.ErrCommentRejected
+	}
+	return true, nil
+}
+
+// Replies to a reply are attached to the top level comment of its thread.
+func (cu *commentUsecase) resolveReply(ctx context.Context, comment *domain.Comment) error {
+	filter := bson.M{"_id": comment.ParentCommentId, "post_id": comment.PostId, "deleted_at": nil}
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrCommentNotFound
+	}
+	repliedComment, err := cu.commentRepository.FindOneComment(ctx, comment.ParentCommentId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if repliedComment.ParentCommentId != "" {
+		comment.ParentCommentId = repliedComment.ParentCommentId
+	}
+	repliedUser, err := cu.userRepository.FindOneUser(ctx, bson.M{"_id": repliedComment.UserId})
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	comment.ReplyingTo = domain.NewCommentReplyingTo(repliedUser.Id, repliedUser.Username)
+	return nil
+}
+
+func (cu *commentUsecase) PutComment(ctx context.Context, comment *domain.Comment, tokenString string) error {
 	userId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	filter := bson.M{"_id": comment.Id}
-	cu.Lock()
-	queryResult, err := cu.commentRepository.FindComments(filter)
-	cu.Unlock()
+	filter := bson.M{"_id": comment.Id, "deleted_at": nil}
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	if len(*queryResult) == 0 {
 		return domain.ErrCommentNotFound
 	}
-	cu.Lock()
-	willBeUpdatedComment, err := cu.commentRepository.FindOneComment(comment.Id)
-	cu.Unlock()
+	willBeUpdatedComment, err := cu.commentRepository.FindOneComment(ctx, comment.Id)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	if willBeUpdatedComment.UserId != userId {
 		return domain.ErrUnauthorizedCommentUpdate
 	}
+	if comment.Comment == willBeUpdatedComment.Comment {
+		return nil
+	}
+
+	post, err := cu.postRepository.FindOnePost(ctx, willBeUpdatedComment.PostId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	hidden, err := cu.moderateComment(ctx, comment.Comment, userId, post.UserId)
+	if err != nil {
+		return err
+	}
+
+	comment.Hashtags = domain.ExtractHashtags(comment.Comment)
+	comment.Mentions, err = domain.ResolveMentions(ctx, cu.userRepository, comment.Comment)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	revision := domain.NewCommentRevision(willBeUpdatedComment.Comment, willBeUpdatedComment.UpdatedDate, time.Now())
+	err = cu.commentRepository.UpdateComment(ctx, comment.Id, comment.Comment, comment.Hashtags, comment.Mentions, revision)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if hidden && !willBeUpdatedComment.Hidden {
+		err = cu.commentRepository.UpdateCommentHidden(ctx, comment.Id, true)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		if willBeUpdatedComment.Pinned {
+			err = cu.commentRepository.UpdateCommentPinned(ctx, comment.Id, false)
+			if err != nil {
+				return domain.ErrInternalServerError
+			}
+		}
+	}
+
+	addedHashtags, removedHashtags := domain.DiffHashtags(willBeUpdatedComment.Hashtags, comment.Hashtags)
+	err = cu.hashtagRepository.UpdateHashtagCommentCounts(ctx, addedHashtags, 1)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = cu.hashtagRepository.UpdateHashtagCommentCounts(ctx, removedHashtags, -1)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	err = cu.mentionRepository.DeleteMentions(ctx, comment.Id, "comment")
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	userMentions := domain.NewUserMentions(comment.Mentions, willBeUpdatedComment.UserId, willBeUpdatedComment.PostId, comment.Id, "comment", willBeUpdatedComment.CreatedDate)
+	err = cu.mentionRepository.InsertMentions(ctx, userMentions)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (cu *commentUsecase) FindCommentRevisions(ctx context.Context, postId string, commentId string, tokenString string) (*[]domain.CommentRevision, error) {
+	userId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": postId, "deleted_at": nil}
+	queryResult, err := cu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrPostNotFound
+	}
+	filter = bson.M{"_id": commentId, "post_id": postId, "deleted_at": nil}
+	queryResult, err = cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrCommentNotFound
+	}
+
+	comment, err := cu.commentRepository.FindOneComment(ctx, commentId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if comment.UserId != userId {
+		post, err := cu.postRepository.FindOnePost(ctx, postId)
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		if post.UserId != userId {
+			return nil, domain.ErrUnauthorizedCommentRevisions
+		}
+	}
 
-	cu.Lock()
-	err = cu.commentRepository.UpdateComment(comment.Id, comment.Comment)
-	cu.Unlock()
+	revisions := []domain.CommentRevision{}
+	revisions = append(revisions, comment.Revisions...)
+	return &revisions, nil
+}
+
+// A comment deleted by the post owner cannot be restored by its author.
+func (cu *commentUsecase) DeleteComment(ctx context.Context, commentId string, tokenString string) error {
+	userId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
+	filter := bson.M{"_id": commentId, "deleted_at": nil}
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrCommentNotFound
+	}
+
+	willBeDeletedComment, err := cu.commentRepository.FindOneComment(ctx, commentId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if willBeDeletedComment.UserId != userId {
+		post, err := cu.postRepository.FindOnePost(ctx, willBeDeletedComment.PostId)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		if post.UserId != userId {
+			return domain.ErrUnauthorizedCommentDelete
+		}
+	}
+
+	var replies []*domain.Comment
+	if willBeDeletedComment.ParentCommentId == "" {
+		filter = bson.M{"parent_comment_id": commentId, "deleted_at": nil}
+		replies, err = cu.findReplies(ctx, filter)
+		if err != nil {
+			return err
+		}
+	}
+
+	deletedAt := time.Now()
+	err = cu.commentRepository.UpdateCommentDeletedAt(ctx, commentId, &deletedAt)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if willBeDeletedComment.UserId != userId {
+		err = cu.commentRepository.UpdateCommentDeletedBy(ctx, commentId, userId)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	if willBeDeletedComment.Pinned {
+		err = cu.commentRepository.UpdateCommentPinned(ctx, commentId, false)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	if willBeDeletedComment.ParentCommentId != "" {
+		err = cu.commentRepository.IncrementCommentScore(ctx, willBeDeletedComment.ParentCommentId, -domain.CommentReplyScore)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	if len(replies) > 0 {
+		filter = bson.M{"parent_comment_id": commentId, "deleted_at": nil}
+		err = cu.commentRepository.UpdateCommentsDeletedAt(ctx, filter, &deletedAt)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+
+	for _, deletedComment := range append([]*domain.Comment{willBeDeletedComment}, replies...) {
+		err = cu.hashtagRepository.UpdateHashtagCommentCounts(ctx, deletedComment.Hashtags, -1)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+
+		err = cu.mentionRepository.DeleteMentions(ctx, deletedComment.Id, "comment")
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
 	return nil
 }
 
-func (cu *commentUsecase) DeleteComment(commentId string, tokenString string) error {
+func (cu *commentUsecase) PinComment(ctx context.Context, commentId string, tokenString string) error {
+	comment, err := cu.findModeratedComment(ctx, commentId, tokenString)
+	if err != nil {
+		return err
+	}
+	if comment.ParentCommentId != "" {
+		return domain.ErrReplyPin
+	}
+	if comment.Hidden {
+		return domain.ErrHiddenCommentPin
+	}
+	if comment.Pinned {
+		return domain.ErrCommentPinConflict
+	}
+
+	filter := bson.M{"post_id": comment.PostId, "pinned": true}
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	for _, v := range *queryResult {
+		err = cu.commentRepository.UpdateCommentPinned(ctx, fmt.Sprintf("%v", v["_id"]), false)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	err = cu.commentRepository.UpdateCommentPinned(ctx, commentId, true)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (cu *commentUsecase) UnpinComment(ctx context.Context, commentId string, tokenString string) error {
+	comment, err := cu.findModeratedComment(ctx, commentId, tokenString)
+	if err != nil {
+		return err
+	}
+	if !comment.Pinned {
+		return domain.ErrCommentNotPinned
+	}
+
+	err = cu.commentRepository.UpdateCommentPinned(ctx, commentId, false)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (cu *commentUsecase) HideComment(ctx context.Context, commentId string, tokenString string) error {
+	comment, err := cu.findModeratedComment(ctx, commentId, tokenString)
+	if err != nil {
+		return err
+	}
+	if comment.Hidden {
+		return domain.ErrCommentHideConflict
+	}
+
+	err = cu.commentRepository.UpdateCommentHidden(ctx, commentId, true)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if comment.Pinned {
+		err = cu.commentRepository.UpdateCommentPinned(ctx, commentId, false)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	return nil
+}
+
+func (cu *commentUsecase) UnhideComment(ctx context.Context, commentId string, tokenString string) error {
+	comment, err := cu.findModeratedComment(ctx, commentId, tokenString)
+	if err != nil {
+		return err
+	}
+	if !comment.Hidden {
+		return domain.ErrCommentNotHidden
+	}
+
+	err = cu.commentRepository.UpdateCommentHidden(ctx, commentId, false)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (cu *commentUsecase) findModeratedComment(ctx context.Context, commentId string, tokenString string) (*domain.Comment, error) {
+	userId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": commentId, "deleted_at": nil}
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrCommentNotFound
+	}
+
+	comment, err := cu.commentRepository.FindOneComment(ctx, commentId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	post, err := cu.postRepository.FindOnePost(ctx, comment.PostId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if post.UserId != userId {
+		return nil, domain.ErrUnauthorizedCommentModerate
+	}
+	return comment, nil
+}
+
+func (cu *commentUsecase) findReplies(ctx context.Context, filter bson.M) ([]*domain.Comment, error) {
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	var replies []*domain.Comment
+	for _, v := range *queryResult {
+		reply, err := cu.commentRepository.FindOneComment(ctx, fmt.Sprintf("%v", v["_id"]))
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		replies = append(replies, reply)
+	}
+	return replies, nil
+}
+
+// A reply cannot be restored while its parent is deleted.
+func (cu *commentUsecase) RestoreComment(ctx context.Context, commentId string, tokenString string) error {
 	userId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	filter := bson.M{"_id": commentId}
-	cu.Lock()
-	queryResult, err := cu.commentRepository.FindComments(filter)
-	cu.Unlock()
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
@@ -151,21 +803,189 @@ func (cu *commentUsecase) DeleteComment(commentId string, tokenString string) er
 		return domain.ErrCommentNotFound
 	}
 
-	cu.Lock()
-	willBeDeletedComment, err := cu.commentRepository.FindOneComment(commentId)
-	cu.Unlock()
+	willBeRestoredComment, err := cu.commentRepository.FindOneComment(ctx, commentId)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	if willBeDeletedComment.UserId != userId {
-		return domain.ErrUnauthorizedCommentDelete
+	if willBeRestoredComment.UserId != userId || willBeRestoredComment.DeletedBy != "" {
+		return domain.ErrUnauthorizedCommentRestore
+	}
+	if willBeRestoredComment.DeletedAt == nil {
+		return domain.ErrCommentNotDeleted
+	}
+	if time.Since(*willBeRestoredComment.DeletedAt) > domain.TrashRetention {
+		return domain.ErrCommentNotFound
+	}
+	filter = bson.M{"_id": willBeRestoredComment.PostId, "deleted_at": nil}
+	postQueryResult, err := cu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*postQueryResult) == 0 {
+		return domain.ErrCommentPostDeleted
+	}
+
+	var replies []*domain.Comment
+	if willBeRestoredComment.ParentCommentId != "" {
+		filter = bson.M{"_id": willBeRestoredComment.ParentCommentId, "deleted_at": nil}
+		queryResult, err = cu.commentRepository.FindComments(ctx, filter)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		if len(*queryResult) == 0 {
+			return domain.ErrCommentParentDeleted
+		}
+	} else {
+		filter = bson.M{"parent_comment_id": commentId, "deleted_at": willBeRestoredComment.DeletedAt}
+		replies, err = cu.findReplies(ctx, filter)
+		if err != nil {
+			return err
+		}
 	}
 
-	cu.Lock()
-	err = cu.commentRepository.DeleteComment(commentId)
-	cu.Unlock()
+	err = cu.commentRepository.UpdateCommentDeletedAt(ctx, commentId, nil)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
+	if willBeRestoredComment.ParentCommentId != "" {
+		err = cu.commentRepository.IncrementCommentScore(ctx, willBeRestoredComment.ParentCommentId, domain.CommentReplyScore)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	if len(replies) > 0 {
+		filter = bson.M{"parent_comment_id": commentId, "deleted_at": willBeRestoredComment.DeletedAt}
+		err = cu.commentRepository.UpdateCommentsDeletedAt(ctx, filter, nil)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+
+	for _, restoredComment := range append([]*domain.Comment{willBeRestoredComment}, replies...) {
+		err = cu.hashtagRepository.UpdateHashtagCommentCounts(ctx, restoredComment.Hashtags, 1)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+
+		userMentions := domain.NewUserMentions(restoredComment.Mentions, restoredComment.UserId, restoredComment.PostId, restoredComment.Id, "comment", restoredComment.CreatedDate)
+		err = cu.mentionRepository.InsertMentions(ctx, userMentions)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	return nil
+}
+
+func (cu *commentUsecase) FindDeletedComments(ctx context.Context, userId string, tokenString string) (*[]domain.Comment, error) {
+	callerId, err := cu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if callerId != userId {
+		return nil, domain.ErrUnauthorizedTrashView
+	}
+	filter := bson.M{"user_id": userId, "deleted_at": bson.M{"$gt": time.Now().Add(-domain.TrashRetention)}, "deleted_by": nil}
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	queryResult, err = cu.withoutTrashedPostComments(ctx, queryResult)
+	if err != nil {
+		return nil, err
+	}
+	return cu.buildComments(ctx, queryResult)
+}
+
+// Comments trashed along with their post come back with it, not on their own.
+func (cu *commentUsecase) withoutTrashedPostComments(ctx context.Context, queryResult *[]bson.M) (*[]bson.M, error) {
+	if len(*queryResult) == 0 {
+		return queryResult, nil
+	}
+	var postIds []string
+	for _, v := range *queryResult {
+		postIds = append(postIds, fmt.Sprintf("%v", v["post_id"]))
+	}
+	filter := bson.M{"_id": bson.M{"$in": postIds}, "deleted_at": bson.M{"$ne": nil}}
+	postQueryResult, err := cu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	trashedPostIds := make(map[string]bool, len(*postQueryResult))
+	for _, v := range *postQueryResult {
+		trashedPostIds[fmt.Sprintf("%v", v["_id"])] = true
+	}
+	comments := []bson.M{}
+	for _, v := range *queryResult {
+		if !trashedPostIds[fmt.Sprintf("%v", v["post_id"])] {
+			comments = append(comments, v)
+		}
+	}
+	return &comments, nil
+}
+
+func (cu *commentUsecase) PurgeDeletedComments(ctx context.Context) error {
+	filter := bson.M{"deleted_at": bson.M{"$lte": time.Now().Add(-domain.TrashRetention)}}
+	queryResult, err := cu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	for _, v := range *queryResult {
+		commentId := fmt.Sprintf("%v", v["_id"])
+		err = cu.likeRepository.DeleteLikes(ctx, bson.M{"resource_id": commentId, "resource_type": "comment"})
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		err = cu.commentRepository.DeleteComment(ctx, commentId)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
 	return nil
 }
+
+func (cu *commentUsecase) MigrateCommentScores(ctx context.Context) error {
+	filter := bson.M{"score": bson.M{"$exists": false}}
+	for {
+		queryResult, err := cu.commentRepository.FindPaginatedComments(ctx, filter, 0, domain.CommentScoreMigrationBatch)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		if len(*queryResult) == 0 {
+			return nil
+		}
+		var commentIds, topLevelIds []string
+		for _, v := range *queryResult {
+			commentId := fmt.Sprintf("%v", v["_id"])
+			commentIds = append(commentIds, commentId)
+			if _, ok := v["parent_comment_id"].(string); !ok {
+				topLevelIds = append(topLevelIds, commentId)
+			}
+		}
+		reactionCounts, err := cu.likeRepository.CountReactions(ctx, domain.LikeResourceComment, commentIds)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		replyCounts := map[string]int{}
+		if len(topLevelIds) > 0 {
+			replyCounts, err = cu.commentRepository.CountReplies(ctx, topLevelIds)
+			if err != nil {
+				return domain.ErrInternalServerError
+			}
+		}
+		scores := make(map[string]int, len(commentIds))
+		for _, commentId := range commentIds {
+			score := replyCounts[commentId] * domain.CommentReplyScore
+			for _, count := range reactionCounts[commentId] {
+				score += count * domain.CommentLikeScore
+			}
+			scores[commentId] = score
+		}
+		err = cu.commentRepository.UpdateCommentScores(ctx, scores)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		if len(*queryResult) < domain.CommentScoreMigrationBatch {
+			return nil
+		}
+	}
+}
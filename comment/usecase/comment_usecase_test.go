@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"context"
 	"errors"
 	"instagram-go/comment/usecase"
 	"instagram-go/domain"
@@ -24,6 +25,10 @@ type CommentUsecaseSuite struct {
 	commentRepository *mocks.CommentRepository
 	postRepository    *mocks.PostRepository
 	likeRepository    *mocks.LikeRepository
+	hashtagRepository *mocks.HashtagRepository
+	userRepository    *mocks.UserRepository
+	mentionRepository *mocks.MentionRepository
+	contentModerator  *mocks.ContentModerator
 	headerHelper      *mocks.IHeaderHelper
 }
 
@@ -31,95 +36,253 @@ func (cu *CommentUsecaseSuite) SetupTest() {
 	cu.commentRepository = new(mocks.CommentRepository)
 	cu.postRepository = new(mocks.PostRepository)
 	cu.likeRepository = new(mocks.LikeRepository)
+	cu.hashtagRepository = new(mocks.HashtagRepository)
+	cu.userRepository = new(mocks.UserRepository)
+	cu.mentionRepository = new(mocks.MentionRepository)
+	cu.contentModerator = new(mocks.ContentModerator)
 	cu.headerHelper = new(mocks.IHeaderHelper)
 }
 
+func (cu *CommentUsecaseSuite) TestFindCommentInvalidPagination() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, _, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortOldest, "", 0, "token1")
+
+	expectedError := domain.ErrInvalidPagination.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentInvalidSort() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, _, err := commentUsecase.FindComments(context.TODO(), "postid1", "popular", "", 20, "token1")
+
+	expectedError := domain.ErrInvalidCommentSort.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
 func (cu *CommentUsecaseSuite) TestFindCommentFindPostError() {
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	_, err := commentUsecase.FindComments("postid1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, _, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortOldest, "", 20, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
 func (cu *CommentUsecaseSuite) TestFindCommentPostNotFound() {
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	_, err := commentUsecase.FindComments("postid1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, _, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortOldest, "", 20, "token1")
 
 	expectedError := domain.ErrPostNotFound.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
+func (cu *CommentUsecaseSuite) TestFindCommentInvalidCursor() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, _, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortTop, "not a cursor", 20, "token1")
+
+	expectedError := domain.ErrInvalidCursor.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
 func (cu *CommentUsecaseSuite) TestFindCommentFindCommentsError() {
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(nil, errors.New("FindComments return error"))
+	cu.commentRepository.On("FindSortedComments", mock.Anything, mock.AnythingOfType("M"), mock.Anything, mock.AnythingOfType("int64")).Return(nil, errors.New("FindSortedComments return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	_, err := commentUsecase.FindComments("postid1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, _, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortOldest, "", 20, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
-func (cu *CommentUsecaseSuite) TestFindCommentFindLikesError() {
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+func (cu *CommentUsecaseSuite) TestFindCommentCountReactionsError() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindSortedComments", mock.Anything, mock.AnythingOfType("M"), mock.Anything, mock.AnythingOfType("int64")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 		{"_id": "commentid2", "post_id": "postid1", "user_id": "userid1", "comment": "comment2",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, []string{"commentid1", "commentid2"}).Return(nil, errors.New("CountReactions return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	_, err := commentUsecase.FindComments("postid1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, _, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortOldest, "", 20, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
 }
 
 func (cu *CommentUsecaseSuite) TestFindCommentSuccessful() {
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindSortedComments", mock.Anything, mock.AnythingOfType("M"), mock.Anything, int64(21)).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 		{"_id": "commentid2", "post_id": "postid1", "user_id": "userid1", "comment": "comment2",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, []string{"commentid1", "commentid2"}).Return(map[string]map[string]int{
+		"commentid2": {domain.ReactionHeart: 2, "laugh": 1},
+	}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, []string{"commentid1", "commentid2"}).Return(map[string]int{"commentid1": 3}, nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	comments, err := commentUsecase.FindComments("postid1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	comments, nextCursor, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortOldest, "", 20, "token1")
 
 	assert.NoErrorf(cu.T(), err, "Should not have return error but got %s", err)
 	assert.Equal(cu.T(), 2, len(*comments), "Should have return 2 comments")
 	assert.Equal(cu.T(), "commentid1", (*comments)[0].Id, "The first id should be correct")
 	assert.Equal(cu.T(), "commentid2", (*comments)[1].Id, "The first id should be correct")
+	assert.Equalf(cu.T(), 3, (*comments)[0].ReplyCount, "Should have return reply count %d but got %d", 3, (*comments)[0].ReplyCount)
+	assert.Equalf(cu.T(), 2, (*comments)[1].LikeCount, "Should have return like count %d but got %d", 2, (*comments)[1].LikeCount)
+	assert.Empty(cu.T(), nextCursor, "Should not have return a next cursor on the last page")
+	cu.likeRepository.AssertNumberOfCalls(cu.T(), "CountReactions", 1)
+	cu.commentRepository.AssertNumberOfCalls(cu.T(), "CountReplies", 1)
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentViewerState() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindSortedComments", mock.Anything, mock.AnythingOfType("M"), mock.Anything, int64(21)).Return(&[]bson.M{
+		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid2", "comment": "comment1",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+		{"_id": "commentid2", "post_id": "postid1", "user_id": "userid2", "comment": "comment2",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	viewerLikesFilter := bson.M{"user_id": "userid1", "resource_type": domain.LikeResourceComment, "resource_id": bson.M{"$in": []string{"commentid1", "commentid2"}}}
+	cu.likeRepository.On("FindLikes", mock.Anything, viewerLikesFilter).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid2", "resource_type": "comment"},
+	}, nil)
+	cu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, mock.Anything).Return(map[string]map[string]int{}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	comments, _, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortOldest, "", 20, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should not have return error but got %s", err)
+	assert.Falsef(cu.T(), *(*comments)[0].ViewerHasLiked, "Should have return that the viewer did not like the first comment")
+	assert.Truef(cu.T(), *(*comments)[1].ViewerHasLiked, "Should have return that the viewer liked the second comment")
+	assert.Equalf(cu.T(), domain.ReactionHeart, (*comments)[1].ViewerReaction, "Should have return the viewer reaction %s but got %s", domain.ReactionHeart, (*comments)[1].ViewerReaction)
+	cu.likeRepository.AssertCalled(cu.T(), "FindLikes", mock.Anything, viewerLikesFilter)
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentNextPageSuccessful() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindSortedComments", mock.Anything, mock.AnythingOfType("M"), mock.Anything, int64(2)).Return(&[]bson.M{
+		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1", "score": int32(3),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+		{"_id": "commentid2", "post_id": "postid1", "user_id": "userid1", "comment": "comment2", "score": int32(1),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil).Once()
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, mock.Anything).Return(map[string]map[string]int{}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	comments, nextCursor, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortTop, "", 1, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should not have return error but got %s", err)
+	assert.Equal(cu.T(), 1, len(*comments), "Should have return 1 comment")
+	assert.Equal(cu.T(), "commentid1", (*comments)[0].Id, "The first id should be correct")
+	assert.NotEmpty(cu.T(), nextCursor, "Should have return a next cursor")
+
+	cu.commentRepository.On("FindSortedComments", mock.Anything, mock.MatchedBy(func(filter bson.M) bool {
+		conditions, ok := filter["$or"].(bson.A)
+		return ok && len(conditions) == 4 && conditions[0].(bson.M)["score"] == nil
+	}), mock.Anything, int64(2)).Return(&[]bson.M{}, nil).Once()
+
+	comments, nextCursor, err = commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortTop, nextCursor, 1, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should not have return error but got %s", err)
+	assert.Equal(cu.T(), 0, len(*comments), "Should have return no comments")
+	assert.Empty(cu.T(), nextCursor, "Should not have return a next cursor on the last page")
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentNextPageAfterUnscoredComment() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	createdDate := time.Now()
+	cu.commentRepository.On("FindSortedComments", mock.Anything, mock.AnythingOfType("M"), mock.Anything, int64(2)).Return(&[]bson.M{
+		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
+			"created_date": primitive.NewDateTimeFromTime(createdDate), "updated_date": primitive.NewDateTimeFromTime(createdDate)},
+		{"_id": "commentid2", "post_id": "postid1", "user_id": "userid1", "comment": "comment2",
+			"created_date": primitive.NewDateTimeFromTime(createdDate), "updated_date": primitive.NewDateTimeFromTime(createdDate)},
+	}, nil).Once()
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, mock.Anything).Return(map[string]map[string]int{}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, nextCursor, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortTop, "", 1, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should not have return error but got %s", err)
+	assert.NotEmpty(cu.T(), nextCursor, "Should have return a next cursor")
+
+	cu.commentRepository.On("FindSortedComments", mock.Anything, mock.MatchedBy(func(filter bson.M) bool {
+		conditions, ok := filter["$or"].(bson.A)
+		if !ok || len(conditions) != 2 {
+			return false
+		}
+		sameScore, _ := conditions[0].(bson.M)
+		_, hasScore := sameScore["score"]
+		return hasScore && sameScore["score"] == nil
+	}), mock.Anything, int64(2)).Return(&[]bson.M{}, nil).Once()
+
+	_, _, err = commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortTop, nextCursor, 1, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should not have return error but got %s", err)
 }
 
 func (cu *CommentUsecaseSuite) TestPostCommentGetUserIdFromTokenError() {
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PostComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -128,10 +291,10 @@ func (cu *CommentUsecaseSuite) TestPostCommentGetUserIdFromTokenError() {
 func (cu *CommentUsecaseSuite) TestPostCommentFindPostsError() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PostComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -139,60 +302,97 @@ func (cu *CommentUsecaseSuite) TestPostCommentFindPostsError() {
 func (cu *CommentUsecaseSuite) TestPostCommentPostNotFound() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.postRepository.On("FindPosts", mock.Anything, domain.VisiblePostFilter(bson.M{"_id": "postid1"}, "userid1")).Return(&[]bson.M{}, nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PostComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrPostNotFound.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
 }
 
+func (cu *CommentUsecaseSuite) TestPostCommentPostCommentsDisabled() {
+	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "comments_disabled": true}}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
+
+	expectedError := domain.ErrPostCommentsDisabled.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	cu.commentRepository.AssertNotCalled(cu.T(), "InsertComment", mock.Anything, mock.Anything)
+}
+
 func (cu *CommentUsecaseSuite) TestPostCommentInsertCommentError() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("InsertComment", mock.AnythingOfType("*domain.Comment")).Return(errors.New("InsertComment return error"))
+	cu.commentRepository.On("InsertComment", mock.Anything, mock.AnythingOfType("*domain.Comment")).Return(errors.New("InsertComment return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PostComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
 }
 
+func (cu *CommentUsecaseSuite) TestPostCommentUpdateHashtagCommentCountsError() {
+	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1 #golang", 0, time.Now(), time.Now())
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid1"}}, nil)
+	cu.commentRepository.On("InsertComment", mock.Anything, mock.AnythingOfType("*domain.Comment")).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, []string{"golang"}, 1).Return(errors.New("UpdateHashtagCommentCounts return error"))
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
 func (cu *CommentUsecaseSuite) TestPostCommentInsertCommentSuccessful() {
-	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
+	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1 #Golang #golang @username2", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("InsertComment", mock.AnythingOfType("*domain.Comment")).Return(nil)
+	cu.commentRepository.On("InsertComment", mock.Anything, mock.AnythingOfType("*domain.Comment")).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, []string{"golang"}, 1).Return(nil)
+	cu.userRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "userid2", "username": "username2"}}, nil)
+	cu.mentionRepository.On("InsertMentions", mock.Anything, mock.AnythingOfType("[]domain.UserMention")).Return(nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PostComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
 
 	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cu.T(), []string{"golang"}, comment.Hashtags, "Should have set hashtags %v but got %v", []string{"golang"}, comment.Hashtags)
+	cu.hashtagRepository.AssertCalled(cu.T(), "UpdateHashtagCommentCounts", mock.Anything, []string{"golang"}, 1)
+	expectedMentions := []domain.Mention{*domain.NewMention("userid2", "username2", 25, 10)}
+	assert.Equalf(cu.T(), expectedMentions, comment.Mentions, "Should have set mentions %v but got %v", expectedMentions, comment.Mentions)
+	cu.mentionRepository.AssertCalled(cu.T(), "InsertMentions", mock.Anything, mock.MatchedBy(func(userMentions []domain.UserMention) bool {
+		return len(userMentions) == 1 && userMentions[0].UserId == "userid2" && userMentions[0].PostId == "postid1" && userMentions[0].ResourceType == "comment"
+	}))
 }
 
 func (cu *CommentUsecaseSuite) TestPutCommentGetUserIdFromTokenError() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PutComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -201,10 +401,10 @@ func (cu *CommentUsecaseSuite) TestPutCommentGetUserIdFromTokenError() {
 func (cu *CommentUsecaseSuite) TestPutCommentFindCommentError() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(nil, errors.New("FindComments return error"))
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindComments return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PutComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -213,10 +413,10 @@ func (cu *CommentUsecaseSuite) TestPutCommentFindCommentError() {
 func (cu *CommentUsecaseSuite) TestPutCommentCommentNotFound() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PutComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrCommentNotFound.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -224,14 +424,14 @@ func (cu *CommentUsecaseSuite) TestPutCommentCommentNotFound() {
 func (cu *CommentUsecaseSuite) TestPutCommentFindOneCommentError() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindOneComment", mock.AnythingOfType("string")).Return(nil, errors.New("FindOneComment return error"))
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("FindOneComment return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PutComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -240,63 +440,204 @@ func (cu *CommentUsecaseSuite) TestPutCommentFindOneCommentError() {
 func (cu *CommentUsecaseSuite) TestPutCommentUnauthorizedCommentUpdate() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindOneComment", mock.AnythingOfType("string")).Return(domain.NewComment(
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewComment(
 		"commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now(),
 	), nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PutComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrUnauthorizedCommentUpdate.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
 }
 
 func (cu *CommentUsecaseSuite) TestPutCommentUpdateCommentError() {
-	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
+	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment2", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindOneComment", mock.AnythingOfType("string")).Return(domain.NewComment(
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewComment(
 		"commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now(),
 	), nil)
-	cu.commentRepository.On("UpdateComment", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(errors.New("UpdateComment return error"))
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.commentRepository.On("UpdateComment", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.Anything, mock.AnythingOfType("*domain.CommentRevision")).Return(errors.New("UpdateComment return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PutComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
 }
 
-func (cu *CommentUsecaseSuite) TestPutCommentUpdateCommentSuccessful() {
+func (cu *CommentUsecaseSuite) TestPutCommentUnchangedComment() {
 	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(domain.NewComment(
+		"commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now(),
+	), nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertNotCalled(cu.T(), "UpdateComment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestPutCommentUpdateCommentSuccessful() {
+	comment := domain.NewComment("commentid1", "postid1", "userid1", "comment1 #golang #gopher", 0, time.Now(), time.Now())
+	oldComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1 #golang #mongodb", 0, time.Now(), time.Now())
+	oldComment.Hashtags = []string{"golang", "mongodb"}
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindOneComment", mock.AnythingOfType("string")).Return(domain.NewComment(
-		"commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now(),
-	), nil)
-	cu.commentRepository.On("UpdateComment", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(oldComment, nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.userRepository.On("FindOneUser", mock.Anything, bson.M{"_id": "userid2"}).Return(domain.NewUser("userid2", "username2", "", "", "", nil), nil)
+	cu.contentModerator.On("Moderate", mock.Anything, "comment1 #golang #gopher", domain.DefaultCommentFilter()).Return(false, nil)
+	cu.commentRepository.On("UpdateComment", mock.Anything, "commentid1", "comment1 #golang #gopher", []string{"golang", "gopher"}, []domain.Mention(nil), mock.MatchedBy(func(revision *domain.CommentRevision) bool {
+		return revision.Comment == "comment1 #golang #mongodb" && revision.CreatedDate.Equal(oldComment.UpdatedDate)
+	})).Return(nil)
+	cu.mentionRepository.On("DeleteMentions", mock.Anything, "commentid1", "comment").Return(nil)
+	cu.mentionRepository.On("InsertMentions", mock.Anything, []domain.UserMention(nil)).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, []string{"gopher"}, 1).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, []string{"mongodb"}, -1).Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.hashtagRepository.AssertCalled(cu.T(), "UpdateHashtagCommentCounts", mock.Anything, []string{"gopher"}, 1)
+	cu.hashtagRepository.AssertCalled(cu.T(), "UpdateHashtagCommentCounts", mock.Anything, []string{"mongodb"}, -1)
+	cu.mentionRepository.AssertCalled(cu.T(), "DeleteMentions", mock.Anything, "commentid1", "comment")
+	cu.commentRepository.AssertNotCalled(cu.T(), "UpdateCommentHidden", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestPutCommentRejected() {
+	comment := domain.NewComment("commentid1", "postid1", "userid1", "you l0s3r", 0, time.Now(), time.Now())
+	postOwner := domain.NewUser("userid2", "username2", "", "", "", nil)
+	postOwner.CommentFilter = domain.NewCommentFilter([]string{"loser"}, false, domain.CommentFilterActionReject)
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.userRepository.On("FindOneUser", mock.Anything, bson.M{"_id": "userid2"}).Return(postOwner, nil)
+	cu.contentModerator.On("Moderate", mock.Anything, "you l0s3r", postOwner.CommentFilter).Return(true, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
+
+	expectedError := domain.ErrCommentRejected.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	cu.commentRepository.AssertNotCalled(cu.T(), "UpdateComment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestPutCommentHiddenSuccessful() {
+	comment := domain.NewComment("commentid1", "postid1", "userid1", "you l0s3r", 0, time.Now(), time.Now())
+	oldComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
+	oldComment.Pinned = true
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(oldComment, nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.userRepository.On("FindOneUser", mock.Anything, bson.M{"_id": "userid2"}).Return(domain.NewUser("userid2", "username2", "", "", "", nil), nil)
+	cu.contentModerator.On("Moderate", mock.Anything, "you l0s3r", domain.DefaultCommentFilter()).Return(true, nil)
+	cu.commentRepository.On("UpdateComment", mock.Anything, "commentid1", "you l0s3r", mock.Anything, mock.Anything, mock.AnythingOfType("*domain.CommentRevision")).Return(nil)
+	cu.commentRepository.On("UpdateCommentHidden", mock.Anything, "commentid1", true).Return(nil)
+	cu.commentRepository.On("UpdateCommentPinned", mock.Anything, "commentid1", false).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	cu.mentionRepository.On("DeleteMentions", mock.Anything, "commentid1", "comment").Return(nil)
+	cu.mentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PutComment(context.TODO(), comment, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentHidden", mock.Anything, "commentid1", true)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentPinned", mock.Anything, "commentid1", false)
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentRevisionsPostNotFound() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, err := commentUsecase.FindCommentRevisions(context.TODO(), "postid1", "commentid1", "token1")
+
+	expectedError := domain.ErrPostNotFound.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentRevisionsCommentNotFound() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, err := commentUsecase.FindCommentRevisions(context.TODO(), "postid1", "commentid1", "token1")
+
+	expectedError := domain.ErrCommentNotFound.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentRevisionsUnauthorized() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid3", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.PutComment(comment, "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, err := commentUsecase.FindCommentRevisions(context.TODO(), "postid1", "commentid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedCommentRevisions.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentRevisionsPostOwnerSuccessful() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	comment := domain.NewComment("commentid1", "postid1", "userid2", "comment2", 0, time.Now(), time.Now())
+	comment.Revisions = []domain.CommentRevision{*domain.NewCommentRevision("comment1", time.Now(), time.Now())}
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(comment, nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	revisions, err := commentUsecase.FindCommentRevisions(context.TODO(), "postid1", "commentid1", "token1")
 
 	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cu.T(), 1, len(*revisions), "Should have return %d revision but got %d", 1, len(*revisions))
+	assert.Equalf(cu.T(), "comment1", (*revisions)[0].Comment, "Should have return revision %s but got %s", "comment1", (*revisions)[0].Comment)
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentRevisionsAuthorSuccessful() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now()), nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	revisions, err := commentUsecase.FindCommentRevisions(context.TODO(), "postid1", "commentid1", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cu.T(), 0, len(*revisions), "Should have return %d revisions but got %d", 0, len(*revisions))
+	cu.postRepository.AssertNotCalled(cu.T(), "FindOnePost", mock.Anything, mock.Anything)
 }
 
 func (cu *CommentUsecaseSuite) TestDeleteCommentGetUserIdFromTokenError() {
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.DeleteComment("commentid1", "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -304,10 +645,10 @@ func (cu *CommentUsecaseSuite) TestDeleteCommentGetUserIdFromTokenError() {
 
 func (cu *CommentUsecaseSuite) TestDeleteCommentFindCommentsError() {
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(nil, errors.New("FindComments return error"))
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindComments return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.DeleteComment("commentid1", "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -315,10 +656,10 @@ func (cu *CommentUsecaseSuite) TestDeleteCommentFindCommentsError() {
 
 func (cu *CommentUsecaseSuite) TestDeleteCommentCommentNotFound() {
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.DeleteComment("commentid1", "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
 
 	expectedError := domain.ErrCommentNotFound.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -326,14 +667,14 @@ func (cu *CommentUsecaseSuite) TestDeleteCommentCommentNotFound() {
 
 func (cu *CommentUsecaseSuite) TestDeleteCommentFindOneCommentError() {
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindOneComment", mock.AnythingOfType("string")).Return(nil, errors.New("FindOneComment return error"))
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("FindOneComment return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.DeleteComment("commentid1", "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -341,34 +682,35 @@ func (cu *CommentUsecaseSuite) TestDeleteCommentFindOneCommentError() {
 
 func (cu *CommentUsecaseSuite) TestDeleteCommentUnauthorizedCommentDelete() {
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid2", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindOneComment", mock.AnythingOfType("string")).Return(domain.NewComment(
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewComment(
 		"commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now(),
 	), nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid3", nil, "caption1", 0, time.Now(), time.Now()), nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.DeleteComment("commentid1", "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
 
 	expectedError := domain.ErrUnauthorizedCommentDelete.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
 }
 
-func (cu *CommentUsecaseSuite) TestDeleteCommentDeleteCommentError() {
+func (cu *CommentUsecaseSuite) TestDeleteCommentUpdateCommentDeletedAtError() {
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindOneComment", mock.AnythingOfType("string")).Return(domain.NewComment(
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewComment(
 		"commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now(),
 	), nil)
-	cu.commentRepository.On("DeleteComment", mock.AnythingOfType("string")).Return(errors.New("DeleteComment return error"))
+	cu.commentRepository.On("UpdateCommentDeletedAt", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("*time.Time")).Return(errors.New("UpdateCommentDeletedAt return error"))
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.DeleteComment("commentid1", "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
@@ -376,17 +718,584 @@ func (cu *CommentUsecaseSuite) TestDeleteCommentDeleteCommentError() {
 
 func (cu *CommentUsecaseSuite) TestDeleteCommentSuccessful() {
 	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	cu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"parent_comment_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	cu.commentRepository.On("FindOneComment", mock.AnythingOfType("string")).Return(domain.NewComment(
+	deletedComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1 #golang", 0, time.Now(), time.Now())
+	deletedComment.Hashtags = []string{"golang"}
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(deletedComment, nil)
+	cu.commentRepository.On("UpdateCommentDeletedAt", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("*time.Time")).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, []string{"golang"}, -1).Return(nil)
+	cu.mentionRepository.On("DeleteMentions", mock.Anything, "commentid1", "comment").Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.hashtagRepository.AssertCalled(cu.T(), "UpdateHashtagCommentCounts", mock.Anything, []string{"golang"}, -1)
+	cu.mentionRepository.AssertCalled(cu.T(), "DeleteMentions", mock.Anything, "commentid1", "comment")
+}
+
+func (cu *CommentUsecaseSuite) TestPostCommentRejected() {
+	comment := domain.NewComment("", "postid1", "", "you l0s3r", 0, time.Now(), time.Now())
+	postOwner := domain.NewUser("userid2", "username2", "", "", "", nil)
+	postOwner.CommentFilter = domain.NewCommentFilter([]string{}, true, domain.CommentFilterActionReject)
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid2"}}, nil)
+	cu.userRepository.On("FindOneUser", mock.Anything, bson.M{"_id": "userid2"}).Return(postOwner, nil)
+	cu.contentModerator.On("Moderate", mock.Anything, "you l0s3r", postOwner.CommentFilter).Return(true, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
+
+	expectedError := domain.ErrCommentRejected.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	cu.commentRepository.AssertNotCalled(cu.T(), "InsertComment", mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestPostCommentModerateError() {
+	comment := domain.NewComment("", "postid1", "", "comment1", 0, time.Now(), time.Now())
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid2"}}, nil)
+	cu.userRepository.On("FindOneUser", mock.Anything, bson.M{"_id": "userid2"}).Return(domain.NewUser("userid2", "username2", "", "", "", nil), nil)
+	cu.contentModerator.On("Moderate", mock.Anything, "comment1", domain.DefaultCommentFilter()).Return(false, errors.New("Moderate return error"))
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (cu *CommentUsecaseSuite) TestPostCommentHiddenSuccessful() {
+	comment := domain.NewComment("", "postid1", "", "you l0s3r", 0, time.Now(), time.Now())
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid2"}}, nil)
+	cu.userRepository.On("FindOneUser", mock.Anything, bson.M{"_id": "userid2"}).Return(domain.NewUser("userid2", "username2", "", "", "", nil), nil)
+	cu.contentModerator.On("Moderate", mock.Anything, "you l0s3r", domain.DefaultCommentFilter()).Return(true, nil)
+	cu.commentRepository.On("InsertComment", mock.Anything, mock.MatchedBy(func(comment *domain.Comment) bool {
+		return comment.Hidden
+	})).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, mock.Anything, 1).Return(nil)
+	cu.mentionRepository.On("InsertMentions", mock.Anything, mock.AnythingOfType("[]domain.UserMention")).Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	assert.Truef(cu.T(), comment.Hidden, "Should have hidden the comment")
+}
+
+func (cu *CommentUsecaseSuite) TestPostCommentReplyToReplySuccessful() {
+	comment := domain.NewComment("", "postid1", "", "thanks", 0, time.Now(), time.Now())
+	comment.ParentCommentId = "commentid2"
+	repliedComment := domain.NewComment("commentid2", "postid1", "userid2", "nice", 0, time.Now(), time.Now())
+	repliedComment.ParentCommentId = "commentid1"
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid2"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid2", "post_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid2"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid2").Return(repliedComment, nil)
+	cu.userRepository.On("FindOneUser", mock.Anything, bson.M{"_id": "userid2"}).Return(domain.NewUser("userid2", "username2", "", "", "", nil), nil)
+	cu.contentModerator.On("Moderate", mock.Anything, "thanks", domain.DefaultCommentFilter()).Return(false, nil)
+	cu.commentRepository.On("InsertComment", mock.Anything, mock.AnythingOfType("*domain.Comment")).Return(nil)
+	cu.commentRepository.On("IncrementCommentScore", mock.Anything, "commentid1", domain.CommentReplyScore).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, mock.Anything, 1).Return(nil)
+	cu.mentionRepository.On("InsertMentions", mock.Anything, mock.AnythingOfType("[]domain.UserMention")).Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "IncrementCommentScore", mock.Anything, "commentid1", domain.CommentReplyScore)
+	assert.Equalf(cu.T(), "commentid1", comment.ParentCommentId, "Should have replied to %s but got %s", "commentid1", comment.ParentCommentId)
+	assert.Equalf(cu.T(), domain.NewCommentReplyingTo("userid2", "username2"), comment.ReplyingTo, "Should have replied to %v but got %v", domain.NewCommentReplyingTo("userid2", "username2"), comment.ReplyingTo)
+}
+
+func (cu *CommentUsecaseSuite) TestPostCommentReplyParentNotFound() {
+	comment := domain.NewComment("", "postid1", "", "thanks", 0, time.Now(), time.Now())
+	comment.ParentCommentId = "commentid1"
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PostComment(context.TODO(), comment, "token1")
+
+	expectedError := domain.ErrCommentNotFound.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	cu.commentRepository.AssertNotCalled(cu.T(), "InsertComment", mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentRepliesCommentNotFound() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid2", "post_id": "postid1", "parent_comment_id": nil, "deleted_at": nil, "$and": bson.A{bson.M{"$or": bson.A{bson.M{"hidden": bson.M{"$ne": true}}, bson.M{"user_id": "userid1"}}}}}).Return(&[]bson.M{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, err := commentUsecase.FindCommentReplies(context.TODO(), "postid1", "commentid2", 1, 20, "token1")
+
+	expectedError := domain.ErrCommentNotFound.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentRepliesSuccessful() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid2", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindPaginatedComments", mock.Anything, bson.M{"parent_comment_id": "commentid1", "deleted_at": nil, "$and": bson.A{bson.M{"$or": bson.A{bson.M{"hidden": bson.M{"$ne": true}}, bson.M{"user_id": "userid1"}}}}}, int64(20), int64(20)).Return(&[]bson.M{
+		{"_id": "commentid2", "post_id": "postid1", "user_id": "userid2", "comment": "@username1 thanks",
+			"parent_comment_id": "commentid1", "replying_to": bson.M{"user_id": "userid1", "username": "username1"},
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	cu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, mock.Anything).Return(map[string]map[string]int{}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	replies, err := commentUsecase.FindCommentReplies(context.TODO(), "postid1", "commentid1", 2, 20, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cu.T(), "commentid1", (*replies)[0].ParentCommentId, "Should have return parent %s but got %s", "commentid1", (*replies)[0].ParentCommentId)
+	assert.Equalf(cu.T(), "username1", (*replies)[0].ReplyingTo.Username, "Should have return replying to %s but got %s", "username1", (*replies)[0].ReplyingTo.Username)
+}
+
+func (cu *CommentUsecaseSuite) TestDeleteCommentReplyLowersParentScore() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid2"}}, nil)
+	deletedReply := domain.NewComment("commentid2", "postid1", "userid1", "reply1", 0, time.Now(), time.Now())
+	deletedReply.ParentCommentId = "commentid1"
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid2").Return(deletedReply, nil)
+	cu.commentRepository.On("UpdateCommentDeletedAt", mock.Anything, "commentid2", mock.AnythingOfType("*time.Time")).Return(nil)
+	cu.commentRepository.On("IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentReplyScore).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, mock.Anything, -1).Return(nil)
+	cu.mentionRepository.On("DeleteMentions", mock.Anything, "commentid2", "comment").Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid2", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentReplyScore)
+}
+
+func (cu *CommentUsecaseSuite) TestDeleteCommentCascadesToReplies() {
+	deletedComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
+	reply := domain.NewComment("commentid2", "postid1", "userid2", "reply #golang", 0, time.Now(), time.Now())
+	reply.ParentCommentId = "commentid1"
+	reply.Hashtags = []string{"golang"}
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"parent_comment_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid2"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(deletedComment, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid2").Return(reply, nil)
+	cu.commentRepository.On("UpdateCommentDeletedAt", mock.Anything, "commentid1", mock.AnythingOfType("*time.Time")).Return(nil)
+	cu.commentRepository.On("UpdateCommentsDeletedAt", mock.Anything, bson.M{"parent_comment_id": "commentid1", "deleted_at": nil}, mock.AnythingOfType("*time.Time")).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, mock.Anything, -1).Return(nil)
+	cu.mentionRepository.On("DeleteMentions", mock.Anything, mock.AnythingOfType("string"), "comment").Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentsDeletedAt", mock.Anything, bson.M{"parent_comment_id": "commentid1", "deleted_at": nil}, mock.AnythingOfType("*time.Time"))
+	cu.hashtagRepository.AssertCalled(cu.T(), "UpdateHashtagCommentCounts", mock.Anything, []string{"golang"}, -1)
+	cu.mentionRepository.AssertCalled(cu.T(), "DeleteMentions", mock.Anything, "commentid2", "comment")
+}
+
+func (cu *CommentUsecaseSuite) TestRestoreCommentParentDeleted() {
+	deletedAt := time.Now().Add(-time.Hour)
+	reply := domain.NewComment("commentid2", "postid1", "userid1", "reply", 0, time.Now(), time.Now())
+	reply.ParentCommentId = "commentid1"
+	reply.DeletedAt = &deletedAt
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid2"}).Return(&[]bson.M{{"_id": "commentid2"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid2").Return(reply, nil)
+	cu.postRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.RestoreComment(context.TODO(), "commentid2", "token1")
+
+	expectedError := domain.ErrCommentParentDeleted.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	cu.commentRepository.AssertNotCalled(cu.T(), "UpdateCommentDeletedAt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestRestoreCommentPostDeleted() {
+	deletedAt := time.Now().Add(-time.Hour)
+	deletedComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1 #golang", 0, time.Now(), time.Now())
+	deletedComment.Hashtags = []string{"golang"}
+	deletedComment.DeletedAt = &deletedAt
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1"}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(deletedComment, nil)
+	cu.postRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.RestoreComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrCommentPostDeleted.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	cu.commentRepository.AssertNotCalled(cu.T(), "UpdateCommentDeletedAt", mock.Anything, mock.Anything, mock.Anything)
+	cu.hashtagRepository.AssertNotCalled(cu.T(), "UpdateHashtagCommentCounts", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestRestoreCommentUnauthorizedCommentRestore() {
+	deletedAt := time.Now()
+	deletedComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
+	deletedComment.DeletedAt = &deletedAt
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(deletedComment, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.RestoreComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedCommentRestore.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (cu *CommentUsecaseSuite) TestRestoreCommentNotDeleted() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewComment(
 		"commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now(),
 	), nil)
-	cu.commentRepository.On("DeleteComment", mock.AnythingOfType("string")).Return(nil)
 
-	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.headerHelper)
-	err := commentUsecase.DeleteComment("commentid1", "token1")
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.RestoreComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrCommentNotDeleted.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (cu *CommentUsecaseSuite) TestRestoreCommentRetentionExpired() {
+	deletedAt := time.Now().Add(-domain.TrashRetention - time.Hour)
+	deletedComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
+	deletedComment.DeletedAt = &deletedAt
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(deletedComment, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.RestoreComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrCommentNotFound.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (cu *CommentUsecaseSuite) TestRestoreCommentSuccessful() {
+	deletedAt := time.Now().Add(-time.Hour)
+	deletedComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1 #golang", 0, time.Now(), time.Now())
+	deletedComment.Hashtags = []string{"golang"}
+	deletedComment.DeletedAt = &deletedAt
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"parent_comment_id": "commentid1", "deleted_at": &deletedAt}).Return(&[]bson.M{}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, mock.AnythingOfType("string")).Return(deletedComment, nil)
+	cu.postRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.commentRepository.On("UpdateCommentDeletedAt", mock.Anything, "commentid1", (*time.Time)(nil)).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, []string{"golang"}, 1).Return(nil)
+	cu.mentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.RestoreComment(context.TODO(), "commentid1", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentDeletedAt", mock.Anything, "commentid1", (*time.Time)(nil))
+	cu.hashtagRepository.AssertCalled(cu.T(), "UpdateHashtagCommentCounts", mock.Anything, []string{"golang"}, 1)
+}
+
+func (cu *CommentUsecaseSuite) TestFindDeletedCommentsUnauthorizedTrashView() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	_, err := commentUsecase.FindDeletedComments(context.TODO(), "userid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedTrashView.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	cu.commentRepository.AssertNotCalled(cu.T(), "FindComments", mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestFindDeletedCommentsSuccessful() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
+			"deleted_at":   primitive.NewDateTimeFromTime(time.Now()),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	cu.postRepository.On("FindPosts", mock.Anything, bson.M{"_id": bson.M{"$in": []string{"postid1"}}, "deleted_at": bson.M{"$ne": nil}}).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, mock.Anything).Return(map[string]map[string]int{}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	comments, err := commentUsecase.FindDeletedComments(context.TODO(), "userid1", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cu.T(), 1, len(*comments), "Should have return %d comment but got %d", 1, len(*comments))
+	assert.NotNilf(cu.T(), (*comments)[0].DeletedAt, "Should have decoded the deleted date")
+}
+
+func (cu *CommentUsecaseSuite) TestFindDeletedCommentsSkipsTrashedPostComments() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
+			"deleted_at":   primitive.NewDateTimeFromTime(time.Now()),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+		{"_id": "commentid2", "post_id": "postid2", "user_id": "userid1", "comment": "comment2",
+			"deleted_at":   primitive.NewDateTimeFromTime(time.Now()),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	cu.postRepository.On("FindPosts", mock.Anything, bson.M{"_id": bson.M{"$in": []string{"postid1", "postid2"}}, "deleted_at": bson.M{"$ne": nil}}).Return(&[]bson.M{{"_id": "postid2"}}, nil)
+	cu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, []string{"commentid1"}).Return(map[string]map[string]int{}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	comments, err := commentUsecase.FindDeletedComments(context.TODO(), "userid1", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cu.T(), 1, len(*comments), "Should have return %d comment but got %d", 1, len(*comments))
+	assert.Equalf(cu.T(), "commentid1", (*comments)[0].Id, "Should have return %s but got %s", "commentid1", (*comments)[0].Id)
+}
+
+func (cu *CommentUsecaseSuite) TestPurgeDeletedCommentsSuccessful() {
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.likeRepository.On("DeleteLikes", mock.Anything, bson.M{"resource_id": "commentid1", "resource_type": "comment"}).Return(nil)
+	cu.commentRepository.On("DeleteComment", mock.Anything, "commentid1").Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PurgeDeletedComments(context.TODO())
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.likeRepository.AssertCalled(cu.T(), "DeleteLikes", mock.Anything, bson.M{"resource_id": "commentid1", "resource_type": "comment"})
+	cu.commentRepository.AssertCalled(cu.T(), "DeleteComment", mock.Anything, "commentid1")
+}
+
+func (cu *CommentUsecaseSuite) TestMigrateCommentScoresFindPaginatedCommentsError() {
+	cu.commentRepository.On("FindPaginatedComments", mock.Anything, mock.AnythingOfType("M"), int64(0), int64(domain.CommentScoreMigrationBatch)).Return(nil, errors.New("FindPaginatedComments return error"))
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.MigrateCommentScores(context.TODO())
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestMigrateCommentScoresCountReactionsError() {
+	cu.commentRepository.On("FindPaginatedComments", mock.Anything, mock.AnythingOfType("M"), int64(0), int64(domain.CommentScoreMigrationBatch)).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, []string{"commentid1"}).Return(nil, errors.New("CountReactions return error"))
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.MigrateCommentScores(context.TODO())
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+	cu.commentRepository.AssertNotCalled(cu.T(), "UpdateCommentScores", mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestMigrateCommentScoresNothingToMigrate() {
+	cu.commentRepository.On("FindPaginatedComments", mock.Anything, bson.M{"score": bson.M{"$exists": false}}, int64(0), int64(domain.CommentScoreMigrationBatch)).Return(&[]bson.M{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.MigrateCommentScores(context.TODO())
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.likeRepository.AssertNotCalled(cu.T(), "CountReactions", mock.Anything, mock.Anything, mock.Anything)
+	cu.commentRepository.AssertNotCalled(cu.T(), "UpdateCommentScores", mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestMigrateCommentScoresSuccessful() {
+	cu.commentRepository.On("FindPaginatedComments", mock.Anything, bson.M{"score": bson.M{"$exists": false}}, int64(0), int64(domain.CommentScoreMigrationBatch)).Return(&[]bson.M{
+		{"_id": "commentid1"},
+		{"_id": "commentid2", "parent_comment_id": "commentid1"},
+	}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, []string{"commentid1", "commentid2"}).Return(map[string]map[string]int{
+		"commentid1": {"heart": 1, "wow": 1},
+		"commentid2": {"heart": 1},
+	}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, []string{"commentid1"}).Return(map[string]int{"commentid1": 1}, nil)
+	cu.commentRepository.On("UpdateCommentScores", mock.Anything, mock.AnythingOfType("map[string]int")).Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.MigrateCommentScores(context.TODO())
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentScores", mock.Anything, map[string]int{
+		"commentid1": 2*domain.CommentLikeScore + domain.CommentReplyScore,
+		"commentid2": domain.CommentLikeScore,
+	})
+	cu.commentRepository.AssertNumberOfCalls(cu.T(), "FindPaginatedComments", 1)
+}
+
+func (cu *CommentUsecaseSuite) TestFindCommentPinnedLeadsFirstPage() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.commentRepository.On("FindSortedComments", mock.Anything, bson.M{"post_id": "postid1", "parent_comment_id": nil, "pinned": bson.M{"$ne": true}, "deleted_at": nil, "$and": bson.A{bson.M{}}}, mock.Anything, int64(21)).Return(&[]bson.M{
+		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid2", "comment": "comment1", "hidden": true,
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"post_id": "postid1", "parent_comment_id": nil, "pinned": true, "deleted_at": nil, "$and": bson.A{bson.M{}}}).Return(&[]bson.M{
+		{"_id": "commentid2", "post_id": "postid1", "user_id": "userid2", "comment": "comment2", "pinned": true,
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	cu.likeRepository.On("CountReactions", mock.Anything, domain.LikeResourceComment, mock.Anything).Return(map[string]map[string]int{}, nil)
+	cu.commentRepository.On("CountReplies", mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	comments, _, err := commentUsecase.FindComments(context.TODO(), "postid1", domain.CommentSortOldest, "", 20, "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cu.T(), 2, len(*comments), "Should have return %d comments but got %d", 2, len(*comments))
+	assert.Truef(cu.T(), (*comments)[0].Pinned, "Should have return the pinned comment first")
+	assert.Truef(cu.T(), (*comments)[1].Hidden, "Should have shown the hidden comment to the post owner")
+}
+
+func (cu *CommentUsecaseSuite) TestDeleteCommentByPostOwnerSuccessful() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"parent_comment_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	deletedComment := domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now())
+	deletedComment.Pinned = true
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(deletedComment, nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.commentRepository.On("UpdateCommentDeletedAt", mock.Anything, "commentid1", mock.AnythingOfType("*time.Time")).Return(nil)
+	cu.commentRepository.On("UpdateCommentDeletedBy", mock.Anything, "commentid1", "userid1").Return(nil)
+	cu.commentRepository.On("UpdateCommentPinned", mock.Anything, "commentid1", false).Return(nil)
+	cu.hashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, mock.Anything, -1).Return(nil)
+	cu.mentionRepository.On("DeleteMentions", mock.Anything, "commentid1", "comment").Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.DeleteComment(context.TODO(), "commentid1", "token1")
 
 	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentDeletedBy", mock.Anything, "commentid1", "userid1")
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentPinned", mock.Anything, "commentid1", false)
+}
+
+func (cu *CommentUsecaseSuite) TestRestoreCommentDeletedByPostOwner() {
+	deletedAt := time.Now()
+	restoredComment := domain.NewComment("commentid1", "postid1", "userid1", "comment1", 0, time.Now(), time.Now())
+	restoredComment.DeletedAt = &deletedAt
+	restoredComment.DeletedBy = "userid2"
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(restoredComment, nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.RestoreComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedCommentRestore.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+	cu.commentRepository.AssertNotCalled(cu.T(), "UpdateCommentDeletedAt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (cu *CommentUsecaseSuite) TestPinCommentUnauthorized() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PinComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedCommentModerate.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestPinCommentReply() {
+	reply := domain.NewComment("commentid2", "postid1", "userid2", "reply1", 0, time.Now(), time.Now())
+	reply.ParentCommentId = "commentid1"
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid2"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid2").Return(reply, nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PinComment(context.TODO(), "commentid2", "token1")
+
+	expectedError := domain.ErrReplyPin.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestPinCommentHidden() {
+	comment := domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now())
+	comment.Hidden = true
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(comment, nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PinComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrHiddenCommentPin.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestPinCommentSuccessful() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, bson.M{"post_id": "postid1", "pinned": true}).Return(&[]bson.M{{"_id": "commentid2"}}, nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.commentRepository.On("UpdateCommentPinned", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("bool")).Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.PinComment(context.TODO(), "commentid1", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentPinned", mock.Anything, "commentid2", false)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentPinned", mock.Anything, "commentid1", true)
+}
+
+func (cu *CommentUsecaseSuite) TestUnpinCommentNotPinned() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.UnpinComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrCommentNotPinned.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
+}
+
+func (cu *CommentUsecaseSuite) TestHideCommentSuccessful() {
+	comment := domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now())
+	comment.Pinned = true
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(comment, nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	cu.commentRepository.On("UpdateCommentHidden", mock.Anything, "commentid1", true).Return(nil)
+	cu.commentRepository.On("UpdateCommentPinned", mock.Anything, "commentid1", false).Return(nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.HideComment(context.TODO(), "commentid1", "token1")
+
+	assert.NoErrorf(cu.T(), err, "Should have not return error but got %s", err)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentHidden", mock.Anything, "commentid1", true)
+	cu.commentRepository.AssertCalled(cu.T(), "UpdateCommentPinned", mock.Anything, "commentid1", false)
+}
+
+func (cu *CommentUsecaseSuite) TestUnhideCommentNotHidden() {
+	cu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	cu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	cu.commentRepository.On("FindOneComment", mock.Anything, "commentid1").Return(domain.NewComment("commentid1", "postid1", "userid2", "comment1", 0, time.Now(), time.Now()), nil)
+	cu.postRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+
+	commentUsecase := usecase.NewCommentUsecase(cu.commentRepository, cu.postRepository, cu.likeRepository, cu.hashtagRepository, cu.userRepository, cu.mentionRepository, cu.contentModerator, cu.headerHelper)
+	err := commentUsecase.UnhideComment(context.TODO(), "commentid1", "token1")
+
+	expectedError := domain.ErrCommentNotHidden.Error()
+	assert.EqualErrorf(cu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err.Error())
 }
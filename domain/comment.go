@@ -1,20 +1,44 @@
 package domain
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 )
 
+const (
+	CommentSortNewest          = "newest"
+	CommentSortOldest          = "oldest"
+	CommentSortTop             = "top"
+	CommentLikeScore           = 1
+	CommentReplyScore          = 2
+	CommentScoreMigrationBatch = 500
+)
+
 type Comment struct {
-	Id          string    `json:"id" bson:"_id"`
-	PostId      string    `json:"post_id" bson:"post_id"`
-	UserId      string    `json:"user_id" bson:"user_id"`
-	Comment     string    `json:"comment" bson:"comment"`
-	LikeCount   int       `json:"like_count" bson:"like_count"`
-	CreatedDate time.Time `json:"created_date" bson:"created_date"`
-	UpdatedDate time.Time `json:"updated_date" bson:"updated_date"`
+	Id              string             `json:"id" bson:"_id"`
+	PostId          string             `json:"post_id" bson:"post_id"`
+	UserId          string             `json:"user_id" bson:"user_id"`
+	ParentCommentId string             `json:"parent_comment_id" bson:"parent_comment_id"`
+	ReplyingTo      *CommentReplyingTo `json:"replying_to" bson:"replying_to"`
+	Comment         string             `json:"comment" bson:"comment"`
+	Hashtags        []string           `json:"hashtags" bson:"hashtags"`
+	Mentions        []Mention          `json:"mentions" bson:"mentions"`
+	LikeCount       int                `json:"like_count" bson:"like_count"`
+	ReactionCounts  map[string]int     `json:"reaction_counts,omitempty" bson:"reaction_counts"`
+	ViewerHasLiked  *bool              `json:"viewer_has_liked,omitempty" bson:"-"`
+	ViewerReaction  string             `json:"viewer_reaction,omitempty" bson:"-"`
+	ReplyCount      int                `json:"reply_count" bson:"reply_count"`
+	Edited          bool               `json:"edited" bson:"edited"`
+	Pinned          bool               `json:"pinned" bson:"pinned"`
+	Hidden          bool               `json:"hidden" bson:"hidden"`
+	Revisions       []CommentRevision  `json:"-" bson:"revisions"`
+	DeletedAt       *time.Time         `json:"deleted_at" bson:"deleted_at"`
+	DeletedBy       string             `json:"-" bson:"deleted_by"`
+	CreatedDate     time.Time          `json:"created_date" bson:"created_date"`
+	UpdatedDate     time.Time          `json:"updated_date" bson:"updated_date"`
 }
 
 func NewComment(id string, postId string, userId string, comment string, likeCount int, createdDate time.Time, updatedDate time.Time) *Comment {
@@ -29,22 +53,76 @@ func NewComment(id string, postId string, userId string, comment string, likeCou
 	}
 }
 
+type CommentReplyingTo struct {
+	UserId   string `json:"user_id" bson:"user_id"`
+	Username string `json:"username" bson:"username"`
+}
+
+func NewCommentReplyingTo(userId string, username string) *CommentReplyingTo {
+	return &CommentReplyingTo{
+		UserId:   userId,
+		Username: username,
+	}
+}
+
+type CommentRevision struct {
+	Comment     string    `json:"comment" bson:"comment"`
+	CreatedDate time.Time `json:"created_date" bson:"created_date"`
+	EditedDate  time.Time `json:"edited_date" bson:"edited_date"`
+}
+
+func NewCommentRevision(comment string, createdDate time.Time, editedDate time.Time) *CommentRevision {
+	return &CommentRevision{
+		Comment:     comment,
+		CreatedDate: createdDate,
+		EditedDate:  editedDate,
+	}
+}
+
 type CommentUsecase interface {
-	FindComments(string) (*[]Comment, error)
-	PostComment(*Comment, string) error
-	PutComment(*Comment, string) error
-	DeleteComment(string, string) error
+	FindComments(context.Context, string, string, string, int, string) (*[]Comment, string, error)
+	FindCommentReplies(context.Context, string, string, int, int, string) (*[]Comment, error)
+	PostComment(context.Context, *Comment, string) error
+	PutComment(context.Context, *Comment, string) error
+	FindCommentRevisions(context.Context, string, string, string) (*[]CommentRevision, error)
+	DeleteComment(context.Context, string, string) error
+	PinComment(context.Context, string, string) error
+	UnpinComment(context.Context, string, string) error
+	HideComment(context.Context, string, string) error
+	UnhideComment(context.Context, string, string) error
+	RestoreComment(context.Context, string, string) error
+	FindDeletedComments(context.Context, string, string) (*[]Comment, error)
+	PurgeDeletedComments(context.Context) error
+	MigrateCommentScores(context.Context) error
 }
 
 type CommentRepository interface {
-	FindComments(interface{}) (*[]bson.M, error)
-	InsertComment(*Comment) error
-	FindOneComment(string) (*Comment, error)
-	UpdateComment(string, string) error
-	DeleteComment(string) error
+	CreateIndexes(context.Context) error
+	FindComments(context.Context, interface{}) (*[]bson.M, error)
+	FindPaginatedComments(context.Context, interface{}, int64, int64) (*[]bson.M, error)
+	FindSortedComments(context.Context, interface{}, interface{}, int64) (*[]bson.M, error)
+	InsertComment(context.Context, *Comment) error
+	FindOneComment(context.Context, string) (*Comment, error)
+	UpdateComment(context.Context, string, string, []string, []Mention, *CommentRevision) error
+	UpdateCommentDeletedAt(context.Context, string, *time.Time) error
+	UpdateCommentDeletedBy(context.Context, string, string) error
+	UpdateCommentPinned(context.Context, string, bool) error
+	UpdateCommentHidden(context.Context, string, bool) error
+	IncrementCommentScore(context.Context, string, int) error
+	UpdateCommentScores(context.Context, map[string]int) error
+	UpdateCommentsDeletedAt(context.Context, interface{}, *time.Time) error
+	DeleteComment(context.Context, string) error
+	DeleteComments(context.Context, interface{}) error
+	CountReplies(context.Context, []string) (map[string]int, error)
 }
 
 type CommentHandler interface {
 	Comments(http.ResponseWriter, *http.Request)
 	Comment(http.ResponseWriter, *http.Request)
+	CommentReplies(http.ResponseWriter, *http.Request)
+	CommentRevisions(http.ResponseWriter, *http.Request)
+	CommentPin(http.ResponseWriter, *http.Request)
+	CommentHide(http.ResponseWriter, *http.Request)
+	CommentRestore(http.ResponseWriter, *http.Request)
+	UserDeletedComments(http.ResponseWriter, *http.Request)
 }
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	CommentFilterActionHide   = "hide"
+	CommentFilterActionReject = "reject"
+)
+
+var DefaultOffensiveTerms = []string{
+	"asshole",
+	"bastard",
+	"bitch",
+	"dumbass",
+	"fuck",
+	"idiot",
+	"kill yourself",
+	"kys",
+	"loser",
+	"moron",
+	"shit",
+	"slut",
+	"whore",
+}
+
+type CommentFilter struct {
+	BlockedWords         []string `json:"blocked_words" bson:"blocked_words"`
+	FilterOffensiveTerms bool     `json:"filter_offensive_terms" bson:"filter_offensive_terms"`
+	Action               string   `json:"action" bson:"action"`
+}
+
+func NewCommentFilter(blockedWords []string, filterOffensiveTerms bool, action string) *CommentFilter {
+	return &CommentFilter{
+		BlockedWords:         blockedWords,
+		FilterOffensiveTerms: filterOffensiveTerms,
+		Action:               action,
+	}
+}
+
+func DefaultCommentFilter() *CommentFilter {
+	return NewCommentFilter([]string{}, true, CommentFilterActionHide)
+}
+
+type ContentModerator interface {
+	Moderate(context.Context, string, *CommentFilter) (bool, error)
+}
+
+// Texts are normalized so that accents, case, leetspeak and spaced out letters do not get around it.
+type KeywordModerator struct {
+	offensiveTerms [][]string
+}
+
+func NewKeywordModerator(offensiveTerms []string) *KeywordModerator {
+	return &KeywordModerator{
+		offensiveTerms: normalizeTerms(offensiveTerms),
+	}
+}
+
+func (km *KeywordModerator) Moderate(ctx context.Context, text string, filter *CommentFilter) (bool, error) {
+	terms := normalizeTerms(filter.BlockedWords)
+	if filter.FilterOffensiveTerms {
+		terms = append(terms, km.offensiveTerms...)
+	}
+	if len(terms) == 0 {
+		return false, nil
+	}
+	// Symbols are read both as letters and as separators, so "b@d" and "bad!" both match "bad".
+	for _, words := range [][]string{normalizeWords(text, true), normalizeWords(text, false)} {
+		for _, term := range terms {
+			if containsPhrase(words, term) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+var leetspeak = map[rune]rune{
+	'0': 'o',
+	'1': 'i',
+	'3': 'e',
+	'4': 'a',
+	'5': 's',
+	'7': 't',
+	'8': 'b',
+	'9': 'g',
+	'@': 'a',
+	'$': 's',
+	'!': 'i',
+	'+': 't',
+}
+
+// Runs of three or more single letters are joined back into the word they spell.
+func normalizeWords(text string, readLeetspeak bool) []string {
+	var letters strings.Builder
+	for _, r := range norm.NFKD.String(text) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if leet, ok := leetspeak[r]; ok && readLeetspeak {
+			r = leet
+		}
+		if unicode.IsLetter(r) {
+			letters.WriteRune(unicode.ToLower(r))
+		} else {
+			letters.WriteRune(' ')
+		}
+	}
+
+	var words []string
+	var spelled []string
+	for _, word := range strings.Fields(letters.String()) {
+		if len([]rune(word)) == 1 {
+			spelled = append(spelled, word)
+			continue
+		}
+		words = appendSpelled(words, spelled)
+		spelled = nil
+		words = append(words, word)
+	}
+	return appendSpelled(words, spelled)
+}
+
+func appendSpelled(words []string, spelled []string) []string {
+	if len(spelled) >= 3 {
+		return append(words, strings.Join(spelled, ""))
+	}
+	return append(words, spelled...)
+}
+
+func normalizeTerms(terms []string) [][]string {
+	var normalizedTerms [][]string
+	for _, term := range terms {
+		if words := normalizeWords(term, true); len(words) > 0 {
+			normalizedTerms = append(normalizedTerms, words)
+		}
+	}
+	return normalizedTerms
+}
+
+func containsPhrase(words []string, phrase []string) bool {
+	for i := 0; i+len(phrase) <= len(words); i++ {
+		matched := true
+		for j, word := range phrase {
+			if words[i+j] != word {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"context"
+	"instagram-go/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeywordModeratorModerate(t *testing.T) {
+	moderator := domain.NewKeywordModerator([]string{"idiot"})
+	filter := domain.NewCommentFilter([]string{"spam link", "crypto"}, true, domain.CommentFilterActionHide)
+	for text, flagged := range map[string]bool{
+		"what an idiot":             true,
+		"what an IDIOT!":            true,
+		"what an 1d10t":             true,
+		"what an ìdíôt":             true,
+		"what an i d i o t":         true,
+		"ｉｄｉｏｔ":                     true,
+		"buy crypt0 here":           true,
+		"click this spam   link":    true,
+		"spam is not a link":        false,
+		"idiotic":                   false,
+		"nice photo":                false,
+		"visit @crypto_fan tonight": true,
+	} {
+		result, err := moderator.Moderate(context.TODO(), text, filter)
+
+		assert.NoErrorf(t, err, "Should have not return error but got %s", err)
+		assert.Equalf(t, flagged, result, "Should have flagged %q %v but got %v", text, flagged, result)
+	}
+}
+
+func TestKeywordModeratorOffensiveTermsOff(t *testing.T) {
+	moderator := domain.NewKeywordModerator([]string{"idiot"})
+	filter := domain.NewCommentFilter(nil, false, domain.CommentFilterActionHide)
+
+	result, err := moderator.Moderate(context.TODO(), "what an idiot", filter)
+
+	assert.NoErrorf(t, err, "Should have not return error but got %s", err)
+	assert.False(t, result, "Should have not flagged the default offensive terms when they are off")
+}
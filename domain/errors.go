@@ -3,28 +3,93 @@ package domain
 import "errors"
 
 var (
-	ErrPasswordWrong              = errors.New("password is wrong")
-	ErrInternalServerError        = errors.New("an error has occured in our server")
-	ErrInvalidProfilePicture      = errors.New("invalid profile picture file type")
-	ErrUnauthorizedUserUpdate     = errors.New("user is not authorized to update this user")
-	ErrUserNotFound               = errors.New("User does not exist")
-	ErrUsernameConflict           = errors.New("username already exist")
-	ErrMissingEmailInput          = errors.New("email must not be empty")
-	ErrMissingFullNameInput       = errors.New("full name must not be empty")
-	ErrMissingUsernameInput       = errors.New("username must not be empty")
-	ErrMissingPasswordInput       = errors.New("password must not be empty")
-	ErrMissingVisualMediasInput   = errors.New("visual medias must not be empty")
-	ErrUnsupportedVisualMediaType = errors.New("uploaded visual medias type is not supported")
-	ErrMissingCaptionInput        = errors.New("caption must not be empty")
-	ErrPostNotFound               = errors.New("post does not exist")
-	ErrUnauthorizedPostUpdate     = errors.New("user is not authorized to update this post")
-	ErrUnauthorizedPostDelete     = errors.New("user is not authorized to delete this post")
-	ErrPostLikeConflict           = errors.New("user have already liked this post")
-	ErrLikeNotFound               = errors.New("like does not exist")
-	ErrUnauthorizedLikeDelete     = errors.New("user is not authorized to delete this like")
-	ErrCommentNotFound            = errors.New("comment does not exist")
-	ErrCommentLikeConflict        = errors.New("user have already liked this comment")
-	ErrMissingCommentInput        = errors.New("comment must not be empty")
-	ErrUnauthorizedCommentUpdate  = errors.New("user is not authorized to update this comment")
-	ErrUnauthorizedCommentDelete  = errors.New("user is not authorized to delete this comment")
+	ErrPasswordWrong                = errors.New("password is wrong")
+	ErrInternalServerError          = errors.New("an error has occured in our server")
+	ErrInvalidProfilePicture        = errors.New("invalid profile picture file type")
+	ErrUnauthorizedUserUpdate       = errors.New("user is not authorized to update this user")
+	ErrUserNotFound                 = errors.New("User does not exist")
+	ErrUsernameConflict             = errors.New("username already exist")
+	ErrMissingEmailInput            = errors.New("email must not be empty")
+	ErrMissingFullNameInput         = errors.New("full name must not be empty")
+	ErrMissingUsernameInput         = errors.New("username must not be empty")
+	ErrMissingPasswordInput         = errors.New("password must not be empty")
+	ErrMissingVisualMediasInput     = errors.New("visual medias must not be empty")
+	ErrUnsupportedVisualMediaType   = errors.New("uploaded visual medias type is not supported")
+	ErrInvalidVisualMediaOrder      = errors.New("visual media order must reference each existing visual media at most once")
+	ErrInvalidUserTags              = errors.New("user tags must reference an existing visual media with coordinates between 0 and 1")
+	ErrTaggedUserNotFound           = errors.New("tagged user does not exist")
+	ErrUserTagNotFound              = errors.New("user is not tagged in this post")
+	ErrInvalidLocation              = errors.New("location must have a name and a latitude between -90 and 90 and a longitude between -180 and 180")
+	ErrMissingLocationPoint         = errors.New("location coordinates must be provided when none can be read from the visual medias")
+	ErrInvalidNearbyQuery           = errors.New("lat and lng must be valid coordinates and radius a positive number of meters")
+	ErrInvalidPostStatus            = errors.New("status must be draft, published or scheduled with a future publish_at, and a published post cannot change status")
+	ErrUnpublishedPostArchive       = errors.New("only published posts can be archived")
+	ErrPostArchiveConflict          = errors.New("post is already archived")
+	ErrPostNotArchived              = errors.New("post is not archived")
+	ErrUnauthorizedPostArchive      = errors.New("user is not authorized to archive this post")
+	ErrUnauthorizedArchiveView      = errors.New("user is not authorized to view this archive")
+	ErrPostNotDeleted               = errors.New("post is not deleted")
+	ErrUnauthorizedPostRestore      = errors.New("user is not authorized to restore this post")
+	ErrUnauthorizedTrashView        = errors.New("user is not authorized to view these recently deleted items")
+	ErrUnpublishedPostPin           = errors.New("only published posts can be pinned")
+	ErrPostPinConflict              = errors.New("post is already pinned")
+	ErrPostNotPinned                = errors.New("post is not pinned")
+	ErrPinnedPostLimit              = errors.New("a user can pin at most 3 posts, unpin one first")
+	ErrUnauthorizedPostPin          = errors.New("user is not authorized to pin this post")
+	ErrPostCommentsDisabled         = errors.New("comments are disabled on this post")
+	ErrInvalidPostSettings          = errors.New("comments_disabled and hide_like_count must be booleans")
+	ErrMissingCaptionInput          = errors.New("caption must not be empty")
+	ErrPostNotFound                 = errors.New("post does not exist")
+	ErrUnauthorizedPostUpdate       = errors.New("user is not authorized to update this post")
+	ErrUnauthorizedPostDelete       = errors.New("user is not authorized to delete this post")
+	ErrPostLikeConflict             = errors.New("user have already liked this post")
+	ErrLikeNotFound                 = errors.New("like does not exist")
+	ErrUnauthorizedLikeDelete       = errors.New("user is not authorized to delete this like")
+	ErrPostSaveConflict             = errors.New("user have already saved this post")
+	ErrSaveNotFound                 = errors.New("save does not exist")
+	ErrCollectionNotFound           = errors.New("collection does not exist")
+	ErrMissingCollectionNameInput   = errors.New("collection name must not be empty")
+	ErrUnauthorizedCollectionView   = errors.New("user is not authorized to view this collection")
+	ErrUnauthorizedCollectionUpdate = errors.New("user is not authorized to update this collection")
+	ErrUnauthorizedCollectionDelete = errors.New("user is not authorized to delete this collection")
+	ErrCommentNotFound              = errors.New("comment does not exist")
+	ErrCommentLikeConflict          = errors.New("user have already liked this comment")
+	ErrMissingCommentInput          = errors.New("comment must not be empty")
+	ErrUnauthorizedCommentUpdate    = errors.New("user is not authorized to update this comment")
+	ErrUnauthorizedCommentDelete    = errors.New("user is not authorized to delete this comment")
+	ErrCommentNotDeleted            = errors.New("comment is not deleted")
+	ErrUnauthorizedCommentRestore   = errors.New("user is not authorized to restore this comment")
+	ErrUnauthorizedCommentRevisions = errors.New("user is not authorized to view the revisions of this comment")
+	ErrCommentParentDeleted         = errors.New("replied comment is deleted")
+	ErrHashtagNotFound              = errors.New("hashtag does not exist")
+	ErrMissingStoryVisualMediaInput = errors.New("visual_media must be a single image or video file")
+	ErrStoryNotFound                = errors.New("story does not exist")
+	ErrHighlightNotFound            = errors.New("highlight does not exist")
+	ErrMissingHighlightTitleInput   = errors.New("highlight title must not be empty")
+	ErrMissingHighlightItemsInput   = errors.New("highlight items must not be empty")
+	ErrInvalidHighlightItems        = errors.New("each post_ids must come with a visual_media_indexes referencing one of its visual medias")
+	ErrUnpublishedHighlightPost     = errors.New("only published posts can be added to a highlight")
+	ErrUnauthorizedHighlightItem    = errors.New("user is not authorized to add this post to a highlight")
+	ErrUnauthorizedHighlightUpdate  = errors.New("user is not authorized to update this highlight")
+	ErrUnauthorizedHighlightDelete  = errors.New("user is not authorized to delete this highlight")
+	ErrInvalidPagination            = errors.New("page and limit must be positive integers")
+	ErrInvalidCommentSort           = errors.New("sort must be one of newest, oldest or top")
+	ErrInvalidCursor                = errors.New("cursor is invalid")
+	ErrUnauthorizedCommentModerate  = errors.New("user is not authorized to moderate this comment")
+	ErrReplyPin                     = errors.New("only top level comments can be pinned")
+	ErrHiddenCommentPin             = errors.New("hidden comments cannot be pinned")
+	ErrCommentPinConflict           = errors.New("comment is already pinned")
+	ErrCommentNotPinned             = errors.New("comment is not pinned")
+	ErrCommentHideConflict          = errors.New("comment is already hidden")
+	ErrCommentNotHidden             = errors.New("comment is not hidden")
+	ErrInvalidCommentFilter         = errors.New("comment filter action must be either hide or reject")
+	ErrUnauthorizedCommentFilter    = errors.New("user is not authorized to manage this comment filter")
+	ErrCommentRejected              = errors.New("comment was rejected by the comment filter of the post owner")
+	ErrInvalidReaction              = errors.New("reaction is not one of the available reactions")
+	ErrReactionNotFound             = errors.New("user has not reacted to this resource")
+	ErrHiddenLikes                  = errors.New("likes of this post are hidden")
+	ErrLikeNotOnPost                = errors.New("like does not belong to this post")
+	ErrLikeNotOnComment             = errors.New("like does not belong to this comment")
+	ErrCommentNotOnPost             = errors.New("comment does not belong to this post")
+	ErrCommentPostDeleted           = errors.New("commented post is deleted")
 )
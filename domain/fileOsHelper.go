@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"encoding/binary"
+	"errors"
 	"image"
 	"image/gif"
 	"image/jpeg"
@@ -15,10 +17,14 @@ import (
 
 type IFileOsHelper interface {
 	DecodeImage(io.Reader) (image.Image, string, error)
+	DecodeImageConfig(io.Reader) (image.Config, string, error)
+	DecodeVideoConfig(io.ReadSeeker) (*VideoConfig, error)
+	DecodeImageGPS(io.ReadSeeker) (*GeoPoint, error)
 	ResizeAndSaveFileToLocale(string, image.Image, string, string) (string, error)
 	MkDirAll(string, fs.FileMode) error
 	Create(string) (*os.File, error)
 	Copy(io.Writer, io.Reader) (int64, error)
+	Remove(string) error
 }
 
 type FileOsHelper struct {
@@ -32,6 +38,216 @@ func (fos *FileOsHelper) DecodeImage(r io.Reader) (image.Image, string, error) {
 	return image.Decode(r)
 }
 
+func (fos *FileOsHelper) DecodeImageConfig(r io.Reader) (image.Config, string, error) {
+	return image.DecodeConfig(r)
+}
+
+type VideoConfig struct {
+	Width    int
+	Height   int
+	Duration float64
+}
+
+var errUnsupportedVideoContainer = errors.New("video container is not supported")
+
+func (fos *FileOsHelper) DecodeVideoConfig(r io.ReadSeeker) (*VideoConfig, error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	moovStart, moovEnd, err := findMp4Box(r, 0, end, "moov")
+	if err != nil {
+		return nil, err
+	}
+
+	var config VideoConfig
+	mvhdStart, _, err := findMp4Box(r, moovStart, moovEnd, "mvhd")
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, 32)
+	if _, err = r.Seek(mvhdStart, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err = io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if header[0] == 1 {
+		timescale := binary.BigEndian.Uint32(header[20:24])
+		duration := binary.BigEndian.Uint64(header[24:32])
+		if timescale > 0 {
+			config.Duration = float64(duration) / float64(timescale)
+		}
+	} else {
+		timescale := binary.BigEndian.Uint32(header[12:16])
+		duration := binary.BigEndian.Uint32(header[16:20])
+		if timescale > 0 {
+			config.Duration = float64(duration) / float64(timescale)
+		}
+	}
+
+	offset := moovStart
+	for offset < moovEnd {
+		trakStart, trakEnd, err := findMp4Box(r, offset, moovEnd, "trak")
+		if err != nil {
+			break
+		}
+		offset = trakEnd
+		tkhdStart, tkhdEnd, err := findMp4Box(r, trakStart, trakEnd, "tkhd")
+		if err != nil || tkhdEnd-tkhdStart < 8 {
+			continue
+		}
+		dimensions := make([]byte, 8)
+		if _, err = r.Seek(tkhdEnd-8, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if _, err = io.ReadFull(r, dimensions); err != nil {
+			return nil, err
+		}
+		width := int(binary.BigEndian.Uint32(dimensions[0:4]) >> 16)
+		height := int(binary.BigEndian.Uint32(dimensions[4:8]) >> 16)
+		if width > 0 && height > 0 {
+			config.Width = width
+			config.Height = height
+			break
+		}
+	}
+	return &config, nil
+}
+
+func findMp4Box(r io.ReadSeeker, start int64, end int64, boxType string) (int64, int64, error) {
+	header := make([]byte, 16)
+	offset := start
+	for offset+8 <= end {
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return 0, 0, err
+		}
+		if _, err := io.ReadFull(r, header[:8]); err != nil {
+			return 0, 0, err
+		}
+		size := int64(binary.BigEndian.Uint32(header[0:4]))
+		headerSize := int64(8)
+		if size == 1 {
+			if _, err := io.ReadFull(r, header[8:16]); err != nil {
+				return 0, 0, err
+			}
+			size = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerSize = 16
+		} else if size == 0 {
+			size = end - offset
+		}
+		if size < headerSize || offset+size > end {
+			return 0, 0, errUnsupportedVideoContainer
+		}
+		if string(header[4:8]) == boxType {
+			return offset + headerSize, offset + size, nil
+		}
+		offset += size
+	}
+	return 0, 0, errUnsupportedVideoContainer
+}
+
+var errExifGPSNotFound = errors.New("image has no exif gps coordinates")
+
+func (fos *FileOsHelper) DecodeImageGPS(r io.ReadSeeker) (*GeoPoint, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	marker := make([]byte, 4)
+	if _, err := io.ReadFull(r, marker[:2]); err != nil || marker[0] != 0xFF || marker[1] != 0xD8 {
+		return nil, errExifGPSNotFound
+	}
+	for {
+		if _, err := io.ReadFull(r, marker); err != nil || marker[0] != 0xFF {
+			return nil, errExifGPSNotFound
+		}
+		size := int(binary.BigEndian.Uint16(marker[2:4])) - 2
+		if marker[1] == 0xDA || size < 0 {
+			return nil, errExifGPSNotFound
+		}
+		segment := make([]byte, size)
+		if _, err := io.ReadFull(r, segment); err != nil {
+			return nil, errExifGPSNotFound
+		}
+		if marker[1] == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return decodeExifGPS(segment[6:])
+		}
+	}
+}
+
+func decodeExifGPS(tiff []byte) (*GeoPoint, error) {
+	if len(tiff) < 8 {
+		return nil, errExifGPSNotFound
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, errExifGPSNotFound
+	}
+	entries := func(offset uint32) map[uint16][]byte {
+		result := make(map[uint16][]byte)
+		if int(offset)+2 > len(tiff) {
+			return result
+		}
+		count := int(order.Uint16(tiff[offset:]))
+		for i := 0; i < count; i++ {
+			start := int(offset) + 2 + i*12
+			if start+12 > len(tiff) {
+				break
+			}
+			result[order.Uint16(tiff[start:])] = tiff[start+2 : start+12]
+		}
+		return result
+	}
+	rationals := func(entry []byte) []float64 {
+		count := int(order.Uint32(entry[2:6]))
+		offset := int(order.Uint32(entry[6:10]))
+		if count != 3 || offset+24 > len(tiff) {
+			return nil
+		}
+		var values []float64
+		for i := 0; i < count; i++ {
+			numerator := order.Uint32(tiff[offset+i*8:])
+			denominator := order.Uint32(tiff[offset+i*8+4:])
+			if denominator == 0 {
+				return nil
+			}
+			values = append(values, float64(numerator)/float64(denominator))
+		}
+		return values
+	}
+
+	gpsPointer, ok := entries(order.Uint32(tiff[4:8]))[0x8825]
+	if !ok {
+		return nil, errExifGPSNotFound
+	}
+	gps := entries(order.Uint32(gpsPointer[6:10]))
+	latitudeRef, latitudeEntry := gps[0x0001], gps[0x0002]
+	longitudeRef, longitudeEntry := gps[0x0003], gps[0x0004]
+	if latitudeRef == nil || latitudeEntry == nil || longitudeRef == nil || longitudeEntry == nil {
+		return nil, errExifGPSNotFound
+	}
+	latitude, longitude := rationals(latitudeEntry), rationals(longitudeEntry)
+	if latitude == nil || longitude == nil {
+		return nil, errExifGPSNotFound
+	}
+	point := NewGeoPoint(latitude[0]+latitude[1]/60+latitude[2]/3600, longitude[0]+longitude[1]/60+longitude[2]/3600)
+	if latitudeRef[6] == 'S' {
+		point.Coordinates[1] = -point.Coordinates[1]
+	}
+	if longitudeRef[6] == 'W' {
+		point.Coordinates[0] = -point.Coordinates[0]
+	}
+	if !point.IsValid() {
+		return nil, errExifGPSNotFound
+	}
+	return point, nil
+}
+
 func (fos *FileOsHelper) ResizeAndSaveFileToLocale(size string, originalProfilePicture image.Image, userId string, fileType string) (string, error) {
 	var resizedProfilePicture image.Image
 	fileExtension := strings.Split(fileType, "/")[1]
@@ -74,3 +290,7 @@ func (fos *FileOsHelper) Create(name string) (*os.File, error) {
 func (fos *FileOsHelper) Copy(dst io.Writer, src io.Reader) (int64, error) {
 	return io.Copy(dst, src)
 }
+
+func (fos *FileOsHelper) Remove(name string) error {
+	return os.Remove(name)
+}
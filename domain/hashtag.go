@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type Hashtag struct {
+	Tag          string    `json:"tag" bson:"_id"`
+	PostCount    int       `json:"post_count" bson:"post_count"`
+	CommentCount int       `json:"comment_count" bson:"comment_count"`
+	CreatedDate  time.Time `json:"created_date" bson:"created_date"`
+	UpdatedDate  time.Time `json:"updated_date" bson:"updated_date"`
+}
+
+func NewHashtag(tag string, postCount int, commentCount int, createdDate time.Time, updatedDate time.Time) *Hashtag {
+	return &Hashtag{
+		Tag:          tag,
+		PostCount:    postCount,
+		CommentCount: commentCount,
+		CreatedDate:  createdDate,
+		UpdatedDate:  updatedDate,
+	}
+}
+
+// A hashtag has to start a word, so "a#b" does not tag "b".
+var hashtagRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])#([\p{L}\p{N}_]+)`)
+
+func ExtractHashtags(text string) []string {
+	var hashtags []string
+	seen := make(map[string]bool)
+	for _, match := range hashtagRegexp.FindAllStringSubmatch(text, -1) {
+		hashtag := NormalizeHashtag(match[1])
+		if !seen[hashtag] {
+			seen[hashtag] = true
+			hashtags = append(hashtags, hashtag)
+		}
+	}
+	return hashtags
+}
+
+func NormalizeHashtag(hashtag string) string {
+	return strings.ToLower(strings.TrimPrefix(hashtag, "#"))
+}
+
+func DiffHashtags(oldHashtags []string, newHashtags []string) ([]string, []string) {
+	oldSet := make(map[string]bool)
+	for _, v := range oldHashtags {
+		oldSet[v] = true
+	}
+	newSet := make(map[string]bool)
+	for _, v := range newHashtags {
+		newSet[v] = true
+	}
+	var added, removed []string
+	for _, v := range newHashtags {
+		if !oldSet[v] {
+			added = append(added, v)
+		}
+	}
+	for _, v := range oldHashtags {
+		if !newSet[v] {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+type HashtagUsecase interface {
+	FindHashtag(context.Context, string) (*Hashtag, error)
+}
+
+type HashtagRepository interface {
+	FindOneHashtag(context.Context, string) (*Hashtag, error)
+	UpdateHashtagPostCounts(context.Context, []string, int) error
+	UpdateHashtagCommentCounts(context.Context, []string, int) error
+}
+
+type HashtagHandler interface {
+	Hashtag(http.ResponseWriter, *http.Request)
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Without a custom cover, clients show the first item as the cover.
+type Highlight struct {
+	Id          string          `json:"id" bson:"_id"`
+	UserId      string          `json:"user_id" bson:"user_id"`
+	Title       string          `json:"title" bson:"title"`
+	Cover       *VisualMedia    `json:"cover,omitempty" bson:"cover"`
+	Items       []HighlightItem `json:"items" bson:"items"`
+	CreatedDate time.Time       `json:"created_date" bson:"created_date"`
+	UpdatedDate time.Time       `json:"updated_date" bson:"updated_date"`
+}
+
+func NewHighlight(id string, userId string, title string, cover *VisualMedia, items []HighlightItem, createdDate time.Time, updatedDate time.Time) *Highlight {
+	return &Highlight{
+		Id:          id,
+		UserId:      userId,
+		Title:       title,
+		Cover:       cover,
+		Items:       items,
+		CreatedDate: createdDate,
+		UpdatedDate: updatedDate,
+	}
+}
+
+// HighlightItem copies its visual media so it outlives the post.
+type HighlightItem struct {
+	PostId           string      `json:"post_id" bson:"post_id"`
+	VisualMediaIndex int         `json:"visual_media_index" bson:"visual_media_index"`
+	VisualMedia      VisualMedia `json:"visual_media" bson:"visual_media"`
+}
+
+func NewHighlightItem(postId string, visualMediaIndex int, visualMedia VisualMedia) *HighlightItem {
+	return &HighlightItem{
+		PostId:           postId,
+		VisualMediaIndex: visualMediaIndex,
+		VisualMedia:      visualMedia,
+	}
+}
+
+type HighlightUsecase interface {
+	InsertHighlight(context.Context, *Highlight, string, *multipart.FileHeader) error
+	FindUserHighlights(context.Context, string) (*[]Highlight, error)
+	UpdateHighlight(context.Context, *Highlight, string, *multipart.FileHeader) error
+	DeleteHighlight(context.Context, string, string) error
+}
+
+type HighlightRepository interface {
+	CreateIndexes(context.Context) error
+	InsertHighlight(context.Context, *Highlight) error
+	FindHighlights(context.Context, interface{}) (*[]bson.M, error)
+	FindOneHighlight(context.Context, string) (*Highlight, error)
+	UpdateHighlight(context.Context, *Highlight) error
+	DeleteHighlight(context.Context, string) error
+}
+
+type HighlightHandler interface {
+	Highlights(http.ResponseWriter, *http.Request)
+	Highlight(http.ResponseWriter, *http.Request)
+	UserHighlights(http.ResponseWriter, *http.Request)
+}
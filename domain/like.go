@@ -1,16 +1,29 @@
 package domain
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 )
 
+const (
+	LikeResourcePost    = "post"
+	LikeResourceComment = "comment"
+)
+
+const ReactionHeart = "heart"
+
+var DefaultReactions = []string{ReactionHeart, "laugh", "wow", "sad", "angry", "fire"}
+
 type Like struct {
-	Id           string `json:"id" bson:"_id"`
-	UserId       string `json:"user_id" bson:"user_id"`
-	ResourceId   string `json:"resource_id" bson:"resource_id"`
-	ResourceType string `json:"resource_type" bson:"resource_type"`
+	Id           string    `json:"id" bson:"_id"`
+	UserId       string    `json:"user_id" bson:"user_id"`
+	ResourceId   string    `json:"resource_id" bson:"resource_id"`
+	ResourceType string    `json:"resource_type" bson:"resource_type"`
+	Reaction     string    `json:"reaction" bson:"reaction"`
+	CreatedDate  time.Time `json:"created_date" bson:"created_date"`
 }
 
 func NewLike(id string, userId string, resourceId string, resourceType string) *Like {
@@ -19,26 +32,71 @@ func NewLike(id string, userId string, resourceId string, resourceType string) *
 		UserId:       userId,
 		ResourceId:   resourceId,
 		ResourceType: resourceType,
+		Reaction:     ReactionHeart,
+	}
+}
+
+// Likes stored before reactions existed are hearts.
+func LikeReaction(like bson.M) string {
+	if reaction, ok := like["reaction"].(string); ok && reaction != "" {
+		return reaction
+	}
+	return ReactionHeart
+}
+
+type Liker struct {
+	UserId          string           `json:"user_id"`
+	Username        string           `json:"username"`
+	Fullname        string           `json:"fullname"`
+	ProfilePictures []ProfilePicture `json:"profile_pictures"`
+	Reaction        string           `json:"reaction"`
+}
+
+func NewLiker(user *User, reaction string) *Liker {
+	return &Liker{
+		UserId:          user.Id,
+		Username:        user.Username,
+		Fullname:        user.Fullname,
+		ProfilePictures: user.ProfilePictures,
+		Reaction:        reaction,
 	}
 }
 
 type LikeUsecase interface {
-	InsertPostLike(string, string) error
-	DeletePostLike(string, string) error
-	InsertCommentLike(string, string) error
-	DeleteCommentLike(string, string) error
+	InsertPostLike(context.Context, string, string) (*Like, bool, error)
+	DeletePostLike(context.Context, string, string, string) error
+	UnlikePost(context.Context, string, string) error
+	InsertCommentLike(context.Context, string, string, string) (*Like, bool, error)
+	DeleteCommentLike(context.Context, string, string, string, string) error
+	UnlikeComment(context.Context, string, string, string) error
+	PutReaction(context.Context, string, string, string, string, string) error
+	DeleteReaction(context.Context, string, string, string, string) error
+	FindPostLikes(context.Context, string, int, int, string) (*[]Liker, error)
+	FindCommentLikes(context.Context, string, string, int, int, string) (*[]Liker, error)
 }
 
 type LikeRepository interface {
-	InsertLike(*Like) error
-	FindLikes(interface{}) (*[]bson.M, error)
-	FindOneLike(string) (*Like, error)
-	DeleteLike(string) error
+	CreateIndexes(context.Context) error
+	InsertLike(context.Context, *Like) error
+	FindLikes(context.Context, interface{}) (*[]bson.M, error)
+	FindPaginatedLikes(context.Context, interface{}, int64, int64) (*[]bson.M, error)
+	FindOneLike(context.Context, string) (*Like, error)
+	DeleteLike(context.Context, string) (int64, error)
+	DeleteLikes(context.Context, interface{}) error
+	UpdateLikeReaction(context.Context, string, string) error
+	MigrateReactions(context.Context) error
+	CountReactions(context.Context, string, []string) (map[string]map[string]int, error)
 }
 
 type LikeHandler interface {
 	PostLikePost(http.ResponseWriter, *http.Request)
 	DeleteLikePost(http.ResponseWriter, *http.Request)
+	UnlikePost(http.ResponseWriter, *http.Request)
 	PostCommentLike(http.ResponseWriter, *http.Request)
 	DeleteCommentLike(http.ResponseWriter, *http.Request)
+	UnlikeComment(http.ResponseWriter, *http.Request)
+	PostReactions(http.ResponseWriter, *http.Request)
+	CommentReactions(http.ResponseWriter, *http.Request)
+	GetPostLikes(http.ResponseWriter, *http.Request)
+	GetCommentLikes(http.ResponseWriter, *http.Request)
 }
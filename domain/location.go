@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	DefaultNearbyRadius = 1000
+	MaxNearbyRadius     = 50000
+)
+
+// GeoPoint is a GeoJSON point. Coordinates are longitude then latitude.
+type GeoPoint struct {
+	Type        string    `json:"type" bson:"type"`
+	Coordinates []float64 `json:"coordinates" bson:"coordinates"`
+}
+
+func NewGeoPoint(latitude float64, longitude float64) *GeoPoint {
+	return &GeoPoint{
+		Type:        "Point",
+		Coordinates: []float64{longitude, latitude},
+	}
+}
+
+func (gp *GeoPoint) Latitude() float64 {
+	return gp.Coordinates[1]
+}
+
+func (gp *GeoPoint) Longitude() float64 {
+	return gp.Coordinates[0]
+}
+
+func (gp *GeoPoint) IsValid() bool {
+	return gp != nil && len(gp.Coordinates) == 2 &&
+		gp.Latitude() >= -90 && gp.Latitude() <= 90 &&
+		gp.Longitude() >= -180 && gp.Longitude() <= 180
+}
+
+type Location struct {
+	Id    string    `json:"id" bson:"id"`
+	Name  string    `json:"name" bson:"name"`
+	Point *GeoPoint `json:"point" bson:"point"`
+}
+
+func NewLocation(id string, name string, point *GeoPoint) *Location {
+	return &Location{
+		Id:    id,
+		Name:  name,
+		Point: point,
+	}
+}
+
+// Coordinates are rounded to about 10 meters so posts tagged at the same place share a location.
+func NewLocationId(name string, point *GeoPoint) string {
+	key := fmt.Sprintf("%s|%.4f|%.4f", strings.ToLower(strings.TrimSpace(name)), point.Latitude(), point.Longitude())
+	hash := sha256.Sum256([]byte(key))
+	return "location-" + hex.EncodeToString(hash[:8])
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Offset and Length count characters, not bytes, and include the leading @.
+type Mention struct {
+	UserId   string `json:"user_id" bson:"user_id"`
+	Username string `json:"username" bson:"username"`
+	Offset   int    `json:"offset" bson:"offset"`
+	Length   int    `json:"length" bson:"length"`
+}
+
+func NewMention(userId string, username string, offset int, length int) *Mention {
+	return &Mention{
+		UserId:   userId,
+		Username: username,
+		Offset:   offset,
+		Length:   length,
+	}
+}
+
+type UserMention struct {
+	Id           string    `json:"id" bson:"_id"`
+	UserId       string    `json:"user_id" bson:"user_id"`
+	AuthorId     string    `json:"author_id" bson:"author_id"`
+	PostId       string    `json:"post_id" bson:"post_id"`
+	ResourceId   string    `json:"resource_id" bson:"resource_id"`
+	ResourceType string    `json:"resource_type" bson:"resource_type"`
+	CreatedDate  time.Time `json:"created_date" bson:"created_date"`
+}
+
+func NewUserMention(id string, userId string, authorId string, postId string, resourceId string, resourceType string, createdDate time.Time) *UserMention {
+	return &UserMention{
+		Id:           id,
+		UserId:       userId,
+		AuthorId:     authorId,
+		PostId:       postId,
+		ResourceId:   resourceId,
+		ResourceType: resourceType,
+		CreatedDate:  createdDate,
+	}
+}
+
+func NewUserMentions(mentions []Mention, authorId string, postId string, resourceId string, resourceType string, createdDate time.Time) []UserMention {
+	var userMentions []UserMention
+	seen := make(map[string]bool)
+	for _, mention := range mentions {
+		if seen[mention.UserId] {
+			continue
+		}
+		seen[mention.UserId] = true
+		userMention := NewUserMention("mention-"+uuid.NewString(), mention.UserId, authorId, postId, resourceId, resourceType, createdDate)
+		userMentions = append(userMentions, *userMention)
+	}
+	return userMentions
+}
+
+// A mention has to start a word, so an email address is not a mention.
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@])(@[A-Za-z0-9_.]+)`)
+
+func ResolveMentions(ctx context.Context, userRepository UserRepository, text string) ([]Mention, error) {
+	var candidates []Mention
+	var usernames []string
+	for _, match := range mentionRegexp.FindAllStringSubmatchIndex(text, -1) {
+		mentionText := strings.TrimRight(text[match[2]:match[3]], ".")
+		if len(mentionText) == 1 {
+			continue
+		}
+		offset := utf8.RuneCountInString(text[:match[2]])
+		mention := NewMention("", mentionText[1:], offset, utf8.RuneCountInString(mentionText))
+		candidates = append(candidates, *mention)
+		usernames = append(usernames, mention.Username)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	filter := bson.M{"username": bson.M{"$in": usernames}}
+	queryResult, err := userRepository.FindUser(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	userIds := make(map[string]string)
+	for _, v := range *queryResult {
+		userIds[fmt.Sprintf("%v", v["username"])] = fmt.Sprintf("%v", v["_id"])
+	}
+	var mentions []Mention
+	for _, candidate := range candidates {
+		if userId, ok := userIds[candidate.Username]; ok {
+			candidate.UserId = userId
+			mentions = append(mentions, candidate)
+		}
+	}
+	return mentions, nil
+}
+
+func DecodeMentions(value interface{}) ([]Mention, error) {
+	var decoded struct {
+		Mentions []Mention `bson:"mentions"`
+	}
+	if value == nil {
+		return decoded.Mentions, nil
+	}
+	mentionsBytes, err := bson.Marshal(bson.M{"mentions": value})
+	if err != nil {
+		return nil, err
+	}
+	err = bson.Unmarshal(mentionsBytes, &decoded)
+	return decoded.Mentions, err
+}
+
+type MentionUsecase interface {
+	FindUserMentions(context.Context, string, int, int, string) (*[]UserMention, error)
+}
+
+type MentionRepository interface {
+	CreateIndexes(context.Context) error
+	InsertMentions(context.Context, []UserMention) error
+	FindMentions(context.Context, interface{}) (*[]bson.M, error)
+	FindVisibleMentions(context.Context, interface{}, interface{}, interface{}, int64, int64) (*[]bson.M, error)
+	DeleteMentions(context.Context, string, string) error
+}
+
+type MentionHandler interface {
+	UserMentions(http.ResponseWriter, *http.Request)
+}
@@ -0,0 +1,119 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CollectionRepository is an autogenerated mock type for the CollectionRepository type
+type CollectionRepository struct {
+	mock.Mock
+}
+
+// CreateIndexes provides a mock function with given fields: _a0
+func (_m *CollectionRepository) CreateIndexes(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCollection provides a mock function with given fields: _a0, _a1
+func (_m *CollectionRepository) DeleteCollection(_a0 context.Context, _a1 string) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindCollections provides a mock function with given fields: _a0, _a1
+func (_m *CollectionRepository) FindCollections(_a0 context.Context, _a1 interface{}) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *[]primitive.M
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]primitive.M)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneCollection provides a mock function with given fields: _a0, _a1
+func (_m *CollectionRepository) FindOneCollection(_a0 context.Context, _a1 string) (*domain.Collection, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *domain.Collection
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Collection); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Collection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertCollection provides a mock function with given fields: _a0, _a1
+func (_m *CollectionRepository) InsertCollection(_a0 context.Context, _a1 *domain.Collection) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Collection) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCollection provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CollectionRepository) UpdateCollection(_a0 context.Context, _a1 string, _a2 string, _a3 time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
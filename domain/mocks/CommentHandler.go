@@ -18,7 +18,37 @@ func (_m *CommentHandler) Comment(_a0 http.ResponseWriter, _a1 *http.Request) {
 	_m.Called(_a0, _a1)
 }
 
+// CommentHide provides a mock function with given fields: _a0, _a1
+func (_m *CommentHandler) CommentHide(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// CommentPin provides a mock function with given fields: _a0, _a1
+func (_m *CommentHandler) CommentPin(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// CommentReplies provides a mock function with given fields: _a0, _a1
+func (_m *CommentHandler) CommentReplies(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// CommentRestore provides a mock function with given fields: _a0, _a1
+func (_m *CommentHandler) CommentRestore(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// CommentRevisions provides a mock function with given fields: _a0, _a1
+func (_m *CommentHandler) CommentRevisions(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
 // Comments provides a mock function with given fields: _a0, _a1
 func (_m *CommentHandler) Comments(_a0 http.ResponseWriter, _a1 *http.Request) {
 	_m.Called(_a0, _a1)
 }
+
+// UserDeletedComments provides a mock function with given fields: _a0, _a1
+func (_m *CommentHandler) UserDeletedComments(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
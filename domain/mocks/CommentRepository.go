@@ -3,10 +3,11 @@
 package mocks
 
 import (
+	context "context"
 	domain "instagram-go/domain"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
-
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -15,12 +16,35 @@ type CommentRepository struct {
 	mock.Mock
 }
 
-// DeleteComment provides a mock function with given fields: _a0
-func (_m *CommentRepository) DeleteComment(_a0 string) error {
+// CountReplies provides a mock function with given fields: _a0, _a1
+func (_m *CommentRepository) CountReplies(_a0 context.Context, _a1 []string) (map[string]int, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 map[string]int
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]int); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateIndexes provides a mock function with given fields: _a0
+func (_m *CommentRepository) CreateIndexes(_a0 context.Context) error {
 	ret := _m.Called(_a0)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
 		r0 = rf(_a0)
 	} else {
 		r0 = ret.Error(0)
@@ -29,13 +53,41 @@ func (_m *CommentRepository) DeleteComment(_a0 string) error {
 	return r0
 }
 
-// FindComments provides a mock function with given fields: _a0
-func (_m *CommentRepository) FindComments(_a0 interface{}) (*[]primitive.M, error) {
-	ret := _m.Called(_a0)
+// DeleteComment provides a mock function with given fields: _a0, _a1
+func (_m *CommentRepository) DeleteComment(_a0 context.Context, _a1 string) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteComments provides a mock function with given fields: _a0, _a1
+func (_m *CommentRepository) DeleteComments(_a0 context.Context, _a1 interface{}) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindComments provides a mock function with given fields: _a0, _a1
+func (_m *CommentRepository) FindComments(_a0 context.Context, _a1 interface{}) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *[]primitive.M
-	if rf, ok := ret.Get(0).(func(interface{}) *[]primitive.M); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]primitive.M)
@@ -43,8 +95,8 @@ func (_m *CommentRepository) FindComments(_a0 interface{}) (*[]primitive.M, erro
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,13 +104,13 @@ func (_m *CommentRepository) FindComments(_a0 interface{}) (*[]primitive.M, erro
 	return r0, r1
 }
 
-// FindOneComment provides a mock function with given fields: _a0
-func (_m *CommentRepository) FindOneComment(_a0 string) (*domain.Comment, error) {
-	ret := _m.Called(_a0)
+// FindOneComment provides a mock function with given fields: _a0, _a1
+func (_m *CommentRepository) FindOneComment(_a0 context.Context, _a1 string) (*domain.Comment, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *domain.Comment
-	if rf, ok := ret.Get(0).(func(string) *domain.Comment); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Comment); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Comment)
@@ -66,8 +118,8 @@ func (_m *CommentRepository) FindOneComment(_a0 string) (*domain.Comment, error)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,13 +127,59 @@ func (_m *CommentRepository) FindOneComment(_a0 string) (*domain.Comment, error)
 	return r0, r1
 }
 
-// InsertComment provides a mock function with given fields: _a0
-func (_m *CommentRepository) InsertComment(_a0 *domain.Comment) error {
-	ret := _m.Called(_a0)
+// FindPaginatedComments provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CommentRepository) FindPaginatedComments(_a0 context.Context, _a1 interface{}, _a2 int64, _a3 int64) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *[]primitive.M
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, int64, int64) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]primitive.M)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSortedComments provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CommentRepository) FindSortedComments(_a0 context.Context, _a1 interface{}, _a2 interface{}, _a3 int64) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *[]primitive.M
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}, int64) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]primitive.M)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, interface{}, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementCommentScore provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentRepository) IncrementCommentScore(_a0 context.Context, _a1 string, _a2 int) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.Comment) error); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -89,12 +187,12 @@ func (_m *CommentRepository) InsertComment(_a0 *domain.Comment) error {
 	return r0
 }
 
-// UpdateComment provides a mock function with given fields: _a0, _a1
-func (_m *CommentRepository) UpdateComment(_a0 string, _a1 string) error {
+// InsertComment provides a mock function with given fields: _a0, _a1
+func (_m *CommentRepository) InsertComment(_a0 context.Context, _a1 *domain.Comment) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Comment) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -102,3 +200,101 @@ func (_m *CommentRepository) UpdateComment(_a0 string, _a1 string) error {
 
 	return r0
 }
+
+// UpdateComment provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *CommentRepository) UpdateComment(_a0 context.Context, _a1 string, _a2 string, _a3 []string, _a4 []domain.Mention, _a5 *domain.CommentRevision) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, []domain.Mention, *domain.CommentRevision) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCommentDeletedAt provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentRepository) UpdateCommentDeletedAt(_a0 context.Context, _a1 string, _a2 *time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCommentDeletedBy provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentRepository) UpdateCommentDeletedBy(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCommentHidden provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentRepository) UpdateCommentHidden(_a0 context.Context, _a1 string, _a2 bool) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCommentPinned provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentRepository) UpdateCommentPinned(_a0 context.Context, _a1 string, _a2 bool) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCommentScores provides a mock function with given fields: _a0, _a1
+func (_m *CommentRepository) UpdateCommentScores(_a0 context.Context, _a1 map[string]int) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]int) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCommentsDeletedAt provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentRepository) UpdateCommentsDeletedAt(_a0 context.Context, _a1 interface{}, _a2 *time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, *time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
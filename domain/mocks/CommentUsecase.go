@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	domain "instagram-go/domain"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,13 +14,13 @@ type CommentUsecase struct {
 	mock.Mock
 }
 
-// DeleteComment provides a mock function with given fields: _a0, _a1
-func (_m *CommentUsecase) DeleteComment(_a0 string, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteComment provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) DeleteComment(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -27,13 +28,89 @@ func (_m *CommentUsecase) DeleteComment(_a0 string, _a1 string) error {
 	return r0
 }
 
-// FindComments provides a mock function with given fields: _a0
-func (_m *CommentUsecase) FindComments(_a0 string) (*[]domain.Comment, error) {
-	ret := _m.Called(_a0)
+// FindCommentReplies provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *CommentUsecase) FindCommentReplies(_a0 context.Context, _a1 string, _a2 string, _a3 int, _a4 int, _a5 string) (*[]domain.Comment, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 *[]domain.Comment
-	if rf, ok := ret.Get(0).(func(string) *[]domain.Comment); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int, string) *[]domain.Comment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Comment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindCommentRevisions provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CommentUsecase) FindCommentRevisions(_a0 context.Context, _a1 string, _a2 string, _a3 string) (*[]domain.CommentRevision, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *[]domain.CommentRevision
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *[]domain.CommentRevision); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.CommentRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindComments provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *CommentUsecase) FindComments(_a0 context.Context, _a1 string, _a2 string, _a3 string, _a4 int, _a5 string) (*[]domain.Comment, string, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 *[]domain.Comment
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int, string) *[]domain.Comment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Comment)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int, string) string); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, int, string) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FindDeletedComments provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) FindDeletedComments(_a0 context.Context, _a1 string, _a2 string) (*[]domain.Comment, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *[]domain.Comment
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *[]domain.Comment); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]domain.Comment)
@@ -41,8 +118,8 @@ func (_m *CommentUsecase) FindComments(_a0 string) (*[]domain.Comment, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -50,13 +127,111 @@ func (_m *CommentUsecase) FindComments(_a0 string) (*[]domain.Comment, error) {
 	return r0, r1
 }
 
-// PostComment provides a mock function with given fields: _a0, _a1
-func (_m *CommentUsecase) PostComment(_a0 *domain.Comment, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// HideComment provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) HideComment(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MigrateCommentScores provides a mock function with given fields: _a0
+func (_m *CommentUsecase) MigrateCommentScores(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PinComment provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) PinComment(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PostComment provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) PostComment(_a0 context.Context, _a1 *domain.Comment, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Comment, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDeletedComments provides a mock function with given fields: _a0
+func (_m *CommentUsecase) PurgeDeletedComments(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutComment provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) PutComment(_a0 context.Context, _a1 *domain.Comment, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Comment, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreComment provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) RestoreComment(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnhideComment provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) UnhideComment(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.Comment, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -64,13 +239,13 @@ func (_m *CommentUsecase) PostComment(_a0 *domain.Comment, _a1 string) error {
 	return r0
 }
 
-// PutComment provides a mock function with given fields: _a0, _a1
-func (_m *CommentUsecase) PutComment(_a0 *domain.Comment, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// UnpinComment provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CommentUsecase) UnpinComment(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.Comment, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,36 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ContentModerator is an autogenerated mock type for the ContentModerator type
+type ContentModerator struct {
+	mock.Mock
+}
+
+// Moderate provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ContentModerator) Moderate(_a0 context.Context, _a1 string, _a2 *domain.CommentFilter) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.CommentFilter) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.CommentFilter) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,66 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HashtagRepository is an autogenerated mock type for the HashtagRepository type
+type HashtagRepository struct {
+	mock.Mock
+}
+
+// FindOneHashtag provides a mock function with given fields: _a0, _a1
+func (_m *HashtagRepository) FindOneHashtag(_a0 context.Context, _a1 string) (*domain.Hashtag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *domain.Hashtag
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Hashtag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Hashtag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateHashtagCommentCounts provides a mock function with given fields: _a0, _a1, _a2
+func (_m *HashtagRepository) UpdateHashtagCommentCounts(_a0 context.Context, _a1 []string, _a2 int) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateHashtagPostCounts provides a mock function with given fields: _a0, _a1, _a2
+func (_m *HashtagRepository) UpdateHashtagPostCounts(_a0 context.Context, _a1 []string, _a2 int) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
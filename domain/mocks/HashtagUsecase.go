@@ -0,0 +1,38 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HashtagUsecase is an autogenerated mock type for the HashtagUsecase type
+type HashtagUsecase struct {
+	mock.Mock
+}
+
+// FindHashtag provides a mock function with given fields: _a0, _a1
+func (_m *HashtagUsecase) FindHashtag(_a0 context.Context, _a1 string) (*domain.Hashtag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *domain.Hashtag
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Hashtag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Hashtag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
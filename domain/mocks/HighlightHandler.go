@@ -0,0 +1,29 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HighlightHandler is an autogenerated mock type for the HighlightHandler type
+type HighlightHandler struct {
+	mock.Mock
+}
+
+// Highlight provides a mock function with given fields: _a0, _a1
+func (_m *HighlightHandler) Highlight(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// Highlights provides a mock function with given fields: _a0, _a1
+func (_m *HighlightHandler) Highlights(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// UserHighlights provides a mock function with given fields: _a0, _a1
+func (_m *HighlightHandler) UserHighlights(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
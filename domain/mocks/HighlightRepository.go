@@ -0,0 +1,118 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+
+	mock "github.com/stretchr/testify/mock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// HighlightRepository is an autogenerated mock type for the HighlightRepository type
+type HighlightRepository struct {
+	mock.Mock
+}
+
+// CreateIndexes provides a mock function with given fields: _a0
+func (_m *HighlightRepository) CreateIndexes(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHighlight provides a mock function with given fields: _a0, _a1
+func (_m *HighlightRepository) DeleteHighlight(_a0 context.Context, _a1 string) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindHighlights provides a mock function with given fields: _a0, _a1
+func (_m *HighlightRepository) FindHighlights(_a0 context.Context, _a1 interface{}) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *[]primitive.M
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]primitive.M)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOneHighlight provides a mock function with given fields: _a0, _a1
+func (_m *HighlightRepository) FindOneHighlight(_a0 context.Context, _a1 string) (*domain.Highlight, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *domain.Highlight
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Highlight); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Highlight)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertHighlight provides a mock function with given fields: _a0, _a1
+func (_m *HighlightRepository) InsertHighlight(_a0 context.Context, _a1 *domain.Highlight) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Highlight) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateHighlight provides a mock function with given fields: _a0, _a1
+func (_m *HighlightRepository) UpdateHighlight(_a0 context.Context, _a1 *domain.Highlight) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Highlight) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
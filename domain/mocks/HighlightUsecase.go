@@ -0,0 +1,81 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+	multipart "mime/multipart"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HighlightUsecase is an autogenerated mock type for the HighlightUsecase type
+type HighlightUsecase struct {
+	mock.Mock
+}
+
+// DeleteHighlight provides a mock function with given fields: _a0, _a1, _a2
+func (_m *HighlightUsecase) DeleteHighlight(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindUserHighlights provides a mock function with given fields: _a0, _a1
+func (_m *HighlightUsecase) FindUserHighlights(_a0 context.Context, _a1 string) (*[]domain.Highlight, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *[]domain.Highlight
+	if rf, ok := ret.Get(0).(func(context.Context, string) *[]domain.Highlight); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Highlight)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertHighlight provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *HighlightUsecase) InsertHighlight(_a0 context.Context, _a1 *domain.Highlight, _a2 string, _a3 *multipart.FileHeader) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Highlight, string, *multipart.FileHeader) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateHighlight provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *HighlightUsecase) UpdateHighlight(_a0 context.Context, _a1 *domain.Highlight, _a2 string, _a3 *multipart.FileHeader) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Highlight, string, *multipart.FileHeader) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -4,13 +4,12 @@ package mocks
 
 import (
 	image "image"
-	fs "io/fs"
-
+	domain "instagram-go/domain"
 	io "io"
+	fs "io/fs"
+	os "os"
 
 	mock "github.com/stretchr/testify/mock"
-
-	os "os"
 )
 
 // IFileOsHelper is an autogenerated mock type for the IFileOsHelper type
@@ -92,6 +91,80 @@ func (_m *IFileOsHelper) DecodeImage(_a0 io.Reader) (image.Image, string, error)
 	return r0, r1, r2
 }
 
+// DecodeImageConfig provides a mock function with given fields: _a0
+func (_m *IFileOsHelper) DecodeImageConfig(_a0 io.Reader) (image.Config, string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 image.Config
+	if rf, ok := ret.Get(0).(func(io.Reader) image.Config); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(image.Config)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(io.Reader) string); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(io.Reader) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DecodeImageGPS provides a mock function with given fields: _a0
+func (_m *IFileOsHelper) DecodeImageGPS(_a0 io.ReadSeeker) (*domain.GeoPoint, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *domain.GeoPoint
+	if rf, ok := ret.Get(0).(func(io.ReadSeeker) *domain.GeoPoint); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.GeoPoint)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(io.ReadSeeker) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeVideoConfig provides a mock function with given fields: _a0
+func (_m *IFileOsHelper) DecodeVideoConfig(_a0 io.ReadSeeker) (*domain.VideoConfig, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *domain.VideoConfig
+	if rf, ok := ret.Get(0).(func(io.ReadSeeker) *domain.VideoConfig); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VideoConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(io.ReadSeeker) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MkDirAll provides a mock function with given fields: _a0, _a1
 func (_m *IFileOsHelper) MkDirAll(_a0 string, _a1 fs.FileMode) error {
 	ret := _m.Called(_a0, _a1)
@@ -106,6 +179,20 @@ func (_m *IFileOsHelper) MkDirAll(_a0 string, _a1 fs.FileMode) error {
 	return r0
 }
 
+// Remove provides a mock function with given fields: _a0
+func (_m *IFileOsHelper) Remove(_a0 string) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResizeAndSaveFileToLocale provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *IFileOsHelper) ResizeAndSaveFileToLocale(_a0 string, _a1 image.Image, _a2 string, _a3 string) (string, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
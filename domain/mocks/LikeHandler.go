@@ -13,6 +13,11 @@ type LikeHandler struct {
 	mock.Mock
 }
 
+// CommentReactions provides a mock function with given fields: _a0, _a1
+func (_m *LikeHandler) CommentReactions(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
 // DeleteCommentLike provides a mock function with given fields: _a0, _a1
 func (_m *LikeHandler) DeleteCommentLike(_a0 http.ResponseWriter, _a1 *http.Request) {
 	_m.Called(_a0, _a1)
@@ -23,6 +28,16 @@ func (_m *LikeHandler) DeleteLikePost(_a0 http.ResponseWriter, _a1 *http.Request
 	_m.Called(_a0, _a1)
 }
 
+// GetCommentLikes provides a mock function with given fields: _a0, _a1
+func (_m *LikeHandler) GetCommentLikes(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// GetPostLikes provides a mock function with given fields: _a0, _a1
+func (_m *LikeHandler) GetPostLikes(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
 // PostCommentLike provides a mock function with given fields: _a0, _a1
 func (_m *LikeHandler) PostCommentLike(_a0 http.ResponseWriter, _a1 *http.Request) {
 	_m.Called(_a0, _a1)
@@ -32,3 +47,18 @@ func (_m *LikeHandler) PostCommentLike(_a0 http.ResponseWriter, _a1 *http.Reques
 func (_m *LikeHandler) PostLikePost(_a0 http.ResponseWriter, _a1 *http.Request) {
 	_m.Called(_a0, _a1)
 }
+
+// PostReactions provides a mock function with given fields: _a0, _a1
+func (_m *LikeHandler) PostReactions(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// UnlikeComment provides a mock function with given fields: _a0, _a1
+func (_m *LikeHandler) UnlikeComment(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// UnlikePost provides a mock function with given fields: _a0, _a1
+func (_m *LikeHandler) UnlikePost(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
@@ -3,10 +3,10 @@
 package mocks
 
 import (
+	context "context"
 	domain "instagram-go/domain"
 
 	mock "github.com/stretchr/testify/mock"
-
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -15,12 +15,35 @@ type LikeRepository struct {
 	mock.Mock
 }
 
-// DeleteLike provides a mock function with given fields: _a0
-func (_m *LikeRepository) DeleteLike(_a0 string) error {
+// CountReactions provides a mock function with given fields: _a0, _a1, _a2
+func (_m *LikeRepository) CountReactions(_a0 context.Context, _a1 string, _a2 []string) (map[string]map[string]int, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 map[string]map[string]int
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) map[string]map[string]int); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateIndexes provides a mock function with given fields: _a0
+func (_m *LikeRepository) CreateIndexes(_a0 context.Context) error {
 	ret := _m.Called(_a0)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
 		r0 = rf(_a0)
 	} else {
 		r0 = ret.Error(0)
@@ -29,13 +52,48 @@ func (_m *LikeRepository) DeleteLike(_a0 string) error {
 	return r0
 }
 
-// FindLikes provides a mock function with given fields: _a0
-func (_m *LikeRepository) FindLikes(_a0 interface{}) (*[]primitive.M, error) {
-	ret := _m.Called(_a0)
+// DeleteLike provides a mock function with given fields: _a0, _a1
+func (_m *LikeRepository) DeleteLike(_a0 context.Context, _a1 string) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteLikes provides a mock function with given fields: _a0, _a1
+func (_m *LikeRepository) DeleteLikes(_a0 context.Context, _a1 interface{}) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindLikes provides a mock function with given fields: _a0, _a1
+func (_m *LikeRepository) FindLikes(_a0 context.Context, _a1 interface{}) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *[]primitive.M
-	if rf, ok := ret.Get(0).(func(interface{}) *[]primitive.M); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]primitive.M)
@@ -43,8 +101,8 @@ func (_m *LikeRepository) FindLikes(_a0 interface{}) (*[]primitive.M, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,13 +110,13 @@ func (_m *LikeRepository) FindLikes(_a0 interface{}) (*[]primitive.M, error) {
 	return r0, r1
 }
 
-// FindOneLike provides a mock function with given fields: _a0
-func (_m *LikeRepository) FindOneLike(_a0 string) (*domain.Like, error) {
-	ret := _m.Called(_a0)
+// FindOneLike provides a mock function with given fields: _a0, _a1
+func (_m *LikeRepository) FindOneLike(_a0 context.Context, _a1 string) (*domain.Like, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *domain.Like
-	if rf, ok := ret.Get(0).(func(string) *domain.Like); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Like); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Like)
@@ -66,8 +124,31 @@ func (_m *LikeRepository) FindOneLike(_a0 string) (*domain.Like, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPaginatedLikes provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *LikeRepository) FindPaginatedLikes(_a0 context.Context, _a1 interface{}, _a2 int64, _a3 int64) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *[]primitive.M
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, int64, int64) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]primitive.M)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,12 +156,26 @@ func (_m *LikeRepository) FindOneLike(_a0 string) (*domain.Like, error) {
 	return r0, r1
 }
 
-// InsertLike provides a mock function with given fields: _a0
-func (_m *LikeRepository) InsertLike(_a0 *domain.Like) error {
+// InsertLike provides a mock function with given fields: _a0, _a1
+func (_m *LikeRepository) InsertLike(_a0 context.Context, _a1 *domain.Like) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Like) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MigrateReactions provides a mock function with given fields: _a0
+func (_m *LikeRepository) MigrateReactions(_a0 context.Context) error {
 	ret := _m.Called(_a0)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.Like) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
 		r0 = rf(_a0)
 	} else {
 		r0 = ret.Error(0)
@@ -88,3 +183,17 @@ func (_m *LikeRepository) InsertLike(_a0 *domain.Like) error {
 
 	return r0
 }
+
+// UpdateLikeReaction provides a mock function with given fields: _a0, _a1, _a2
+func (_m *LikeRepository) UpdateLikeReaction(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
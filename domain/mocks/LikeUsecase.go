@@ -2,20 +2,39 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+	domain "instagram-go/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // LikeUsecase is an autogenerated mock type for the LikeUsecase type
 type LikeUsecase struct {
 	mock.Mock
 }
 
-// DeleteCommentLike provides a mock function with given fields: _a0, _a1
-func (_m *LikeUsecase) DeleteCommentLike(_a0 string, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteCommentLike provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *LikeUsecase) DeleteCommentLike(_a0 context.Context, _a1 string, _a2 string, _a3 string, _a4 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePostLike provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *LikeUsecase) DeletePostLike(_a0 context.Context, _a1 string, _a2 string, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -23,13 +42,133 @@ func (_m *LikeUsecase) DeleteCommentLike(_a0 string, _a1 string) error {
 	return r0
 }
 
-// DeletePostLike provides a mock function with given fields: _a0, _a1
-func (_m *LikeUsecase) DeletePostLike(_a0 string, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteReaction provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *LikeUsecase) DeleteReaction(_a0 context.Context, _a1 string, _a2 string, _a3 string, _a4 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindCommentLikes provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *LikeUsecase) FindCommentLikes(_a0 context.Context, _a1 string, _a2 string, _a3 int, _a4 int, _a5 string) (*[]domain.Liker, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 *[]domain.Liker
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int, string) *[]domain.Liker); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Liker)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPostLikes provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *LikeUsecase) FindPostLikes(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.Liker, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *[]domain.Liker
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.Liker); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Liker)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertCommentLike provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *LikeUsecase) InsertCommentLike(_a0 context.Context, _a1 string, _a2 string, _a3 string) (*domain.Like, bool, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *domain.Like
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.Like); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Like)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) bool); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// InsertPostLike provides a mock function with given fields: _a0, _a1, _a2
+func (_m *LikeUsecase) InsertPostLike(_a0 context.Context, _a1 string, _a2 string) (*domain.Like, bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *domain.Like
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.Like); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Like)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PutReaction provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *LikeUsecase) PutReaction(_a0 context.Context, _a1 string, _a2 string, _a3 string, _a4 string, _a5 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -37,13 +176,13 @@ func (_m *LikeUsecase) DeletePostLike(_a0 string, _a1 string) error {
 	return r0
 }
 
-// InsertCommentLike provides a mock function with given fields: _a0, _a1
-func (_m *LikeUsecase) InsertCommentLike(_a0 string, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// UnlikeComment provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *LikeUsecase) UnlikeComment(_a0 context.Context, _a1 string, _a2 string, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -51,13 +190,13 @@ func (_m *LikeUsecase) InsertCommentLike(_a0 string, _a1 string) error {
 	return r0
 }
 
-// InsertPostLike provides a mock function with given fields: _a0, _a1
-func (_m *LikeUsecase) InsertPostLike(_a0 string, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// UnlikePost provides a mock function with given fields: _a0, _a1, _a2
+func (_m *LikeUsecase) UnlikePost(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,19 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MentionHandler is an autogenerated mock type for the MentionHandler type
+type MentionHandler struct {
+	mock.Mock
+}
+
+// UserMentions provides a mock function with given fields: _a0, _a1
+func (_m *MentionHandler) UserMentions(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
@@ -0,0 +1,104 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+
+	mock "github.com/stretchr/testify/mock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MentionRepository is an autogenerated mock type for the MentionRepository type
+type MentionRepository struct {
+	mock.Mock
+}
+
+// CreateIndexes provides a mock function with given fields: _a0
+func (_m *MentionRepository) CreateIndexes(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteMentions provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MentionRepository) DeleteMentions(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindMentions provides a mock function with given fields: _a0, _a1
+func (_m *MentionRepository) FindMentions(_a0 context.Context, _a1 interface{}) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *[]primitive.M
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]primitive.M)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindVisibleMentions provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *MentionRepository) FindVisibleMentions(_a0 context.Context, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 int64, _a5 int64) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 *[]primitive.M
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}, interface{}, int64, int64) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]primitive.M)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, interface{}, interface{}, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertMentions provides a mock function with given fields: _a0, _a1
+func (_m *MentionRepository) InsertMentions(_a0 context.Context, _a1 []domain.UserMention) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.UserMention) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
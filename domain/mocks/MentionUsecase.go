@@ -0,0 +1,38 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MentionUsecase is an autogenerated mock type for the MentionUsecase type
+type MentionUsecase struct {
+	mock.Mock
+}
+
+// FindUserMentions provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MentionUsecase) FindUserMentions(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.UserMention, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *[]domain.UserMention
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.UserMention); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.UserMention)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
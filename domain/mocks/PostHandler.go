@@ -13,12 +13,92 @@ type PostHandler struct {
 	mock.Mock
 }
 
+// CollectionPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) CollectionPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// HashtagPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) HashtagPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// LocationPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) LocationPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// NearbyPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) NearbyPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
 // Post provides a mock function with given fields: _a0, _a1
 func (_m *PostHandler) Post(_a0 http.ResponseWriter, _a1 *http.Request) {
 	_m.Called(_a0, _a1)
 }
 
+// PostArchive provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) PostArchive(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// PostPin provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) PostPin(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// PostRestore provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) PostRestore(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// PostSettings provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) PostSettings(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// PostStatus provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) PostStatus(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// PostUserTags provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) PostUserTags(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// PostVisualMedias provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) PostVisualMedias(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
 // Posts provides a mock function with given fields: _a0, _a1
 func (_m *PostHandler) Posts(_a0 http.ResponseWriter, _a1 *http.Request) {
 	_m.Called(_a0, _a1)
 }
+
+// SavedPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) SavedPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// UserArchivedPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) UserArchivedPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// UserDeletedPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) UserDeletedPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// UserPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) UserPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// UserTaggedPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostHandler) UserTaggedPosts(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
@@ -3,10 +3,11 @@
 package mocks
 
 import (
+	context "context"
 	domain "instagram-go/domain"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
-
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -15,12 +16,12 @@ type PostRepository struct {
 	mock.Mock
 }
 
-// DeletePost provides a mock function with given fields: _a0
-func (_m *PostRepository) DeletePost(_a0 string) error {
+// CreateIndexes provides a mock function with given fields: _a0
+func (_m *PostRepository) CreateIndexes(_a0 context.Context) error {
 	ret := _m.Called(_a0)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
 		r0 = rf(_a0)
 	} else {
 		r0 = ret.Error(0)
@@ -29,13 +30,27 @@ func (_m *PostRepository) DeletePost(_a0 string) error {
 	return r0
 }
 
-// FindOnePost provides a mock function with given fields: _a0
-func (_m *PostRepository) FindOnePost(_a0 string) (*domain.Post, error) {
-	ret := _m.Called(_a0)
+// DeletePost provides a mock function with given fields: _a0, _a1
+func (_m *PostRepository) DeletePost(_a0 context.Context, _a1 string) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindOnePost provides a mock function with given fields: _a0, _a1
+func (_m *PostRepository) FindOnePost(_a0 context.Context, _a1 string) (*domain.Post, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *domain.Post
-	if rf, ok := ret.Get(0).(func(string) *domain.Post); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Post)
@@ -43,8 +58,8 @@ func (_m *PostRepository) FindOnePost(_a0 string) (*domain.Post, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,13 +67,13 @@ func (_m *PostRepository) FindOnePost(_a0 string) (*domain.Post, error) {
 	return r0, r1
 }
 
-// FindPosts provides a mock function with given fields: _a0
-func (_m *PostRepository) FindPosts(_a0 interface{}) (*[]primitive.M, error) {
-	ret := _m.Called(_a0)
+// FindPaginatedPosts provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *PostRepository) FindPaginatedPosts(_a0 context.Context, _a1 interface{}, _a2 int64, _a3 int64) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 *[]primitive.M
-	if rf, ok := ret.Get(0).(func(interface{}) *[]primitive.M); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, int64, int64) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]primitive.M)
@@ -66,8 +81,8 @@ func (_m *PostRepository) FindPosts(_a0 interface{}) (*[]primitive.M, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,12 +90,49 @@ func (_m *PostRepository) FindPosts(_a0 interface{}) (*[]primitive.M, error) {
 	return r0, r1
 }
 
-// InsertPost provides a mock function with given fields: _a0
-func (_m *PostRepository) InsertPost(_a0 *domain.Post) error {
+// FindPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostRepository) FindPosts(_a0 context.Context, _a1 interface{}) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *[]primitive.M
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]primitive.M)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertPost provides a mock function with given fields: _a0, _a1
+func (_m *PostRepository) InsertPost(_a0 context.Context, _a1 *domain.Post) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Post) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MigrateVisualMediaUrls provides a mock function with given fields: _a0
+func (_m *PostRepository) MigrateVisualMediaUrls(_a0 context.Context) error {
 	ret := _m.Called(_a0)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.Post) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
 		r0 = rf(_a0)
 	} else {
 		r0 = ret.Error(0)
@@ -89,13 +141,111 @@ func (_m *PostRepository) InsertPost(_a0 *domain.Post) error {
 	return r0
 }
 
-// UpdatePost provides a mock function with given fields: _a0, _a1
-func (_m *PostRepository) UpdatePost(_a0 string, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// UpdatePost provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostRepository) UpdatePost(_a0 context.Context, _a1 string, _a2 string, _a3 []string, _a4 []domain.Mention) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, []domain.Mention) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePostArchivedDate provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostRepository) UpdatePostArchivedDate(_a0 context.Context, _a1 string, _a2 *time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePostDeletedAt provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostRepository) UpdatePostDeletedAt(_a0 context.Context, _a1 string, _a2 *time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePostPinnedDate provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostRepository) UpdatePostPinnedDate(_a0 context.Context, _a1 string, _a2 *time.Time) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePostSettings provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *PostRepository) UpdatePostSettings(_a0 context.Context, _a1 string, _a2 bool, _a3 bool) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, bool) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePostStatus provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostRepository) UpdatePostStatus(_a0 context.Context, _a1 string, _a2 string, _a3 string, _a4 *time.Time) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *time.Time) bool); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePostVisualMedias provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostRepository) UpdatePostVisualMedias(_a0 context.Context, _a1 string, _a2 []domain.VisualMedia) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []domain.VisualMedia) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
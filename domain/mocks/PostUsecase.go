@@ -3,11 +3,12 @@
 package mocks
 
 import (
+	context "context"
 	domain "instagram-go/domain"
+	multipart "mime/multipart"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
-
-	multipart "mime/multipart"
 )
 
 // PostUsecase is an autogenerated mock type for the PostUsecase type
@@ -15,13 +16,41 @@ type PostUsecase struct {
 	mock.Mock
 }
 
-// DeletePost provides a mock function with given fields: _a0, _a1
-func (_m *PostUsecase) DeletePost(_a0 string, _a1 string) error {
-	ret := _m.Called(_a0, _a1)
+// ArchivePost provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostUsecase) ArchivePost(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePost provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostUsecase) DeletePost(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePostUserTag provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostUsecase) DeletePostUserTag(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -29,13 +58,151 @@ func (_m *PostUsecase) DeletePost(_a0 string, _a1 string) error {
 	return r0
 }
 
-// FindPosts provides a mock function with given fields:
-func (_m *PostUsecase) FindPosts() (*[]domain.Post, error) {
-	ret := _m.Called()
+// FindArchivedPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) FindArchivedPosts(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *[]domain.Post
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDeletedPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) FindDeletedPosts(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *[]domain.Post
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindHashtagPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) FindHashtagPosts(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *[]domain.Post
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindLocationPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) FindLocationPosts(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *[]domain.Post
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindNearbyPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *PostUsecase) FindNearbyPosts(_a0 context.Context, _a1 *domain.GeoPoint, _a2 float64, _a3 int, _a4 int, _a5 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 *[]domain.Post
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.GeoPoint, float64, int, int, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.GeoPoint, float64, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPosts provides a mock function with given fields: _a0, _a1
+func (_m *PostUsecase) FindPosts(_a0 context.Context, _a1 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *[]domain.Post
+	if rf, ok := ret.Get(0).(func(context.Context, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSavedPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) FindSavedPosts(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	var r0 *[]domain.Post
-	if rf, ok := ret.Get(0).(func() *[]domain.Post); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]domain.Post)
@@ -43,8 +210,8 @@ func (_m *PostUsecase) FindPosts() (*[]domain.Post, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,12 +219,128 @@ func (_m *PostUsecase) FindPosts() (*[]domain.Post, error) {
 	return r0, r1
 }
 
-// InsertPost provides a mock function with given fields: _a0, _a1, _a2
-func (_m *PostUsecase) InsertPost(_a0 *domain.Post, _a1 string, _a2 []*multipart.FileHeader) error {
+// FindUserPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) FindUserPosts(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *[]domain.Post
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindUserTaggedPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) FindUserTaggedPosts(_a0 context.Context, _a1 string, _a2 int, _a3 int, _a4 string) (*[]domain.Post, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 *[]domain.Post
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string) *[]domain.Post); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertPost provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *PostUsecase) InsertPost(_a0 context.Context, _a1 *domain.Post, _a2 string, _a3 []*multipart.FileHeader) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Post, string, []*multipart.FileHeader) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PinPost provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostUsecase) PinPost(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PublishScheduledPosts provides a mock function with given fields: _a0
+func (_m *PostUsecase) PublishScheduledPosts(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDeletedPosts provides a mock function with given fields: _a0
+func (_m *PostUsecase) PurgeDeletedPosts(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreDeletedPost provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostUsecase) RestoreDeletedPost(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestorePost provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostUsecase) RestorePost(_a0 context.Context, _a1 string, _a2 string) error {
 	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.Post, string, []*multipart.FileHeader) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
 		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
@@ -66,12 +349,12 @@ func (_m *PostUsecase) InsertPost(_a0 *domain.Post, _a1 string, _a2 []*multipart
 	return r0
 }
 
-// UpdatePost provides a mock function with given fields: _a0, _a1, _a2
-func (_m *PostUsecase) UpdatePost(_a0 string, _a1 string, _a2 string) error {
+// UnpinPost provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PostUsecase) UnpinPost(_a0 context.Context, _a1 string, _a2 string) error {
 	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
 		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
@@ -79,3 +362,73 @@ func (_m *PostUsecase) UpdatePost(_a0 string, _a1 string, _a2 string) error {
 
 	return r0
 }
+
+// UpdatePost provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *PostUsecase) UpdatePost(_a0 context.Context, _a1 string, _a2 string, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePostSettings provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) UpdatePostSettings(_a0 context.Context, _a1 string, _a2 bool, _a3 bool, _a4 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, bool, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePostStatus provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *PostUsecase) UpdatePostStatus(_a0 context.Context, _a1 string, _a2 string, _a3 *time.Time, _a4 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *time.Time, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePostUserTags provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *PostUsecase) UpdatePostUserTags(_a0 context.Context, _a1 string, _a2 []domain.VisualMediaUserTag, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []domain.VisualMediaUserTag, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePostVisualMedias provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *PostUsecase) UpdatePostVisualMedias(_a0 context.Context, _a1 string, _a2 []int, _a3 []*multipart.FileHeader, _a4 []string, _a5 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int, []*multipart.FileHeader, []string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,29 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SaveHandler is an autogenerated mock type for the SaveHandler type
+type SaveHandler struct {
+	mock.Mock
+}
+
+// Collection provides a mock function with given fields: _a0, _a1
+func (_m *SaveHandler) Collection(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// Collections provides a mock function with given fields: _a0, _a1
+func (_m *SaveHandler) Collections(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// PostSaves provides a mock function with given fields: _a0, _a1
+func (_m *SaveHandler) PostSaves(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
@@ -0,0 +1,122 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SaveUsecase is an autogenerated mock type for the SaveUsecase type
+type SaveUsecase struct {
+	mock.Mock
+}
+
+// DeleteCollection provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SaveUsecase) DeleteCollection(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSave provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SaveUsecase) DeleteSave(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindCollections provides a mock function with given fields: _a0, _a1
+func (_m *SaveUsecase) FindCollections(_a0 context.Context, _a1 string) (*[]domain.Collection, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *[]domain.Collection
+	if rf, ok := ret.Get(0).(func(context.Context, string) *[]domain.Collection); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Collection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertCollection provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SaveUsecase) InsertCollection(_a0 context.Context, _a1 *domain.Collection, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Collection, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertSave provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *SaveUsecase) InsertSave(_a0 context.Context, _a1 string, _a2 string, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCollection provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SaveUsecase) UpdateCollection(_a0 context.Context, _a1 *domain.Collection, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Collection, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSaveCollection provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *SaveUsecase) UpdateSaveCollection(_a0 context.Context, _a1 string, _a2 string, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
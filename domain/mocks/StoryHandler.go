@@ -0,0 +1,29 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StoryHandler is an autogenerated mock type for the StoryHandler type
+type StoryHandler struct {
+	mock.Mock
+}
+
+// Stories provides a mock function with given fields: _a0, _a1
+func (_m *StoryHandler) Stories(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// StoryViews provides a mock function with given fields: _a0, _a1
+func (_m *StoryHandler) StoryViews(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
+
+// UserStories provides a mock function with given fields: _a0, _a1
+func (_m *StoryHandler) UserStories(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
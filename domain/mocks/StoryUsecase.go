@@ -0,0 +1,104 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "instagram-go/domain"
+	multipart "mime/multipart"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StoryUsecase is an autogenerated mock type for the StoryUsecase type
+type StoryUsecase struct {
+	mock.Mock
+}
+
+// FindStoryTrays provides a mock function with given fields: _a0, _a1
+func (_m *StoryUsecase) FindStoryTrays(_a0 context.Context, _a1 string) (*[]domain.StoryTray, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *[]domain.StoryTray
+	if rf, ok := ret.Get(0).(func(context.Context, string) *[]domain.StoryTray); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.StoryTray)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindUserStories provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StoryUsecase) FindUserStories(_a0 context.Context, _a1 string, _a2 string) (*[]domain.Story, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *[]domain.Story
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *[]domain.Story); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Story)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertStory provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StoryUsecase) InsertStory(_a0 context.Context, _a1 *multipart.FileHeader, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *multipart.FileHeader, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeExpiredStories provides a mock function with given fields: _a0
+func (_m *StoryUsecase) PurgeExpiredStories(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ViewStory provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StoryUsecase) ViewStory(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -27,3 +27,8 @@ func (_m *UserHandler) PostUser(_a0 http.ResponseWriter, _a1 *http.Request) {
 func (_m *UserHandler) PutUser(_a0 http.ResponseWriter, _a1 *http.Request) {
 	_m.Called(_a0, _a1)
 }
+
+// UserCommentFilter provides a mock function with given fields: _a0, _a1
+func (_m *UserHandler) UserCommentFilter(_a0 http.ResponseWriter, _a1 *http.Request) {
+	_m.Called(_a0, _a1)
+}
@@ -3,10 +3,10 @@
 package mocks
 
 import (
+	context "context"
 	domain "instagram-go/domain"
 
 	mock "github.com/stretchr/testify/mock"
-
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -15,13 +15,41 @@ type UserRepository struct {
 	mock.Mock
 }
 
-// FindOneUser provides a mock function with given fields: filter
-func (_m *UserRepository) FindOneUser(filter interface{}) (*domain.User, error) {
-	ret := _m.Called(filter)
+// CreateIndexes provides a mock function with given fields: _a0
+func (_m *UserRepository) CreateIndexes(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DecrementUserPinnedCount provides a mock function with given fields: _a0, _a1
+func (_m *UserRepository) DecrementUserPinnedCount(_a0 context.Context, _a1 string) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindOneUser provides a mock function with given fields: ctx, filter
+func (_m *UserRepository) FindOneUser(ctx context.Context, filter interface{}) (*domain.User, error) {
+	ret := _m.Called(ctx, filter)
 
 	var r0 *domain.User
-	if rf, ok := ret.Get(0).(func(interface{}) *domain.User); ok {
-		r0 = rf(filter)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) *domain.User); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
@@ -29,8 +57,8 @@ func (_m *UserRepository) FindOneUser(filter interface{}) (*domain.User, error)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
-		r1 = rf(filter)
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,13 +66,13 @@ func (_m *UserRepository) FindOneUser(filter interface{}) (*domain.User, error)
 	return r0, r1
 }
 
-// FindUser provides a mock function with given fields: _a0
-func (_m *UserRepository) FindUser(_a0 interface{}) (*[]primitive.M, error) {
-	ret := _m.Called(_a0)
+// FindUser provides a mock function with given fields: _a0, _a1
+func (_m *UserRepository) FindUser(_a0 context.Context, _a1 interface{}) (*[]primitive.M, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *[]primitive.M
-	if rf, ok := ret.Get(0).(func(interface{}) *[]primitive.M); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) *[]primitive.M); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]primitive.M)
@@ -52,8 +80,8 @@ func (_m *UserRepository) FindUser(_a0 interface{}) (*[]primitive.M, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,13 +89,34 @@ func (_m *UserRepository) FindUser(_a0 interface{}) (*[]primitive.M, error) {
 	return r0, r1
 }
 
-// InsertUser provides a mock function with given fields: _a0
-func (_m *UserRepository) InsertUser(_a0 *domain.User) error {
-	ret := _m.Called(_a0)
+// IncrementUserPinnedCount provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserRepository) IncrementUserPinnedCount(_a0 context.Context, _a1 string, _a2 int) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertUser provides a mock function with given fields: _a0, _a1
+func (_m *UserRepository) InsertUser(_a0 context.Context, _a1 *domain.User) error {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.User) error); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -75,13 +124,27 @@ func (_m *UserRepository) InsertUser(_a0 *domain.User) error {
 	return r0
 }
 
-// UpdateUser provides a mock function with given fields: _a0
-func (_m *UserRepository) UpdateUser(_a0 *domain.User) error {
-	ret := _m.Called(_a0)
+// UpdateUser provides a mock function with given fields: _a0, _a1
+func (_m *UserRepository) UpdateUser(_a0 context.Context, _a1 *domain.User) error {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.User) error); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUserCommentFilter provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserRepository) UpdateUserCommentFilter(_a0 context.Context, _a1 string, _a2 *domain.CommentFilter) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.CommentFilter) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3,11 +3,11 @@
 package mocks
 
 import (
+	context "context"
 	domain "instagram-go/domain"
+	multipart "mime/multipart"
 
 	mock "github.com/stretchr/testify/mock"
-
-	multipart "mime/multipart"
 )
 
 // UserUsecase is an autogenerated mock type for the UserUsecase type
@@ -15,13 +15,36 @@ type UserUsecase struct {
 	mock.Mock
 }
 
-// InsertUser provides a mock function with given fields: _a0
-func (_m *UserUsecase) InsertUser(_a0 *domain.User) error {
-	ret := _m.Called(_a0)
+// FindCommentFilter provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserUsecase) FindCommentFilter(_a0 context.Context, _a1 string, _a2 string) (*domain.CommentFilter, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *domain.CommentFilter
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.CommentFilter); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CommentFilter)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertUser provides a mock function with given fields: _a0, _a1
+func (_m *UserUsecase) InsertUser(_a0 context.Context, _a1 *domain.User) error {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.User) error); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -29,13 +52,13 @@ func (_m *UserUsecase) InsertUser(_a0 *domain.User) error {
 	return r0
 }
 
-// UpdateUser provides a mock function with given fields: _a0, _a1, _a2
-func (_m *UserUsecase) UpdateUser(_a0 *domain.User, _a1 string, _a2 multipart.File) error {
-	ret := _m.Called(_a0, _a1, _a2)
+// UpdateCommentFilter provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *UserUsecase) UpdateCommentFilter(_a0 context.Context, _a1 string, _a2 *domain.CommentFilter, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.User, string, multipart.File) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.CommentFilter, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -43,20 +66,34 @@ func (_m *UserUsecase) UpdateUser(_a0 *domain.User, _a1 string, _a2 multipart.Fi
 	return r0
 }
 
-// VerifyCredential provides a mock function with given fields: _a0, _a1
-func (_m *UserUsecase) VerifyCredential(_a0 string, _a1 string) (string, error) {
-	ret := _m.Called(_a0, _a1)
+// UpdateUser provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *UserUsecase) UpdateUser(_a0 context.Context, _a1 *domain.User, _a2 string, _a3 multipart.File) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User, string, multipart.File) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyCredential provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UserUsecase) VerifyCredential(_a0 context.Context, _a1 string, _a2 string) (string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
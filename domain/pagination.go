@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"net/http"
+	"strconv"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+func Paginate(page int, limit int) (int64, int64, error) {
+	if page < 1 || limit < 1 {
+		return 0, 0, ErrInvalidPagination
+	}
+	if limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+	return int64((page - 1) * limit), int64(limit), nil
+}
+
+func ParsePagination(r *http.Request) (int, int, error) {
+	page, limit := 1, DefaultPageLimit
+	var err error
+	if pageQuery := r.URL.Query().Get("page"); pageQuery != "" {
+		page, err = strconv.Atoi(pageQuery)
+	}
+	if limitQuery := r.URL.Query().Get("limit"); err == nil && limitQuery != "" {
+		limit, err = strconv.Atoi(limitQuery)
+	}
+	return page, limit, err
+}
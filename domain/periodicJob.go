@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type PeriodicJob struct {
+	name     string
+	job      func(context.Context) error
+	interval time.Duration
+}
+
+func NewPeriodicJob(name string, job func(context.Context) error, interval time.Duration) *PeriodicJob {
+	return &PeriodicJob{
+		name:     name,
+		job:      job,
+		interval: interval,
+	}
+}
+
+// Run runs the job right away to catch up on what came due while the server was down.
+func (pj *PeriodicJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(pj.interval)
+	defer ticker.Stop()
+	for {
+		if err := pj.job(ctx); err != nil {
+			log.Printf("%s failed: %v", pj.name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
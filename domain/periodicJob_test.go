@@ -0,0 +1,42 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"instagram-go/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodicJobRunsOnStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	job := func(context.Context) error {
+		calls++
+		cancel()
+		return nil
+	}
+
+	domain.NewPeriodicJob("job", job, time.Hour).Run(ctx)
+
+	assert.Equalf(t, 1, calls, "Should have run the job once but ran it %d times", calls)
+}
+
+func TestPeriodicJobKeepsGoingAfterError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	job := func(context.Context) error {
+		calls++
+		if calls == 1 {
+			return errors.New("job return error")
+		}
+		cancel()
+		return nil
+	}
+
+	domain.NewPeriodicJob("job", job, time.Millisecond).Run(ctx)
+
+	assert.Equalf(t, 2, calls, "Should have run the job twice but ran it %d times", calls)
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"mime/multipart"
 	"net/http"
 	"time"
@@ -9,43 +10,203 @@ import (
 )
 
 type Post struct {
-	Id              string    `json:"id" bson:"_id"`
-	UserId          string    `json:"user_id" bson:"user_id"`
-	VisualMediaUrls []string  `json:"visual_media_urls" bson:"visual_media_urls"`
-	Caption         string    `json:"caption" bson:"caption"`
-	LikeCount       int       `json:"like_count" bson:"like_count"`
-	CreatedDate     time.Time `json:"created_date" bson:"created_date"`
-	UpdatedDate     time.Time `json:"updated_date" bson:"updated_date"`
+	Id               string         `json:"id" bson:"_id"`
+	UserId           string         `json:"user_id" bson:"user_id"`
+	VisualMedias     []VisualMedia  `json:"visual_medias" bson:"visual_medias"`
+	Caption          string         `json:"caption" bson:"caption"`
+	Hashtags         []string       `json:"hashtags" bson:"hashtags"`
+	Mentions         []Mention      `json:"mentions" bson:"mentions"`
+	Location         *Location      `json:"location" bson:"location,omitempty"`
+	Status           string         `json:"status" bson:"status"`
+	PublishAt        *time.Time     `json:"publish_at" bson:"publish_at"`
+	ArchivedDate     *time.Time     `json:"archived_date" bson:"archived_date"`
+	DeletedAt        *time.Time     `json:"deleted_at" bson:"deleted_at"`
+	PinnedDate       *time.Time     `json:"pinned_date" bson:"pinned_date"`
+	CommentsDisabled bool           `json:"comments_disabled" bson:"comments_disabled"`
+	HideLikeCount    bool           `json:"hide_like_count" bson:"hide_like_count"`
+	LikeCount        *int           `json:"like_count,omitempty" bson:"like_count"`
+	ReactionCounts   map[string]int `json:"reaction_counts,omitempty" bson:"reaction_counts"`
+	ViewerHasLiked   *bool          `json:"viewer_has_liked,omitempty" bson:"-"`
+	ViewerReaction   string         `json:"viewer_reaction,omitempty" bson:"-"`
+	ViewerHasSaved   *bool          `json:"viewer_has_saved,omitempty" bson:"-"`
+	CreatedDate      time.Time      `json:"created_date" bson:"created_date"`
+	UpdatedDate      time.Time      `json:"updated_date" bson:"updated_date"`
 }
 
-func NewPost(id string, userId string, visualMediaUrls []string, caption string, likeCount int, createdDate time.Time, updatedDate time.Time) *Post {
+func NewPost(id string, userId string, visualMedias []VisualMedia, caption string, likeCount int, createdDate time.Time, updatedDate time.Time) *Post {
 	return &Post{
-		Id:              id,
-		UserId:          userId,
-		VisualMediaUrls: visualMediaUrls,
-		Caption:         caption,
-		LikeCount:       likeCount,
-		CreatedDate:     createdDate,
-		UpdatedDate:     updatedDate,
+		Id:           id,
+		UserId:       userId,
+		VisualMedias: visualMedias,
+		Caption:      caption,
+		LikeCount:    &likeCount,
+		CreatedDate:  createdDate,
+		UpdatedDate:  updatedDate,
 	}
 }
 
+const (
+	PostStatusDraft     = "draft"
+	PostStatusScheduled = "scheduled"
+	PostStatusPublished = "published"
+)
+
+const PostSchedulerInterval = 15 * time.Second
+
+const MaxPinnedPosts = 3
+
+const (
+	TrashRetention     = 30 * 24 * time.Hour
+	TrashPurgeInterval = time.Hour
+)
+
+// Posts stored before statuses existed have none and are published.
+func (p *Post) IsPublished() bool {
+	return p.Status == "" || p.Status == PostStatusPublished
+}
+
+func (p *Post) IsPublic() bool {
+	return p.IsPublished() && p.ArchivedDate == nil && p.DeletedAt == nil
+}
+
+func VisiblePostFilter(filter bson.M, viewerId string) bson.M {
+	filter["archived_date"] = nil
+	filter["deleted_at"] = nil
+	filter["$or"] = bson.A{
+		bson.M{"status": bson.M{"$nin": bson.A{PostStatusDraft, PostStatusScheduled}}},
+		bson.M{"user_id": viewerId},
+	}
+	return filter
+}
+
+const (
+	VisualMediaTypeImage       = "image"
+	VisualMediaTypeVideo       = "video"
+	VisualMediaVariantOriginal = "original"
+)
+
+type VisualMedia struct {
+	Type     string               `json:"type" bson:"type"`
+	MimeType string               `json:"mime_type" bson:"mime_type"`
+	Width    int                  `json:"width" bson:"width"`
+	Height   int                  `json:"height" bson:"height"`
+	Duration float64              `json:"duration" bson:"duration"`
+	Size     int64                `json:"size" bson:"size"`
+	AltText  string               `json:"alt_text" bson:"alt_text"`
+	Variants []VisualMediaVariant `json:"variants" bson:"variants"`
+	UserTags []UserTag            `json:"user_tags" bson:"user_tags"`
+}
+
+func NewVisualMedia(visualMediaType string, mimeType string, width int, height int, duration float64, size int64, altText string, variants []VisualMediaVariant) *VisualMedia {
+	return &VisualMedia{
+		Type:     visualMediaType,
+		MimeType: mimeType,
+		Width:    width,
+		Height:   height,
+		Duration: duration,
+		Size:     size,
+		AltText:  altText,
+		Variants: variants,
+	}
+}
+
+type VisualMediaVariant struct {
+	Type string `json:"type" bson:"type"`
+	Url  string `json:"url" bson:"url"`
+}
+
+func NewVisualMediaVariant(variantType string, url string) *VisualMediaVariant {
+	return &VisualMediaVariant{
+		Type: variantType,
+		Url:  url,
+	}
+}
+
+const MaxUserTagsPerVisualMedia = 20
+
+// X and Y are relative to the width and height of the media, from 0 to 1.
+type UserTag struct {
+	UserId string  `json:"user_id" bson:"user_id"`
+	X      float64 `json:"x" bson:"x"`
+	Y      float64 `json:"y" bson:"y"`
+}
+
+func NewUserTag(userId string, x float64, y float64) *UserTag {
+	return &UserTag{
+		UserId: userId,
+		X:      x,
+		Y:      y,
+	}
+}
+
+type VisualMediaUserTag struct {
+	VisualMediaIndex int     `json:"visual_media_index"`
+	UserId           string  `json:"user_id"`
+	X                float64 `json:"x"`
+	Y                float64 `json:"y"`
+}
+
 type PostUsecase interface {
-	InsertPost(*Post, string, []*multipart.FileHeader) error
-	FindPosts() (*[]Post, error)
-	UpdatePost(string, string, string) error
-	DeletePost(string, string) error
+	InsertPost(context.Context, *Post, string, []*multipart.FileHeader) error
+	FindPosts(context.Context, string) (*[]Post, error)
+	FindHashtagPosts(context.Context, string, int, int, string) (*[]Post, error)
+	UpdatePost(context.Context, string, string, string) error
+	UpdatePostVisualMedias(context.Context, string, []int, []*multipart.FileHeader, []string, string) error
+	UpdatePostUserTags(context.Context, string, []VisualMediaUserTag, string) error
+	DeletePostUserTag(context.Context, string, string) error
+	FindUserTaggedPosts(context.Context, string, int, int, string) (*[]Post, error)
+	FindLocationPosts(context.Context, string, int, int, string) (*[]Post, error)
+	FindNearbyPosts(context.Context, *GeoPoint, float64, int, int, string) (*[]Post, error)
+	UpdatePostStatus(context.Context, string, string, *time.Time, string) error
+	PublishScheduledPosts(context.Context) error
+	ArchivePost(context.Context, string, string) error
+	RestorePost(context.Context, string, string) error
+	FindArchivedPosts(context.Context, string, int, int, string) (*[]Post, error)
+	RestoreDeletedPost(context.Context, string, string) error
+	FindDeletedPosts(context.Context, string, int, int, string) (*[]Post, error)
+	FindSavedPosts(context.Context, string, int, int, string) (*[]Post, error)
+	FindUserPosts(context.Context, string, int, int, string) (*[]Post, error)
+	PinPost(context.Context, string, string) error
+	UnpinPost(context.Context, string, string) error
+	UpdatePostSettings(context.Context, string, bool, bool, string) error
+	PurgeDeletedPosts(context.Context) error
+	DeletePost(context.Context, string, string) error
 }
 
 type PostRepository interface {
-	InsertPost(*Post) error
-	FindPosts(interface{}) (*[]bson.M, error)
-	FindOnePost(string) (*Post, error)
-	UpdatePost(string, string) error
-	DeletePost(string) error
+	CreateIndexes(context.Context) error
+	InsertPost(context.Context, *Post) error
+	FindPosts(context.Context, interface{}) (*[]bson.M, error)
+	FindPaginatedPosts(context.Context, interface{}, int64, int64) (*[]bson.M, error)
+	FindOnePost(context.Context, string) (*Post, error)
+	UpdatePost(context.Context, string, string, []string, []Mention) error
+	UpdatePostVisualMedias(context.Context, string, []VisualMedia) error
+	UpdatePostStatus(context.Context, string, string, string, *time.Time) (bool, error)
+	UpdatePostArchivedDate(context.Context, string, *time.Time) error
+	UpdatePostDeletedAt(context.Context, string, *time.Time) error
+	UpdatePostPinnedDate(context.Context, string, *time.Time) (bool, error)
+	UpdatePostSettings(context.Context, string, bool, bool) error
+	DeletePost(context.Context, string) error
+	MigrateVisualMediaUrls(context.Context) error
 }
 
 type PostHandler interface {
 	Posts(http.ResponseWriter, *http.Request)
 	Post(http.ResponseWriter, *http.Request)
+	PostVisualMedias(http.ResponseWriter, *http.Request)
+	HashtagPosts(http.ResponseWriter, *http.Request)
+	PostUserTags(http.ResponseWriter, *http.Request)
+	UserTaggedPosts(http.ResponseWriter, *http.Request)
+	LocationPosts(http.ResponseWriter, *http.Request)
+	NearbyPosts(http.ResponseWriter, *http.Request)
+	PostStatus(http.ResponseWriter, *http.Request)
+	PostArchive(http.ResponseWriter, *http.Request)
+	UserArchivedPosts(http.ResponseWriter, *http.Request)
+	PostRestore(http.ResponseWriter, *http.Request)
+	UserDeletedPosts(http.ResponseWriter, *http.Request)
+	SavedPosts(http.ResponseWriter, *http.Request)
+	CollectionPosts(http.ResponseWriter, *http.Request)
+	PostPin(http.ResponseWriter, *http.Request)
+	UserPosts(http.ResponseWriter, *http.Request)
+	PostSettings(http.ResponseWriter, *http.Request)
 }
@@ -41,7 +41,8 @@ func NewDataResponseComments(data *DataComments) *DataResponseComments {
 }
 
 type DataComments struct {
-	Comments []Comment `json:"comments"`
+	Comments   []Comment `json:"comments"`
+	NextCursor string    `json:"next_cursor,omitempty"`
 }
 
 func NewDataComments(comments *[]Comment) *DataComments {
@@ -50,6 +51,129 @@ func NewDataComments(comments *[]Comment) *DataComments {
 	}
 }
 
+type DataResponseCommentRevisions struct {
+	Data DataCommentRevisions `json:"data"`
+}
+
+func NewDataResponseCommentRevisions(data *DataCommentRevisions) *DataResponseCommentRevisions {
+	return &DataResponseCommentRevisions{
+		Data: *data,
+	}
+}
+
+type DataCommentRevisions struct {
+	Revisions []CommentRevision `json:"revisions"`
+}
+
+func NewDataCommentRevisions(revisions *[]CommentRevision) *DataCommentRevisions {
+	return &DataCommentRevisions{
+		Revisions: *revisions,
+	}
+}
+
+type DataResponseCommentFilter struct {
+	Data DataCommentFilter `json:"data"`
+}
+
+func NewDataResponseCommentFilter(data *DataCommentFilter) *DataResponseCommentFilter {
+	return &DataResponseCommentFilter{
+		Data: *data,
+	}
+}
+
+type DataCommentFilter struct {
+	CommentFilter CommentFilter `json:"comment_filter"`
+}
+
+func NewDataCommentFilter(commentFilter *CommentFilter) *DataCommentFilter {
+	return &DataCommentFilter{
+		CommentFilter: *commentFilter,
+	}
+}
+
+type DataResponseLike struct {
+	Data DataLike `json:"data"`
+}
+
+func NewDataResponseLike(data *DataLike) *DataResponseLike {
+	return &DataResponseLike{
+		Data: *data,
+	}
+}
+
+type DataLike struct {
+	Like Like `json:"like"`
+}
+
+func NewDataLike(like *Like) *DataLike {
+	return &DataLike{
+		Like: *like,
+	}
+}
+
+type DataResponseLikers struct {
+	Data DataLikers `json:"data"`
+}
+
+func NewDataResponseLikers(data *DataLikers) *DataResponseLikers {
+	return &DataResponseLikers{
+		Data: *data,
+	}
+}
+
+type DataLikers struct {
+	Likers []Liker `json:"likers"`
+}
+
+func NewDataLikers(likers *[]Liker) *DataLikers {
+	return &DataLikers{
+		Likers: *likers,
+	}
+}
+
+type DataResponseHashtag struct {
+	Data DataHashtag `json:"data"`
+}
+
+func NewDataResponseHashtag(data DataHashtag) *DataResponseHashtag {
+	return &DataResponseHashtag{
+		Data: data,
+	}
+}
+
+type DataHashtag struct {
+	Hashtag Hashtag `json:"hashtag"`
+}
+
+func NewDataHashtag(hashtag Hashtag) *DataHashtag {
+	return &DataHashtag{
+		Hashtag: hashtag,
+	}
+}
+
+type DataResponseMentions struct {
+	Data DataMentions `json:"data"`
+}
+
+func NewDataResponseMentions(data DataMentions) *DataResponseMentions {
+	return &DataResponseMentions{
+		Data: data,
+	}
+}
+
+type DataMentions struct {
+	Mentions []UserMention `json:"mentions"`
+}
+
+func NewDataMentions(mentions []UserMention) *DataMentions {
+	if mentions == nil {
+		mentions = []UserMention{}
+	}
+	return &DataMentions{
+		Mentions: mentions,
+	}
+}
+
 type DataResponseAuthentication struct {
 	Message string             `json:"message"`
 	Data    DataAuthentication `json:"data"`
@@ -71,3 +195,95 @@ func NewDataAuthentication(token string) *DataAuthentication {
 		AccessToken: token,
 	}
 }
+
+type DataResponseCollections struct {
+	Data DataCollections `json:"data"`
+}
+
+func NewDataResponseCollections(data DataCollections) *DataResponseCollections {
+	return &DataResponseCollections{
+		Data: data,
+	}
+}
+
+type DataCollections struct {
+	Collections []Collection `json:"collections"`
+}
+
+func NewDataCollections(collections []Collection) *DataCollections {
+	if collections == nil {
+		collections = []Collection{}
+	}
+	return &DataCollections{
+		Collections: collections,
+	}
+}
+
+type DataResponseStoryTrays struct {
+	Data DataStoryTrays `json:"data"`
+}
+
+func NewDataResponseStoryTrays(data DataStoryTrays) *DataResponseStoryTrays {
+	return &DataResponseStoryTrays{
+		Data: data,
+	}
+}
+
+type DataStoryTrays struct {
+	Trays []StoryTray `json:"trays"`
+}
+
+func NewDataStoryTrays(trays []StoryTray) *DataStoryTrays {
+	if trays == nil {
+		trays = []StoryTray{}
+	}
+	return &DataStoryTrays{
+		Trays: trays,
+	}
+}
+
+type DataResponseStories struct {
+	Data DataStories `json:"data"`
+}
+
+func NewDataResponseStories(data DataStories) *DataResponseStories {
+	return &DataResponseStories{
+		Data: data,
+	}
+}
+
+type DataStories struct {
+	Stories []Story `json:"stories"`
+}
+
+func NewDataStories(stories []Story) *DataStories {
+	if stories == nil {
+		stories = []Story{}
+	}
+	return &DataStories{
+		Stories: stories,
+	}
+}
+
+type DataResponseHighlights struct {
+	Data DataHighlights `json:"data"`
+}
+
+func NewDataResponseHighlights(data DataHighlights) *DataResponseHighlights {
+	return &DataResponseHighlights{
+		Data: data,
+	}
+}
+
+type DataHighlights struct {
+	Highlights []Highlight `json:"highlights"`
+}
+
+func NewDataHighlights(highlights []Highlight) *DataHighlights {
+	if highlights == nil {
+		highlights = []Highlight{}
+	}
+	return &DataHighlights{
+		Highlights: highlights,
+	}
+}
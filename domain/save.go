@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type Save struct {
+	Id           string    `json:"id" bson:"_id"`
+	UserId       string    `json:"user_id" bson:"user_id"`
+	PostId       string    `json:"post_id" bson:"post_id"`
+	CollectionId string    `json:"collection_id" bson:"collection_id"`
+	CreatedDate  time.Time `json:"created_date" bson:"created_date"`
+}
+
+func NewSave(id string, userId string, postId string, collectionId string, createdDate time.Time) *Save {
+	return &Save{
+		Id:           id,
+		UserId:       userId,
+		PostId:       postId,
+		CollectionId: collectionId,
+		CreatedDate:  createdDate,
+	}
+}
+
+type Collection struct {
+	Id          string    `json:"id" bson:"_id"`
+	UserId      string    `json:"user_id" bson:"user_id"`
+	Name        string    `json:"name" bson:"name"`
+	CreatedDate time.Time `json:"created_date" bson:"created_date"`
+	UpdatedDate time.Time `json:"updated_date" bson:"updated_date"`
+}
+
+func NewCollection(id string, userId string, name string, createdDate time.Time, updatedDate time.Time) *Collection {
+	return &Collection{
+		Id:          id,
+		UserId:      userId,
+		Name:        name,
+		CreatedDate: createdDate,
+		UpdatedDate: updatedDate,
+	}
+}
+
+type SaveUsecase interface {
+	InsertSave(context.Context, string, string, string) error
+	UpdateSaveCollection(context.Context, string, string, string) error
+	DeleteSave(context.Context, string, string) error
+	FindCollections(context.Context, string) (*[]Collection, error)
+	InsertCollection(context.Context, *Collection, string) error
+	UpdateCollection(context.Context, *Collection, string) error
+	DeleteCollection(context.Context, string, string) error
+}
+
+type SaveRepository interface {
+	CreateIndexes(context.Context) error
+	InsertSave(context.Context, *Save) error
+	FindSaves(context.Context, interface{}) (*[]bson.M, error)
+	FindSavedPosts(context.Context, interface{}, interface{}, int64, int64) (*[]bson.M, error)
+	FindOneSave(context.Context, string) (*Save, error)
+	UpdateSaveCollection(context.Context, string, string) error
+	UnsetSavesCollection(context.Context, string) error
+	DeleteSave(context.Context, string) error
+	DeleteSaves(context.Context, interface{}) error
+}
+
+type CollectionRepository interface {
+	CreateIndexes(context.Context) error
+	InsertCollection(context.Context, *Collection) error
+	FindCollections(context.Context, interface{}) (*[]bson.M, error)
+	FindOneCollection(context.Context, string) (*Collection, error)
+	UpdateCollection(context.Context, string, string, time.Time) error
+	DeleteCollection(context.Context, string) error
+}
+
+type SaveHandler interface {
+	PostSaves(http.ResponseWriter, *http.Request)
+	Collections(http.ResponseWriter, *http.Request)
+	Collection(http.ResponseWriter, *http.Request)
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	StoryLifetime      = 24 * time.Hour
+	StoryPurgeInterval = 5 * time.Minute
+	// The TTL index drops a story StoryExpiryGrace after it expires, leaving the purge time to remove its file.
+	StoryExpiryGrace = time.Hour
+)
+
+type Story struct {
+	Id          string        `json:"id" bson:"_id"`
+	UserId      string        `json:"user_id" bson:"user_id"`
+	VisualMedia VisualMedia   `json:"visual_media" bson:"visual_media"`
+	Viewers     []StoryViewer `json:"viewers,omitempty" bson:"viewers"`
+	CreatedDate time.Time     `json:"created_date" bson:"created_date"`
+	ExpiresAt   time.Time     `json:"expires_at" bson:"expires_at"`
+}
+
+func NewStory(id string, userId string, visualMedia VisualMedia, createdDate time.Time, expiresAt time.Time) *Story {
+	return &Story{
+		Id:          id,
+		UserId:      userId,
+		VisualMedia: visualMedia,
+		CreatedDate: createdDate,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+type StoryViewer struct {
+	UserId     string    `json:"user_id" bson:"user_id"`
+	ViewedDate time.Time `json:"viewed_date" bson:"viewed_date"`
+}
+
+func NewStoryViewer(userId string, viewedDate time.Time) *StoryViewer {
+	return &StoryViewer{
+		UserId:     userId,
+		ViewedDate: viewedDate,
+	}
+}
+
+type StoryTray struct {
+	UserId  string  `json:"user_id"`
+	Stories []Story `json:"stories"`
+}
+
+func NewStoryTray(userId string, stories []Story) *StoryTray {
+	return &StoryTray{
+		UserId:  userId,
+		Stories: stories,
+	}
+}
+
+type StoryUsecase interface {
+	InsertStory(context.Context, *multipart.FileHeader, string) error
+	FindStoryTrays(context.Context, string) (*[]StoryTray, error)
+	FindUserStories(context.Context, string, string) (*[]Story, error)
+	ViewStory(context.Context, string, string) error
+	PurgeExpiredStories(context.Context) error
+}
+
+type StoryRepository interface {
+	CreateIndexes(context.Context) error
+	InsertStory(context.Context, *Story) error
+	FindStories(context.Context, interface{}) (*[]bson.M, error)
+	FindOneStory(context.Context, string) (*Story, error)
+	InsertStoryViewer(context.Context, string, *StoryViewer) error
+	DeleteStory(context.Context, string) error
+}
+
+type StoryHandler interface {
+	Stories(http.ResponseWriter, *http.Request)
+	UserStories(http.ResponseWriter, *http.Request)
+	StoryViews(http.ResponseWriter, *http.Request)
+}
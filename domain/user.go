@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"mime/multipart"
 	"net/http"
 
@@ -14,6 +15,7 @@ type User struct {
 	Password        string           `json:"password" bson:"password"`
 	Email           string           `json:"email" bson:"email"`
 	ProfilePictures []ProfilePicture `json:"profile_pictures" bson:"profile_pictures"`
+	CommentFilter   *CommentFilter   `json:"-" bson:"comment_filter"`
 }
 
 func NewUser(id string, username string, fullname string, password string, email string, profilePictures []ProfilePicture) *User {
@@ -42,20 +44,27 @@ func NewProfilePicture(profilePictureType string, size string, url string) *Prof
 }
 
 type UserUsecase interface {
-	InsertUser(*User) error
-	UpdateUser(*User, string, multipart.File) error
-	VerifyCredential(string, string) (string, error)
+	InsertUser(context.Context, *User) error
+	UpdateUser(context.Context, *User, string, multipart.File) error
+	VerifyCredential(context.Context, string, string) (string, error)
+	FindCommentFilter(context.Context, string, string) (*CommentFilter, error)
+	UpdateCommentFilter(context.Context, string, *CommentFilter, string) error
 }
 
 type UserRepository interface {
-	InsertUser(*User) error
-	UpdateUser(*User) error
-	FindUser(interface{}) (*[]bson.M, error)
-	FindOneUser(filter interface{}) (*User, error)
+	CreateIndexes(context.Context) error
+	InsertUser(context.Context, *User) error
+	UpdateUser(context.Context, *User) error
+	FindUser(context.Context, interface{}) (*[]bson.M, error)
+	FindOneUser(ctx context.Context, filter interface{}) (*User, error)
+	UpdateUserCommentFilter(context.Context, string, *CommentFilter) error
+	IncrementUserPinnedCount(context.Context, string, int) (bool, error)
+	DecrementUserPinnedCount(context.Context, string) error
 }
 
 type UserHandler interface {
 	PostUser(http.ResponseWriter, *http.Request)
 	PutUser(http.ResponseWriter, *http.Request)
 	AuthenticateUser(http.ResponseWriter, *http.Request)
+	UserCommentFilter(http.ResponseWriter, *http.Request)
 }
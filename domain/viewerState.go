@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func FindViewerReactions(ctx context.Context, likeRepository LikeRepository, viewerId string, resourceType string, resourceIds []string) (map[string]string, error) {
+	reactions := make(map[string]string)
+	if viewerId == "" || len(resourceIds) == 0 {
+		return reactions, nil
+	}
+	filter := bson.M{"user_id": viewerId, "resource_type": resourceType, "resource_id": bson.M{"$in": resourceIds}}
+	queryResult, err := likeRepository.FindLikes(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range *queryResult {
+		reactions[fmt.Sprintf("%v", v["resource_id"])] = LikeReaction(v)
+	}
+	return reactions, nil
+}
+
+func FindViewerSaves(ctx context.Context, saveRepository SaveRepository, viewerId string, postIds []string) (map[string]bool, error) {
+	saves := make(map[string]bool)
+	if viewerId == "" || len(postIds) == 0 {
+		return saves, nil
+	}
+	filter := bson.M{"user_id": viewerId, "post_id": bson.M{"$in": postIds}}
+	queryResult, err := saveRepository.FindSaves(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range *queryResult {
+		saves[fmt.Sprintf("%v", v["post_id"])] = true
+	}
+	return saves, nil
+}
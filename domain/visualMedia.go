@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+func IsSupportedVisualMedia(visualMedia *multipart.FileHeader) bool {
+	fileNameParts := strings.Split(visualMedia.Filename, ".")
+	extension := fileNameParts[len(fileNameParts)-1]
+	return extension == "gif" || extension == "jpg" || extension == "png" ||
+		extension == "tiff" || extension == "webm" || extension == "mp4"
+}
+
+func SaveVisualMedia(fileOsHelper IFileOsHelper, fileHeader *multipart.FileHeader, visualMediaUrl string, altText string) (*VisualMedia, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sniffedBytes := make([]byte, 512)
+	n, err := file.Read(sniffedBytes)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	fileNameParts := strings.Split(fileHeader.Filename, ".")
+	mimeType := detectVisualMediaMimeType(sniffedBytes[:n], fileNameParts[len(fileNameParts)-1])
+
+	visualMedia := NewVisualMedia(VisualMediaTypeImage, mimeType, 0, 0, 0, fileHeader.Size, altText, nil)
+	if strings.HasPrefix(mimeType, "video/") {
+		visualMedia.Type = VisualMediaTypeVideo
+		if videoConfig, err := fileOsHelper.DecodeVideoConfig(file); err == nil {
+			visualMedia.Width = videoConfig.Width
+			visualMedia.Height = videoConfig.Height
+			visualMedia.Duration = videoConfig.Duration
+		}
+	} else if imageConfig, _, err := fileOsHelper.DecodeImageConfig(file); err == nil {
+		visualMedia.Width = imageConfig.Width
+		visualMedia.Height = imageConfig.Height
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	out, err := fileOsHelper.Create(visualMediaUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer out.Close()
+	_, err = fileOsHelper.Copy(out, file)
+	if err != nil {
+		return nil, err
+	}
+	visualMedia.Variants = []VisualMediaVariant{*NewVisualMediaVariant(VisualMediaVariantOriginal, visualMediaUrl)}
+	return visualMedia, nil
+}
+
+func detectVisualMediaMimeType(sniffedBytes []byte, extension string) string {
+	mimeType := http.DetectContentType(sniffedBytes)
+	if mimeType != "application/octet-stream" {
+		return mimeType
+	}
+	switch strings.ToLower(extension) {
+	case "tiff":
+		return "image/tiff"
+	case "mp4":
+		return "video/mp4"
+	case "webm":
+		return "video/webm"
+	}
+	return mimeType
+}
+
+// RemoveVisualMediaFiles is best effort: a file that cannot be removed is left orphaned.
+func RemoveVisualMediaFiles(fileOsHelper IFileOsHelper, visualMedias []VisualMedia) {
+	for _, visualMedia := range visualMedias {
+		for _, variant := range visualMedia.Variants {
+			_ = fileOsHelper.Remove(variant.Url)
+		}
+	}
+}
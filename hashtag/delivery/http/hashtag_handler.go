@@ -0,0 +1,64 @@
+package http
+
+import (
+	"encoding/json"
+	"instagram-go/domain"
+	"net/http"
+	"strings"
+)
+
+type HashtagHandler struct {
+	hashtagUsecase domain.HashtagUsecase
+}
+
+func NewHashtagHandler(hashtagUsecase domain.HashtagUsecase) domain.HashtagHandler {
+	return &HashtagHandler{
+		hashtagUsecase: hashtagUsecase,
+	}
+}
+
+func (hh *HashtagHandler) Hashtag(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		hh.getHashtag(w, r)
+		return
+	}
+}
+
+func (hh *HashtagHandler) getHashtag(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	tag := urlParts[2]
+	hashtag, err := hh.hashtagUsecase.FindHashtag(r.Context(), tag)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(hashtagGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataHashtag := domain.NewDataHashtag(*hashtag)
+	response := domain.NewDataResponseHashtag(*dataHashtag)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func hashtagGetStatusCode(err error) int {
+	switch err {
+	case domain.ErrInternalServerError:
+		return http.StatusInternalServerError
+	case domain.ErrHashtagNotFound:
+		return http.StatusNotFound
+	}
+	return http.StatusOK
+}
@@ -0,0 +1,57 @@
+package http_test
+
+import (
+	"encoding/json"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	hashtagHttp "instagram-go/hashtag/delivery/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHashtagHandlerSuite(t *testing.T) {
+	suite.Run(t, new(HashtagHandlerSuite))
+}
+
+type HashtagHandlerSuite struct {
+	suite.Suite
+	hashtagUsecase *mocks.HashtagUsecase
+}
+
+func (hh *HashtagHandlerSuite) SetupTest() {
+	hh.hashtagUsecase = new(mocks.HashtagUsecase)
+}
+
+func (hh *HashtagHandlerSuite) TestGetHashtagNotFound() {
+	hh.hashtagUsecase.On("FindHashtag", mock.Anything, "golang").Return(nil, domain.ErrHashtagNotFound)
+	hashtagHandler := hashtagHttp.NewHashtagHandler(hh.hashtagUsecase)
+	req, _ := http.NewRequest("GET", "/hashtags/golang", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(hashtagHandler.Hashtag)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(hh.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrHashtagNotFound.Error() + `"}`
+	assert.Equalf(hh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (hh *HashtagHandlerSuite) TestGetHashtagSuccessful() {
+	hashtag := domain.NewHashtag("golang", 2, 1, time.Now(), time.Now())
+	hh.hashtagUsecase.On("FindHashtag", mock.Anything, "golang").Return(hashtag, nil)
+	hashtagHandler := hashtagHttp.NewHashtagHandler(hh.hashtagUsecase)
+	req, _ := http.NewRequest("GET", "/hashtags/golang", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(hashtagHandler.Hashtag)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(hh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	responseBytes, _ := json.Marshal(domain.NewDataResponseHashtag(*domain.NewDataHashtag(*hashtag)))
+	expectedBody := string(responseBytes)
+	assert.Equalf(hh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
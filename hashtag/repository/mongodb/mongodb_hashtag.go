@@ -0,0 +1,61 @@
+package mongodb
+
+import (
+	"context"
+	"instagram-go/domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodbHashtagRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongodbHashtagRepository(collection *mongo.Collection) domain.HashtagRepository {
+	return &mongodbHashtagRepository{
+		collection: collection,
+	}
+}
+
+func (mhr *mongodbHashtagRepository) FindOneHashtag(ctx context.Context, tag string) (*domain.Hashtag, error) {
+	var hashtag domain.Hashtag
+	filter := bson.M{"_id": tag}
+	err := mhr.collection.FindOne(ctx, filter).Decode(&hashtag)
+	if err == mongo.ErrNoDocuments {
+		return nil, domain.ErrHashtagNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &hashtag, nil
+}
+
+func (mhr *mongodbHashtagRepository) UpdateHashtagPostCounts(ctx context.Context, tags []string, delta int) error {
+	return mhr.incrementCounts(ctx, tags, "post_count", delta)
+}
+
+func (mhr *mongodbHashtagRepository) UpdateHashtagCommentCounts(ctx context.Context, tags []string, delta int) error {
+	return mhr.incrementCounts(ctx, tags, "comment_count", delta)
+}
+
+func (mhr *mongodbHashtagRepository) incrementCounts(ctx context.Context, tags []string, counter string, delta int) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	now := time.Now()
+	var models []mongo.WriteModel
+	for _, tag := range tags {
+		update := bson.M{
+			"$inc":         bson.M{counter: delta},
+			"$set":         bson.M{"updated_date": now},
+			"$setOnInsert": bson.M{"created_date": now},
+		}
+		model := mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": tag}).SetUpdate(update).SetUpsert(true)
+		models = append(models, model)
+	}
+	_, err := mhr.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
@@ -0,0 +1,61 @@
+package mongodb_test
+
+import (
+	"context"
+	"instagram-go/domain"
+	"instagram-go/hashtag/repository/mongodb"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestHashtagRepoSuite(t *testing.T) {
+	suite.Run(t, new(HashtagRepoSuite))
+}
+
+type HashtagRepoSuite struct {
+	suite.Suite
+	collection *mongo.Collection
+}
+
+func (hr *HashtagRepoSuite) SetupSuite() {
+	client, _ := mongo.Connect(context.TODO(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	hr.collection = client.Database("instagram_test").Collection("hashtags")
+}
+
+func (hr *HashtagRepoSuite) AfterTest(suiteName, testName string) {
+	hr.collection.Drop(context.TODO())
+}
+
+func (hr *HashtagRepoSuite) TestFindOneHashtagNotFound() {
+	hashtagRepo := mongodb.NewMongodbHashtagRepository(hr.collection)
+
+	_, err := hashtagRepo.FindOneHashtag(context.TODO(), "golang")
+
+	expectedError := domain.ErrHashtagNotFound.Error()
+	assert.EqualErrorf(hr.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (hr *HashtagRepoSuite) TestUpdateHashtagCountsSuccessful() {
+	hashtagRepo := mongodb.NewMongodbHashtagRepository(hr.collection)
+
+	err := hashtagRepo.UpdateHashtagPostCounts(context.TODO(), []string{"golang", "mongodb"}, 1)
+	assert.NoErrorf(hr.T(), err, "Should have not return error but got %s", err)
+	err = hashtagRepo.UpdateHashtagPostCounts(context.TODO(), []string{"golang"}, 1)
+	assert.NoErrorf(hr.T(), err, "Should have not return error but got %s", err)
+	err = hashtagRepo.UpdateHashtagPostCounts(context.TODO(), []string{"mongodb"}, -1)
+	assert.NoErrorf(hr.T(), err, "Should have not return error but got %s", err)
+	err = hashtagRepo.UpdateHashtagCommentCounts(context.TODO(), []string{"golang"}, 1)
+	assert.NoErrorf(hr.T(), err, "Should have not return error but got %s", err)
+
+	hashtag, err := hashtagRepo.FindOneHashtag(context.TODO(), "golang")
+	assert.NoErrorf(hr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(hr.T(), 2, hashtag.PostCount, "Should have return post count %v but got %v", 2, hashtag.PostCount)
+	assert.Equalf(hr.T(), 1, hashtag.CommentCount, "Should have return comment count %v but got %v", 1, hashtag.CommentCount)
+	hashtag, err = hashtagRepo.FindOneHashtag(context.TODO(), "mongodb")
+	assert.NoErrorf(hr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(hr.T(), 0, hashtag.PostCount, "Should have return post count %v but got %v", 0, hashtag.PostCount)
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"instagram-go/domain"
+)
+
+type hashtagUsecase struct {
+	hashtagRepository domain.HashtagRepository
+}
+
+func NewHashtagUsecase(hashtagRepository domain.HashtagRepository) domain.HashtagUsecase {
+	return &hashtagUsecase{
+		hashtagRepository: hashtagRepository,
+	}
+}
+
+func (hu *hashtagUsecase) FindHashtag(ctx context.Context, tag string) (*domain.Hashtag, error) {
+	hashtag, err := hu.hashtagRepository.FindOneHashtag(ctx, domain.NormalizeHashtag(tag))
+	if err == domain.ErrHashtagNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return hashtag, nil
+}
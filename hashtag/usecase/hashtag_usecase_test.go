@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	"instagram-go/hashtag/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHashtagUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(HashtagUsecaseSuite))
+}
+
+type HashtagUsecaseSuite struct {
+	suite.Suite
+	hashtagRepository *mocks.HashtagRepository
+}
+
+func (hu *HashtagUsecaseSuite) SetupTest() {
+	hu.hashtagRepository = new(mocks.HashtagRepository)
+}
+
+func (hu *HashtagUsecaseSuite) TestFindHashtagNotFound() {
+	hu.hashtagRepository.On("FindOneHashtag", mock.Anything, "golang").Return(nil, domain.ErrHashtagNotFound)
+
+	hashtagUsecase := usecase.NewHashtagUsecase(hu.hashtagRepository)
+	_, err := hashtagUsecase.FindHashtag(context.TODO(), "golang")
+
+	expectedError := domain.ErrHashtagNotFound.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (hu *HashtagUsecaseSuite) TestFindHashtagFindOneHashtagError() {
+	hu.hashtagRepository.On("FindOneHashtag", mock.Anything, "golang").Return(nil, errors.New(""))
+
+	hashtagUsecase := usecase.NewHashtagUsecase(hu.hashtagRepository)
+	_, err := hashtagUsecase.FindHashtag(context.TODO(), "golang")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (hu *HashtagUsecaseSuite) TestFindHashtagSuccessful() {
+	expectedHashtag := domain.NewHashtag("golang", 2, 1, time.Now(), time.Now())
+	hu.hashtagRepository.On("FindOneHashtag", mock.Anything, "golang").Return(expectedHashtag, nil)
+
+	hashtagUsecase := usecase.NewHashtagUsecase(hu.hashtagRepository)
+	hashtag, err := hashtagUsecase.FindHashtag(context.TODO(), "GoLang")
+
+	assert.NoErrorf(hu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(hu.T(), expectedHashtag, hashtag, "Should have return %v but got %v", expectedHashtag, hashtag)
+}
@@ -0,0 +1,235 @@
+package http
+
+import (
+	"encoding/json"
+	"instagram-go/domain"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type HighlightHandler struct {
+	highlightUsecase domain.HighlightUsecase
+}
+
+func NewHighlightHandler(highlightUsecase domain.HighlightUsecase) domain.HighlightHandler {
+	return &HighlightHandler{
+		highlightUsecase: highlightUsecase,
+	}
+}
+
+func (hh *HighlightHandler) Highlights(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		hh.postHighlight(w, r)
+		return
+	}
+}
+
+func (hh *HighlightHandler) Highlight(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		hh.putHighlight(w, r)
+		return
+	case "DELETE":
+		hh.deleteHighlight(w, r)
+		return
+	}
+}
+
+func (hh *HighlightHandler) UserHighlights(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		hh.getUserHighlights(w, r)
+		return
+	}
+}
+
+func (hh *HighlightHandler) postHighlight(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	highlight, cover, err := parseHighlightForm(r)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(highlightGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	err = hh.highlightUsecase.InsertHighlight(r.Context(), highlight, tokenString, cover)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(highlightGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Highlight successfully Created")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	w.Write(responseBytes)
+}
+
+func (hh *HighlightHandler) getUserHighlights(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+
+	highlights, err := hh.highlightUsecase.FindUserHighlights(r.Context(), userId)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(highlightGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataHighlights := domain.NewDataHighlights(*highlights)
+	response := domain.NewDataResponseHighlights(*dataHighlights)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (hh *HighlightHandler) putHighlight(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	highlightId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+	highlight, cover, err := parseHighlightForm(r)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(highlightGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	highlight.Id = highlightId
+
+	err = hh.highlightUsecase.UpdateHighlight(r.Context(), highlight, tokenString, cover)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(highlightGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Highlight successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (hh *HighlightHandler) deleteHighlight(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	highlightId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := hh.highlightUsecase.DeleteHighlight(r.Context(), highlightId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(highlightGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Highlight successfully Deleted")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+// Items are the repeated post_ids and visual_media_indexes fields taken pairwise.
+func parseHighlightForm(r *http.Request) (*domain.Highlight, *multipart.FileHeader, error) {
+	r.ParseMultipartForm(10 << 20)
+	title := strings.TrimSpace(r.FormValue("title"))
+	if title == "" {
+		return nil, nil, domain.ErrMissingHighlightTitleInput
+	}
+
+	postIds := r.Form["post_ids"]
+	visualMediaIndexes := r.Form["visual_media_indexes"]
+	if len(postIds) != len(visualMediaIndexes) {
+		return nil, nil, domain.ErrInvalidHighlightItems
+	}
+	var items []domain.HighlightItem
+	for k, postId := range postIds {
+		visualMediaIndex, err := strconv.Atoi(visualMediaIndexes[k])
+		if err != nil {
+			return nil, nil, domain.ErrInvalidHighlightItems
+		}
+		items = append(items, *domain.NewHighlightItem(postId, visualMediaIndex, domain.VisualMedia{}))
+	}
+
+	var cover *multipart.FileHeader
+	if r.MultipartForm != nil && len(r.MultipartForm.File["cover"]) > 0 {
+		cover = r.MultipartForm.File["cover"][0]
+		if !domain.IsSupportedVisualMedia(cover) {
+			return nil, nil, domain.ErrUnsupportedVisualMediaType
+		}
+	}
+	return domain.NewHighlight("", "", title, nil, items, time.Time{}, time.Time{}), cover, nil
+}
+
+func highlightGetStatusCode(err error) int {
+	switch err {
+	case domain.ErrMissingHighlightTitleInput, domain.ErrMissingHighlightItemsInput, domain.ErrInvalidHighlightItems, domain.ErrUnpublishedHighlightPost, domain.ErrUnsupportedVisualMediaType:
+		return http.StatusBadRequest
+	case domain.ErrInternalServerError:
+		return http.StatusInternalServerError
+	case domain.ErrUserNotFound, domain.ErrPostNotFound, domain.ErrHighlightNotFound:
+		return http.StatusNotFound
+	case domain.ErrUnauthorizedHighlightItem, domain.ErrUnauthorizedHighlightUpdate, domain.ErrUnauthorizedHighlightDelete:
+		return http.StatusUnauthorized
+	}
+	return http.StatusOK
+}
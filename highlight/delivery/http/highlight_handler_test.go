@@ -0,0 +1,130 @@
+package http_test
+
+import (
+	"bytes"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	highlightHttp "instagram-go/highlight/delivery/http"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHighlightHandlerSuite(t *testing.T) {
+	suite.Run(t, new(HighlightHandlerSuite))
+}
+
+type HighlightHandlerSuite struct {
+	suite.Suite
+	highlightUsecase *mocks.HighlightUsecase
+}
+
+func (hh *HighlightHandlerSuite) SetupTest() {
+	hh.highlightUsecase = new(mocks.HighlightUsecase)
+}
+
+func newHighlightForm(fields map[string][]string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for key, values := range fields {
+		for _, value := range values {
+			writer.WriteField(key, value)
+		}
+	}
+	writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+func (hh *HighlightHandlerSuite) TestPostHighlightMissingTitle() {
+	body, contentType := newHighlightForm(map[string][]string{"post_ids": {"postid1"}, "visual_media_indexes": {"0"}})
+	highlightHandler := highlightHttp.NewHighlightHandler(hh.highlightUsecase)
+	req, _ := http.NewRequest("POST", "/highlights", body)
+	req.Header.Set("Content-Type", contentType)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(highlightHandler.Highlights)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(hh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrMissingHighlightTitleInput.Error() + `"}`
+	assert.Equalf(hh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (hh *HighlightHandlerSuite) TestPostHighlightUnpairedItems() {
+	body, contentType := newHighlightForm(map[string][]string{"title": {"trips"}, "post_ids": {"postid1", "postid2"}, "visual_media_indexes": {"0"}})
+	highlightHandler := highlightHttp.NewHighlightHandler(hh.highlightUsecase)
+	req, _ := http.NewRequest("POST", "/highlights", body)
+	req.Header.Set("Content-Type", contentType)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(highlightHandler.Highlights)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(hh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidHighlightItems.Error() + `"}`
+	assert.Equalf(hh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (hh *HighlightHandlerSuite) TestPostHighlightSuccessful() {
+	body, contentType := newHighlightForm(map[string][]string{"title": {"trips"}, "post_ids": {"postid1", "postid2"}, "visual_media_indexes": {"1", "0"}})
+	hh.highlightUsecase.On("InsertHighlight", mock.Anything, mock.AnythingOfType("*domain.Highlight"), mock.AnythingOfType("string"), (*multipart.FileHeader)(nil)).Return(nil)
+	highlightHandler := highlightHttp.NewHighlightHandler(hh.highlightUsecase)
+	req, _ := http.NewRequest("POST", "/highlights", body)
+	req.Header.Set("Content-Type", contentType)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(highlightHandler.Highlights)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(hh.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
+	expectedBody := `{"message":"Highlight successfully Created"}`
+	assert.Equalf(hh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	hh.highlightUsecase.AssertCalled(hh.T(), "InsertHighlight", mock.Anything, mock.MatchedBy(func(highlight *domain.Highlight) bool {
+		return highlight.Title == "trips" && len(highlight.Items) == 2 &&
+			highlight.Items[0].PostId == "postid1" && highlight.Items[0].VisualMediaIndex == 1 &&
+			highlight.Items[1].PostId == "postid2" && highlight.Items[1].VisualMediaIndex == 0
+	}), mock.Anything, mock.Anything)
+}
+
+func (hh *HighlightHandlerSuite) TestPutHighlightUnauthorizedHighlightUpdate() {
+	body, contentType := newHighlightForm(map[string][]string{"title": {"trips"}, "post_ids": {"postid1"}, "visual_media_indexes": {"0"}})
+	hh.highlightUsecase.On("UpdateHighlight", mock.Anything, mock.AnythingOfType("*domain.Highlight"), mock.AnythingOfType("string"), mock.Anything).Return(domain.ErrUnauthorizedHighlightUpdate)
+	highlightHandler := highlightHttp.NewHighlightHandler(hh.highlightUsecase)
+	req, _ := http.NewRequest("PUT", "/highlights/highlightid1", body)
+	req.Header.Set("Content-Type", contentType)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(highlightHandler.Highlight)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(hh.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnauthorizedHighlightUpdate.Error() + `"}`
+	assert.Equalf(hh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (hh *HighlightHandlerSuite) TestDeleteHighlightSuccessful() {
+	hh.highlightUsecase.On("DeleteHighlight", mock.Anything, "highlightid1", mock.AnythingOfType("string")).Return(nil)
+	highlightHandler := highlightHttp.NewHighlightHandler(hh.highlightUsecase)
+	req, _ := http.NewRequest("DELETE", "/highlights/highlightid1", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(highlightHandler.Highlight)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(hh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Highlight successfully Deleted"}`
+	assert.Equalf(hh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (hh *HighlightHandlerSuite) TestGetUserHighlightsSuccessful() {
+	hh.highlightUsecase.On("FindUserHighlights", mock.Anything, "userid1").Return(&[]domain.Highlight{}, nil)
+	highlightHandler := highlightHttp.NewHighlightHandler(hh.highlightUsecase)
+	req, _ := http.NewRequest("GET", "/users/userid1/highlights", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(highlightHandler.UserHighlights)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(hh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"highlights":[]}}`
+	assert.Equalf(hh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"context"
+	"instagram-go/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodbHighlightRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongodbHighlightRepository(collection *mongo.Collection) domain.HighlightRepository {
+	return &mongodbHighlightRepository{
+		collection: collection,
+	}
+}
+
+func (mhr *mongodbHighlightRepository) CreateIndexes(ctx context.Context) error {
+	userIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "created_date", Value: 1},
+		},
+	}
+	variantIndex := mongo.IndexModel{
+		Keys: bson.D{primitive.E{Key: "items.visual_media.variants.url", Value: 1}},
+	}
+	_, err := mhr.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{userIndex, variantIndex})
+	return err
+}
+
+func (mhr *mongodbHighlightRepository) InsertHighlight(ctx context.Context, highlight *domain.Highlight) error {
+	newHighlight := bson.D{
+		primitive.E{Key: "_id", Value: highlight.Id},
+		primitive.E{Key: "user_id", Value: highlight.UserId},
+		primitive.E{Key: "title", Value: highlight.Title},
+		primitive.E{Key: "cover", Value: highlight.Cover},
+		primitive.E{Key: "items", Value: highlight.Items},
+		primitive.E{Key: "created_date", Value: highlight.CreatedDate},
+		primitive.E{Key: "updated_date", Value: highlight.UpdatedDate},
+	}
+	_, err := mhr.collection.InsertOne(ctx, newHighlight)
+	return err
+}
+
+func (mhr *mongodbHighlightRepository) FindHighlights(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "created_date", Value: 1}})
+	cursor, err := mhr.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (mhr *mongodbHighlightRepository) FindOneHighlight(ctx context.Context, highlightId string) (*domain.Highlight, error) {
+	var highlight domain.Highlight
+	filter := bson.M{"_id": highlightId}
+	err := mhr.collection.FindOne(ctx, filter).Decode(&highlight)
+	return &highlight, err
+}
+
+func (mhr *mongodbHighlightRepository) UpdateHighlight(ctx context.Context, highlight *domain.Highlight) error {
+	filter := bson.M{"_id": highlight.Id}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "title",
+			Value: highlight.Title}, primitive.E{
+			Key:   "cover",
+			Value: highlight.Cover}, primitive.E{
+			Key:   "items",
+			Value: highlight.Items}, primitive.E{
+			Key:   "updated_date",
+			Value: highlight.UpdatedDate},
+		},
+	}}
+	_, err := mhr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (mhr *mongodbHighlightRepository) DeleteHighlight(ctx context.Context, highlightId string) error {
+	filter := bson.M{"_id": highlightId}
+	_, err := mhr.collection.DeleteOne(ctx, filter)
+	return err
+}
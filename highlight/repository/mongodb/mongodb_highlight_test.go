@@ -0,0 +1,64 @@
+package mongodb_test
+
+import (
+	"context"
+	"instagram-go/domain"
+	"instagram-go/highlight/repository/mongodb"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestHighlightRepoSuite(t *testing.T) {
+	suite.Run(t, new(HighlightRepoSuite))
+}
+
+type HighlightRepoSuite struct {
+	suite.Suite
+	collection *mongo.Collection
+}
+
+func (hr *HighlightRepoSuite) SetupSuite() {
+	client, _ := mongo.Connect(context.TODO(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	hr.collection = client.Database("instagram_test").Collection("highlights")
+}
+
+func (hr *HighlightRepoSuite) AfterTest(suiteName, testName string) {
+	hr.collection.Drop(context.TODO())
+}
+
+func newHighlightItem(postId string, url string) domain.HighlightItem {
+	visualMedia := domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", []domain.VisualMediaVariant{*domain.NewVisualMediaVariant(domain.VisualMediaVariantOriginal, url)})
+	return *domain.NewHighlightItem(postId, 0, *visualMedia)
+}
+
+func (hr *HighlightRepoSuite) TestFindHighlightsByItemUrl() {
+	highlightRepo := mongodb.NewMongodbHighlightRepository(hr.collection)
+	_ = highlightRepo.CreateIndexes(context.TODO())
+	_ = highlightRepo.InsertHighlight(context.TODO(), domain.NewHighlight("highlightid1", "userid1", "trips", nil, []domain.HighlightItem{newHighlightItem("postid1", "./visual_medias/postid10.jpg")}, time.Now(), time.Now()))
+	_ = highlightRepo.InsertHighlight(context.TODO(), domain.NewHighlight("highlightid2", "userid1", "food", nil, []domain.HighlightItem{newHighlightItem("postid2", "./visual_medias/postid20.jpg")}, time.Now(), time.Now()))
+
+	highlights, err := highlightRepo.FindHighlights(context.TODO(), bson.M{"items.visual_media.variants.url": bson.M{"$in": bson.A{"./visual_medias/postid20.jpg"}}})
+
+	assert.NoErrorf(hr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(hr.T(), 1, len(*highlights), "Should have return %d highlights but got %d", 1, len(*highlights))
+	assert.Equalf(hr.T(), "highlightid2", (*highlights)[0]["_id"], "Should have return %s but got %s", "highlightid2", (*highlights)[0]["_id"])
+}
+
+func (hr *HighlightRepoSuite) TestUpdateHighlightSuccessful() {
+	highlightRepo := mongodb.NewMongodbHighlightRepository(hr.collection)
+	_ = highlightRepo.InsertHighlight(context.TODO(), domain.NewHighlight("highlightid1", "userid1", "trips", nil, []domain.HighlightItem{newHighlightItem("postid1", "./visual_medias/postid10.jpg")}, time.Now(), time.Now()))
+
+	items := []domain.HighlightItem{newHighlightItem("postid2", "./visual_medias/postid20.jpg"), newHighlightItem("postid1", "./visual_medias/postid10.jpg")}
+	err := highlightRepo.UpdateHighlight(context.TODO(), domain.NewHighlight("highlightid1", "userid1", "summer trips", nil, items, time.Now(), time.Now()))
+	highlight, _ := highlightRepo.FindOneHighlight(context.TODO(), "highlightid1")
+
+	assert.NoErrorf(hr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(hr.T(), "summer trips", highlight.Title, "Should have return %s but got %s", "summer trips", highlight.Title)
+	assert.Equalf(hr.T(), "postid2", highlight.Items[0].PostId, "Should have return %s first but got %s", "postid2", highlight.Items[0].PostId)
+}
@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"context"
+	"instagram-go/domain"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type highlightUsecase struct {
+	highlightRepository domain.HighlightRepository
+	postRepository      domain.PostRepository
+	userRepository      domain.UserRepository
+	headerHelper        domain.IHeaderHelper
+	fileOsHelper        domain.IFileOsHelper
+}
+
+func NewHighlightUsecase(highlightRepository domain.HighlightRepository, postRepository domain.PostRepository, userRepository domain.UserRepository, headerHelper domain.IHeaderHelper, fileOsHelper domain.IFileOsHelper) domain.HighlightUsecase {
+	return &highlightUsecase{
+		highlightRepository: highlightRepository,
+		postRepository:      postRepository,
+		userRepository:      userRepository,
+		headerHelper:        headerHelper,
+		fileOsHelper:        fileOsHelper,
+	}
+}
+
+func (hu *highlightUsecase) InsertHighlight(ctx context.Context, highlight *domain.Highlight, tokenString string, cover *multipart.FileHeader) error {
+	userId, err := hu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	items, err := hu.resolveHighlightItems(ctx, highlight.Items, userId)
+	if err != nil {
+		return err
+	}
+	highlight.Id = "highlight-" + uuid.NewString()
+	highlight.UserId = userId
+	highlight.Items = items
+	highlight.Cover = nil
+	if cover != nil {
+		highlight.Cover, err = hu.saveCover(highlight.Id, cover)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	highlight.CreatedDate = time.Now()
+	highlight.UpdatedDate = highlight.CreatedDate
+
+	err = hu.highlightRepository.InsertHighlight(ctx, highlight)
+	if err != nil {
+		hu.removeCover(highlight.Cover)
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (hu *highlightUsecase) FindUserHighlights(ctx context.Context, userId string) (*[]domain.Highlight, error) {
+	filter := bson.M{"_id": userId}
+	queryResult, err := hu.userRepository.FindUser(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrUserNotFound
+	}
+
+	filter = bson.M{"user_id": userId}
+	queryResult, err = hu.highlightRepository.FindHighlights(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	var highlights []domain.Highlight
+	for _, v := range *queryResult {
+		highlightBytes, err := bson.Marshal(v)
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		var highlight domain.Highlight
+		if err = bson.Unmarshal(highlightBytes, &highlight); err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		highlights = append(highlights, highlight)
+	}
+	return &highlights, nil
+}
+
+func (hu *highlightUsecase) UpdateHighlight(ctx context.Context, highlight *domain.Highlight, tokenString string, cover *multipart.FileHeader) error {
+	userId, err := hu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	oldHighlight, err := hu.findOwnedHighlight(ctx, highlight.Id, userId, domain.ErrUnauthorizedHighlightUpdate)
+	if err != nil {
+		return err
+	}
+	items, err := hu.resolveHighlightItems(ctx, highlight.Items, userId)
+	if err != nil {
+		return err
+	}
+	highlight.UserId = userId
+	highlight.Items = items
+	highlight.Cover = oldHighlight.Cover
+	if cover != nil {
+		highlight.Cover, err = hu.saveCover(highlight.Id, cover)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+	}
+	highlight.CreatedDate = oldHighlight.CreatedDate
+	highlight.UpdatedDate = time.Now()
+
+	err = hu.highlightRepository.UpdateHighlight(ctx, highlight)
+	if err != nil {
+		if cover != nil {
+			hu.removeCover(highlight.Cover)
+		}
+		return domain.ErrInternalServerError
+	}
+	if cover != nil {
+		hu.removeCover(oldHighlight.Cover)
+	}
+	hu.removeUnreferencedItems(ctx, oldHighlight.Items)
+	return nil
+}
+
+func (hu *highlightUsecase) DeleteHighlight(ctx context.Context, highlightId string, tokenString string) error {
+	userId, err := hu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	highlight, err := hu.findOwnedHighlight(ctx, highlightId, userId, domain.ErrUnauthorizedHighlightDelete)
+	if err != nil {
+		return err
+	}
+
+	err = hu.highlightRepository.DeleteHighlight(ctx, highlightId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	hu.removeCover(highlight.Cover)
+	hu.removeUnreferencedItems(ctx, highlight.Items)
+	return nil
+}
+
+func (hu *highlightUsecase) findOwnedHighlight(ctx context.Context, highlightId string, userId string, unauthorizedErr error) (*domain.Highlight, error) {
+	filter := bson.M{"_id": highlightId}
+	queryResult, err := hu.highlightRepository.FindHighlights(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrHighlightNotFound
+	}
+	highlight, err := hu.highlightRepository.FindOneHighlight(ctx, highlightId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if highlight.UserId != userId {
+		return nil, unauthorizedErr
+	}
+	return highlight, nil
+}
+
+func (hu *highlightUsecase) resolveHighlightItems(ctx context.Context, items []domain.HighlightItem, userId string) ([]domain.HighlightItem, error) {
+	if len(items) == 0 {
+		return nil, domain.ErrMissingHighlightItemsInput
+	}
+	posts := make(map[string]*domain.Post)
+	var resolvedItems []domain.HighlightItem
+	for _, item := range items {
+		post, ok := posts[item.PostId]
+		if !ok {
+			filter := bson.M{"_id": item.PostId, "deleted_at": nil}
+			queryResult, err := hu.postRepository.FindPosts(ctx, filter)
+			if err != nil {
+				return nil, domain.ErrInternalServerError
+			}
+			if len(*queryResult) == 0 {
+				return nil, domain.ErrPostNotFound
+			}
+			post, err = hu.postRepository.FindOnePost(ctx, item.PostId)
+			if err != nil {
+				return nil, domain.ErrInternalServerError
+			}
+			if post.UserId != userId {
+				return nil, domain.ErrUnauthorizedHighlightItem
+			}
+			if !post.IsPublished() {
+				return nil, domain.ErrUnpublishedHighlightPost
+			}
+			posts[item.PostId] = post
+		}
+		if item.VisualMediaIndex < 0 || item.VisualMediaIndex >= len(post.VisualMedias) {
+			return nil, domain.ErrInvalidHighlightItems
+		}
+		resolvedItem := domain.NewHighlightItem(post.Id, item.VisualMediaIndex, post.VisualMedias[item.VisualMediaIndex])
+		resolvedItems = append(resolvedItems, *resolvedItem)
+	}
+	return resolvedItems, nil
+}
+
+func (hu *highlightUsecase) saveCover(highlightId string, cover *multipart.FileHeader) (*domain.VisualMedia, error) {
+	err := hu.fileOsHelper.MkDirAll(filepath.Join(".", "highlights"), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	fileNameParts := strings.Split(cover.Filename, ".")
+	extension := fileNameParts[len(fileNameParts)-1]
+	coverUrl := "./highlights/" + highlightId + "-" + uuid.NewString() + "." + extension
+	return domain.SaveVisualMedia(hu.fileOsHelper, cover, coverUrl, "")
+}
+
+func (hu *highlightUsecase) removeCover(cover *domain.VisualMedia) {
+	if cover != nil {
+		domain.RemoveVisualMediaFiles(hu.fileOsHelper, []domain.VisualMedia{*cover})
+	}
+}
+
+// Files whose references cannot be checked are kept.
+func (hu *highlightUsecase) removeUnreferencedItems(ctx context.Context, items []domain.HighlightItem) {
+	for _, item := range items {
+		var urls bson.A
+		for _, variant := range item.VisualMedia.Variants {
+			urls = append(urls, variant.Url)
+		}
+		filter := bson.M{"visual_medias.variants.url": bson.M{"$in": urls}}
+		queryResult, err := hu.postRepository.FindPosts(ctx, filter)
+		if err != nil || len(*queryResult) > 0 {
+			continue
+		}
+		filter = bson.M{"items.visual_media.variants.url": bson.M{"$in": urls}}
+		queryResult, err = hu.highlightRepository.FindHighlights(ctx, filter)
+		if err != nil || len(*queryResult) > 0 {
+			continue
+		}
+		domain.RemoveVisualMediaFiles(hu.fileOsHelper, []domain.VisualMedia{item.VisualMedia})
+	}
+}
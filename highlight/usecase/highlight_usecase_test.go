@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	"instagram-go/highlight/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestHighlightUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(HighlightUsecaseSuite))
+}
+
+type HighlightUsecaseSuite struct {
+	suite.Suite
+	mockHighlightRepository *mocks.HighlightRepository
+	mockPostRepository      *mocks.PostRepository
+	mockUserRepository      *mocks.UserRepository
+	mockHeaderHelper        *mocks.IHeaderHelper
+	mockFileOsHelper        *mocks.IFileOsHelper
+}
+
+func (hu *HighlightUsecaseSuite) SetupTest() {
+	hu.mockHighlightRepository = new(mocks.HighlightRepository)
+	hu.mockPostRepository = new(mocks.PostRepository)
+	hu.mockUserRepository = new(mocks.UserRepository)
+	hu.mockHeaderHelper = new(mocks.IHeaderHelper)
+	hu.mockFileOsHelper = new(mocks.IFileOsHelper)
+}
+
+func newVisualMedia(url string) *domain.VisualMedia {
+	return domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", []domain.VisualMediaVariant{*domain.NewVisualMediaVariant(domain.VisualMediaVariantOriginal, url)})
+}
+
+func (hu *HighlightUsecaseSuite) TestInsertHighlightSuccessful() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*newVisualMedia("./visual_medias/postid10.jpg"), *newVisualMedia("./visual_medias/postid11.jpg")}, "caption1", 0, time.Now(), time.Now())
+	hu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	hu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	hu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+	hu.mockHighlightRepository.On("InsertHighlight", mock.Anything, mock.AnythingOfType("*domain.Highlight")).Return(nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	highlight := domain.NewHighlight("", "", "trips", nil, []domain.HighlightItem{{PostId: "postid1", VisualMediaIndex: 1}, {PostId: "postid1", VisualMediaIndex: 0}}, time.Time{}, time.Time{})
+	err := highlightUsecase.InsertHighlight(context.TODO(), highlight, "accessToken", nil)
+
+	assert.NoErrorf(hu.T(), err, "Should have not return error but got %s", err)
+	hu.mockPostRepository.AssertNumberOfCalls(hu.T(), "FindOnePost", 1)
+	hu.mockHighlightRepository.AssertCalled(hu.T(), "InsertHighlight", mock.Anything, mock.MatchedBy(func(highlight *domain.Highlight) bool {
+		return highlight.UserId == "userid1" && highlight.Cover == nil && len(highlight.Items) == 2 &&
+			highlight.Items[0].VisualMedia.Variants[0].Url == "./visual_medias/postid11.jpg" &&
+			highlight.Items[1].VisualMedia.Variants[0].Url == "./visual_medias/postid10.jpg"
+	}))
+}
+
+func (hu *HighlightUsecaseSuite) TestInsertHighlightMissingItems() {
+	hu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	highlight := domain.NewHighlight("", "", "trips", nil, nil, time.Time{}, time.Time{})
+	err := highlightUsecase.InsertHighlight(context.TODO(), highlight, "accessToken", nil)
+
+	expectedError := domain.ErrMissingHighlightItemsInput.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (hu *HighlightUsecaseSuite) TestInsertHighlightUnauthorizedHighlightItem() {
+	foundPost := domain.NewPost("postid1", "userid2", []domain.VisualMedia{*newVisualMedia("./visual_medias/postid10.jpg")}, "caption1", 0, time.Now(), time.Now())
+	hu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	hu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	hu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	highlight := domain.NewHighlight("", "", "trips", nil, []domain.HighlightItem{{PostId: "postid1"}}, time.Time{}, time.Time{})
+	err := highlightUsecase.InsertHighlight(context.TODO(), highlight, "accessToken", nil)
+
+	expectedError := domain.ErrUnauthorizedHighlightItem.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	hu.mockHighlightRepository.AssertNotCalled(hu.T(), "InsertHighlight", mock.Anything, mock.Anything)
+}
+
+func (hu *HighlightUsecaseSuite) TestInsertHighlightUnpublishedPost() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*newVisualMedia("./visual_medias/postid10.jpg")}, "caption1", 0, time.Now(), time.Now())
+	foundPost.Status = domain.PostStatusDraft
+	hu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	hu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	hu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	highlight := domain.NewHighlight("", "", "trips", nil, []domain.HighlightItem{{PostId: "postid1"}}, time.Time{}, time.Time{})
+	err := highlightUsecase.InsertHighlight(context.TODO(), highlight, "accessToken", nil)
+
+	expectedError := domain.ErrUnpublishedHighlightPost.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (hu *HighlightUsecaseSuite) TestInsertHighlightInvalidItems() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*newVisualMedia("./visual_medias/postid10.jpg")}, "caption1", 0, time.Now(), time.Now())
+	hu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	hu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	hu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	highlight := domain.NewHighlight("", "", "trips", nil, []domain.HighlightItem{{PostId: "postid1", VisualMediaIndex: 1}}, time.Time{}, time.Time{})
+	err := highlightUsecase.InsertHighlight(context.TODO(), highlight, "accessToken", nil)
+
+	expectedError := domain.ErrInvalidHighlightItems.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (hu *HighlightUsecaseSuite) TestFindUserHighlightsUserNotFound() {
+	hu.mockUserRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	_, err := highlightUsecase.FindUserHighlights(context.TODO(), "userid1")
+
+	expectedError := domain.ErrUserNotFound.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (hu *HighlightUsecaseSuite) TestUpdateHighlightUnauthorizedHighlightUpdate() {
+	hu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	hu.mockHighlightRepository.On("FindHighlights", mock.Anything, bson.M{"_id": "highlightid1"}).Return(&[]bson.M{{"_id": "highlightid1"}}, nil)
+	hu.mockHighlightRepository.On("FindOneHighlight", mock.Anything, "highlightid1").Return(domain.NewHighlight("highlightid1", "userid1", "trips", nil, nil, time.Now(), time.Now()), nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	highlight := domain.NewHighlight("highlightid1", "", "trips", nil, []domain.HighlightItem{{PostId: "postid1"}}, time.Time{}, time.Time{})
+	err := highlightUsecase.UpdateHighlight(context.TODO(), highlight, "accessToken", nil)
+
+	expectedError := domain.ErrUnauthorizedHighlightUpdate.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	hu.mockHighlightRepository.AssertNotCalled(hu.T(), "UpdateHighlight", mock.Anything, mock.Anything)
+}
+
+func (hu *HighlightUsecaseSuite) TestDeleteHighlightHighlightNotFound() {
+	hu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	hu.mockHighlightRepository.On("FindHighlights", mock.Anything, bson.M{"_id": "highlightid1"}).Return(&[]bson.M{}, nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	err := highlightUsecase.DeleteHighlight(context.TODO(), "highlightid1", "accessToken")
+
+	expectedError := domain.ErrHighlightNotFound.Error()
+	assert.EqualErrorf(hu.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (hu *HighlightUsecaseSuite) TestDeleteHighlightRemovesUnreferencedFiles() {
+	cover := newVisualMedia("./highlights/highlightid1-cover.jpg")
+	items := []domain.HighlightItem{
+		*domain.NewHighlightItem("postid1", 0, *newVisualMedia("./visual_medias/postid10.jpg")),
+		*domain.NewHighlightItem("postid2", 0, *newVisualMedia("./visual_medias/postid20.jpg")),
+		*domain.NewHighlightItem("postid3", 0, *newVisualMedia("./visual_medias/postid30.jpg")),
+	}
+	hu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	hu.mockHighlightRepository.On("FindHighlights", mock.Anything, bson.M{"_id": "highlightid1"}).Return(&[]bson.M{{"_id": "highlightid1"}}, nil)
+	hu.mockHighlightRepository.On("FindOneHighlight", mock.Anything, "highlightid1").Return(domain.NewHighlight("highlightid1", "userid1", "trips", cover, items, time.Now(), time.Now()), nil)
+	hu.mockHighlightRepository.On("DeleteHighlight", mock.Anything, "highlightid1").Return(nil)
+	hu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"visual_medias.variants.url": bson.M{"$in": bson.A{"./visual_medias/postid10.jpg"}}}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	hu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	hu.mockHighlightRepository.On("FindHighlights", mock.Anything, bson.M{"items.visual_media.variants.url": bson.M{"$in": bson.A{"./visual_medias/postid20.jpg"}}}).Return(&[]bson.M{{"_id": "highlightid2"}}, nil)
+	hu.mockHighlightRepository.On("FindHighlights", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	hu.mockFileOsHelper.On("Remove", mock.AnythingOfType("string")).Return(nil)
+
+	highlightUsecase := usecase.NewHighlightUsecase(hu.mockHighlightRepository, hu.mockPostRepository, hu.mockUserRepository, hu.mockHeaderHelper, hu.mockFileOsHelper)
+	err := highlightUsecase.DeleteHighlight(context.TODO(), "highlightid1", "accessToken")
+
+	assert.NoErrorf(hu.T(), err, "Should have not return error but got %s", err)
+	hu.mockFileOsHelper.AssertCalled(hu.T(), "Remove", "./highlights/highlightid1-cover.jpg")
+	hu.mockFileOsHelper.AssertCalled(hu.T(), "Remove", "./visual_medias/postid30.jpg")
+	hu.mockFileOsHelper.AssertNumberOfCalls(hu.T(), "Remove", 2)
+}
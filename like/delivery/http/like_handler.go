@@ -3,6 +3,7 @@ package http
 import (
 	"encoding/json"
 	"instagram-go/domain"
+	"io/ioutil"
 	"net/http"
 	"strings"
 )
@@ -21,7 +22,7 @@ func (lh *LikeHandler) PostLikePost(w http.ResponseWriter, r *http.Request) {
 	urlParts := strings.Split(r.URL.String(), "/")
 	postId := urlParts[2]
 	tokenString := r.Header.Get("Authorization")
-	err := lh.likeUsecase.InsertPostLike(postId, tokenString)
+	like, inserted, err := lh.likeUsecase.InsertPostLike(r.Context(), postId, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -34,14 +35,50 @@ func (lh *LikeHandler) PostLikePost(w http.ResponseWriter, r *http.Request) {
 		w.Write(responseBytes)
 		return
 	}
+	dataLike := domain.NewDataLike(like)
+	response := domain.NewDataResponseLike(dataLike)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	if !inserted {
+		w.WriteHeader(http.StatusOK)
+		w.Write(responseBytes)
+		return
+	}
 	w.WriteHeader(http.StatusCreated)
+	w.Write(responseBytes)
 }
 
 func (lh *LikeHandler) DeleteLikePost(w http.ResponseWriter, r *http.Request) {
 	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
 	likeId := urlParts[4]
 	tokenString := r.Header.Get("Authorization")
-	err := lh.likeUsecase.DeletePostLike(likeId, tokenString)
+	err := lh.likeUsecase.DeletePostLike(r.Context(), postId, likeId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (lh *LikeHandler) UnlikePost(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := lh.likeUsecase.UnlikePost(r.Context(), postId, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -59,10 +96,11 @@ func (lh *LikeHandler) DeleteLikePost(w http.ResponseWriter, r *http.Request) {
 
 func (lh *LikeHandler) PostCommentLike(w http.ResponseWriter, r *http.Request) {
 	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
 	commentId := urlParts[4]
 	tokenString := r.Header.Get("Authorization")
 
-	err := lh.likeUsecase.InsertCommentLike(commentId, tokenString)
+	like, inserted, err := lh.likeUsecase.InsertCommentLike(r.Context(), postId, commentId, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -75,15 +113,246 @@ func (lh *LikeHandler) PostCommentLike(w http.ResponseWriter, r *http.Request) {
 		w.Write(responseBytes)
 		return
 	}
+	dataLike := domain.NewDataLike(like)
+	response := domain.NewDataResponseLike(dataLike)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	if !inserted {
+		w.WriteHeader(http.StatusOK)
+		w.Write(responseBytes)
+		return
+	}
 	w.WriteHeader(http.StatusCreated)
+	w.Write(responseBytes)
 }
 
 func (lh *LikeHandler) DeleteCommentLike(w http.ResponseWriter, r *http.Request) {
 	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	commentId := urlParts[4]
 	likeId := urlParts[6]
 	tokenString := r.Header.Get("Authorization")
 
-	err := lh.likeUsecase.DeleteCommentLike(likeId, tokenString)
+	err := lh.likeUsecase.DeleteCommentLike(r.Context(), postId, commentId, likeId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (lh *LikeHandler) UnlikeComment(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	commentId := urlParts[4]
+	tokenString := r.Header.Get("Authorization")
+
+	err := lh.likeUsecase.UnlikeComment(r.Context(), postId, commentId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (lh *LikeHandler) PostReactions(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	switch r.Method {
+	case "PUT":
+		lh.putReaction(w, r, postId, domain.LikeResourcePost, postId)
+		return
+	case "DELETE":
+		lh.deleteReaction(w, r, postId, domain.LikeResourcePost, postId)
+		return
+	}
+}
+
+func (lh *LikeHandler) CommentReactions(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	commentId := urlParts[4]
+	switch r.Method {
+	case "PUT":
+		lh.putReaction(w, r, postId, domain.LikeResourceComment, commentId)
+		return
+	case "DELETE":
+		lh.deleteReaction(w, r, postId, domain.LikeResourceComment, commentId)
+		return
+	}
+}
+
+func (lh *LikeHandler) putReaction(w http.ResponseWriter, r *http.Request, postId string, resourceType string, resourceId string) {
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(domain.ErrInternalServerError))
+		w.Write(responseBytes)
+		return
+	}
+	var body struct {
+		Reaction string `json:"reaction"`
+	}
+	err = json.Unmarshal(bodyBytes, &body)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidReaction.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(domain.ErrInvalidReaction))
+		w.Write(responseBytes)
+		return
+	}
+	tokenString := r.Header.Get("Authorization")
+
+	err = lh.likeUsecase.PutReaction(r.Context(), postId, resourceType, resourceId, body.Reaction, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Reaction successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (lh *LikeHandler) deleteReaction(w http.ResponseWriter, r *http.Request, postId string, resourceType string, resourceId string) {
+	tokenString := r.Header.Get("Authorization")
+
+	err := lh.likeUsecase.DeleteReaction(r.Context(), postId, resourceType, resourceId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Reaction successfully Deleted")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (lh *LikeHandler) GetPostLikes(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	postId := urlParts[2]
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	tokenString := r.Header.Get("Authorization")
+	likers, err := lh.likeUsecase.FindPostLikes(r.Context(), postId, page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataLikers := domain.NewDataLikers(likers)
+	response := domain.NewDataResponseLikers(dataLikers)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (lh *LikeHandler) GetCommentLikes(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	postId := urlParts[2]
+	commentId := urlParts[4]
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(likeGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	tokenString := r.Header.Get("Authorization")
+	likers, err := lh.likeUsecase.FindCommentLikes(r.Context(), postId, commentId, page, limit, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -96,15 +365,29 @@ func (lh *LikeHandler) DeleteCommentLike(w http.ResponseWriter, r *http.Request)
 		w.Write(responseBytes)
 		return
 	}
+	dataLikers := domain.NewDataLikers(likers)
+	response := domain.NewDataResponseLikers(dataLikers)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
 	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
 }
 
 func likeGetStatusCode(err error) int {
 	switch err {
 	case domain.ErrInternalServerError:
 		return http.StatusInternalServerError
-	case domain.ErrPostNotFound, domain.ErrLikeNotFound, domain.ErrCommentNotFound:
+	case domain.ErrPostNotFound, domain.ErrLikeNotFound, domain.ErrCommentNotFound, domain.ErrReactionNotFound,
+		domain.ErrLikeNotOnPost, domain.ErrLikeNotOnComment, domain.ErrCommentNotOnPost:
 		return http.StatusNotFound
+	case domain.ErrInvalidReaction, domain.ErrInvalidPagination:
+		return http.StatusBadRequest
+	case domain.ErrHiddenLikes:
+		return http.StatusForbidden
 	case domain.ErrPostLikeConflict, domain.ErrCommentLikeConflict:
 		return http.StatusConflict
 	case domain.ErrUnauthorizedLikeDelete:
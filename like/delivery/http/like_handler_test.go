@@ -6,7 +6,9 @@ import (
 	likeHttp "instagram-go/like/delivery/http"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -27,7 +29,7 @@ func (lh *LikeHandlerSuite) SetupTest() {
 }
 
 func (lh *LikeHandlerSuite) TestPostLikePostInsertPostLikeError() {
-	lh.likeUsecase.On("InsertPostLike", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	lh.likeUsecase.On("InsertPostLike", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, false, domain.ErrInternalServerError)
 	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
 	req, _ := http.NewRequest("POST", "/posts/postid1/likes", nil)
 	rr := httptest.NewRecorder()
@@ -40,7 +42,9 @@ func (lh *LikeHandlerSuite) TestPostLikePostInsertPostLikeError() {
 }
 
 func (lh *LikeHandlerSuite) TestPostLikePostSuccessful() {
-	lh.likeUsecase.On("InsertPostLike", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	like := domain.NewLike("likeid1", "userid1", "postid1", domain.LikeResourcePost)
+	like.CreatedDate = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	lh.likeUsecase.On("InsertPostLike", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(like, true, nil)
 	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
 	req, _ := http.NewRequest("POST", "/posts/postid1/likes", nil)
 	rr := httptest.NewRecorder()
@@ -48,10 +52,28 @@ func (lh *LikeHandlerSuite) TestPostLikePostSuccessful() {
 	handler.ServeHTTP(rr, req)
 
 	assert.Equalf(lh.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
+	expectedBody := `{"data":{"like":{"id":"likeid1","user_id":"userid1","resource_id":"postid1","resource_type":"post","reaction":"heart","created_date":"2022-01-01T00:00:00Z"}}}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestPostLikePostAlreadyLiked() {
+	like := domain.NewLike("likeid1", "userid1", "postid1", domain.LikeResourcePost)
+	like.Reaction = "laugh"
+	like.CreatedDate = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	lh.likeUsecase.On("InsertPostLike", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(like, false, nil)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("POST", "/posts/postid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.PostLikePost)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"like":{"id":"likeid1","user_id":"userid1","resource_id":"postid1","resource_type":"post","reaction":"laugh","created_date":"2022-01-01T00:00:00Z"}}}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
 
 func (lh *LikeHandlerSuite) TestDeleteLikePostDeletePostLikeError() {
-	lh.likeUsecase.On("DeletePostLike", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	lh.likeUsecase.On("DeletePostLike", mock.Anything, "postid1", "likeid1", mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
 	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
 	req, _ := http.NewRequest("DELETE", "/posts/postid1/likes/likeid1", nil)
 	rr := httptest.NewRecorder()
@@ -64,7 +86,7 @@ func (lh *LikeHandlerSuite) TestDeleteLikePostDeletePostLikeError() {
 }
 
 func (lh *LikeHandlerSuite) TestDeleteLikePostSuccessful() {
-	lh.likeUsecase.On("DeletePostLike", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	lh.likeUsecase.On("DeletePostLike", mock.Anything, "postid1", "likeid1", mock.AnythingOfType("string")).Return(nil)
 	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
 	req, _ := http.NewRequest("DELETE", "/posts/postid1/likes/likeid1", nil)
 	rr := httptest.NewRecorder()
@@ -74,7 +96,7 @@ func (lh *LikeHandlerSuite) TestDeleteLikePostSuccessful() {
 }
 
 func (lh *LikeHandlerSuite) TestPostCommentLikeInsertCommentLikeError() {
-	lh.likeUsecase.On("InsertCommentLike", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	lh.likeUsecase.On("InsertCommentLike", mock.Anything, "postid1", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, false, domain.ErrInternalServerError)
 	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
 	req, _ := http.NewRequest("POST", "/posts/postid1/comments/commentid1/likes", nil)
 	rr := httptest.NewRecorder()
@@ -87,7 +109,8 @@ func (lh *LikeHandlerSuite) TestPostCommentLikeInsertCommentLikeError() {
 }
 
 func (lh *LikeHandlerSuite) TestPostCommentLikeSuccessful() {
-	lh.likeUsecase.On("InsertCommentLike", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	like := domain.NewLike("likeid1", "userid1", "commentid1", domain.LikeResourceComment)
+	lh.likeUsecase.On("InsertCommentLike", mock.Anything, "postid1", "commentid1", mock.AnythingOfType("string")).Return(like, true, nil)
 	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
 	req, _ := http.NewRequest("POST", "/posts/postid1/comments/commentid1/likes", nil)
 	rr := httptest.NewRecorder()
@@ -97,8 +120,20 @@ func (lh *LikeHandlerSuite) TestPostCommentLikeSuccessful() {
 	assert.Equalf(lh.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
 }
 
+func (lh *LikeHandlerSuite) TestPostCommentLikeAlreadyLiked() {
+	like := domain.NewLike("likeid1", "userid1", "commentid1", domain.LikeResourceComment)
+	lh.likeUsecase.On("InsertCommentLike", mock.Anything, "postid1", "commentid1", mock.AnythingOfType("string")).Return(like, false, nil)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("POST", "/posts/postid1/comments/commentid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.PostCommentLike)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+}
+
 func (lh *LikeHandlerSuite) TestDeleteCommentLikeDeleteCommentLikeError() {
-	lh.likeUsecase.On("DeleteCommentLike", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	lh.likeUsecase.On("DeleteCommentLike", mock.Anything, "postid1", "commentid1", "deleteid1", mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
 	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
 	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/likes/deleteid1", nil)
 	rr := httptest.NewRecorder()
@@ -111,7 +146,7 @@ func (lh *LikeHandlerSuite) TestDeleteCommentLikeDeleteCommentLikeError() {
 }
 
 func (lh *LikeHandlerSuite) TestDeleteCommentLikeSuccessful() {
-	lh.likeUsecase.On("DeleteCommentLike", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	lh.likeUsecase.On("DeleteCommentLike", mock.Anything, "postid1", "commentid1", "deleteid1", mock.AnythingOfType("string")).Return(nil)
 	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
 	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/likes/deleteid1", nil)
 	rr := httptest.NewRecorder()
@@ -120,3 +155,155 @@ func (lh *LikeHandlerSuite) TestDeleteCommentLikeSuccessful() {
 
 	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
 }
+
+func (lh *LikeHandlerSuite) TestUnlikePostError() {
+	lh.likeUsecase.On("UnlikePost", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrPostNotFound)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.UnlikePost)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrPostNotFound.Error() + `"}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestUnlikePostSuccessful() {
+	lh.likeUsecase.On("UnlikePost", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(nil)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.UnlikePost)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+}
+
+func (lh *LikeHandlerSuite) TestUnlikeCommentSuccessful() {
+	lh.likeUsecase.On("UnlikeComment", mock.Anything, "postid1", "commentid1", mock.AnythingOfType("string")).Return(nil)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.UnlikeComment)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+}
+
+func (lh *LikeHandlerSuite) TestPostReactionsPutInvalidBody() {
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("PUT", "/posts/postid1/reactions", strings.NewReader("laugh"))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.PostReactions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidReaction.Error() + `"}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestPostReactionsPutSuccessful() {
+	lh.likeUsecase.On("PutReaction", mock.Anything, "postid1", domain.LikeResourcePost, "postid1", "laugh", mock.AnythingOfType("string")).Return(nil)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("PUT", "/posts/postid1/reactions", strings.NewReader(`{"reaction":"laugh"}`))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.PostReactions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Reaction successfully Updated"}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestPostReactionsDeleteError() {
+	lh.likeUsecase.On("DeleteReaction", mock.Anything, "postid1", domain.LikeResourcePost, "postid1", mock.AnythingOfType("string")).Return(domain.ErrReactionNotFound)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/reactions", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.PostReactions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrReactionNotFound.Error() + `"}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestCommentReactionsPutError() {
+	lh.likeUsecase.On("PutReaction", mock.Anything, "postid1", domain.LikeResourceComment, "commentid1", "thumbsdown", mock.AnythingOfType("string")).Return(domain.ErrInvalidReaction)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("PUT", "/posts/postid1/comments/commentid1/reactions", strings.NewReader(`{"reaction":"thumbsdown"}`))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.CommentReactions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidReaction.Error() + `"}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestCommentReactionsDeleteSuccessful() {
+	lh.likeUsecase.On("DeleteReaction", mock.Anything, "postid1", domain.LikeResourceComment, "commentid1", mock.AnythingOfType("string")).Return(nil)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/reactions", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.CommentReactions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Reaction successfully Deleted"}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestGetPostLikesInvalidPagination() {
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/likes?page=first", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.GetPostLikes)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPagination.Error() + `"}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestGetPostLikesHiddenLikes() {
+	lh.likeUsecase.On("FindPostLikes", mock.Anything, "postid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, domain.ErrHiddenLikes)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.GetPostLikes)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusForbidden, rr.Code, "Should have responded with http status code %v but got %v", http.StatusForbidden, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrHiddenLikes.Error() + `"}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestGetPostLikesSuccessful() {
+	likers := []domain.Liker{*domain.NewLiker(domain.NewUser("userid2", "username2", "fullname2", "", "", nil), "laugh")}
+	lh.likeUsecase.On("FindPostLikes", mock.Anything, "postid1", 2, 10, mock.AnythingOfType("string")).Return(&likers, nil)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/likes?page=2&limit=10", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.GetPostLikes)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"likers":[{"user_id":"userid2","username":"username2","fullname":"fullname2","profile_pictures":null,"reaction":"laugh"}]}}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (lh *LikeHandlerSuite) TestGetCommentLikesSuccessful() {
+	likers := []domain.Liker{}
+	lh.likeUsecase.On("FindCommentLikes", mock.Anything, "postid1", "commentid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(&likers, nil)
+	likeHandler := likeHttp.NewLikeHandler(lh.likeUsecase)
+	req, _ := http.NewRequest("GET", "/posts/postid1/comments/commentid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(likeHandler.GetCommentLikes)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(lh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"likers":[]}}`
+	assert.Equalf(lh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
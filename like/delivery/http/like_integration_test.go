@@ -0,0 +1,179 @@
+package http_test
+
+import (
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	likeHttp "instagram-go/like/delivery/http"
+	likeUsecase "instagram-go/like/usecase"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestLikeIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(LikeIntegrationSuite))
+}
+
+type LikeIntegrationSuite struct {
+	suite.Suite
+	headerHelper      *mocks.IHeaderHelper
+	postRepository    *mocks.PostRepository
+	likeRepository    *mocks.LikeRepository
+	commentRepository *mocks.CommentRepository
+	userRepository    *mocks.UserRepository
+	likeHandler       domain.LikeHandler
+}
+
+func (li *LikeIntegrationSuite) SetupTest() {
+	li.headerHelper = new(mocks.IHeaderHelper)
+	li.postRepository = new(mocks.PostRepository)
+	li.likeRepository = new(mocks.LikeRepository)
+	li.commentRepository = new(mocks.CommentRepository)
+	li.userRepository = new(mocks.UserRepository)
+	li.likeHandler = likeHttp.NewLikeHandler(likeUsecase.NewLikeUsecase(
+		li.likeRepository, li.postRepository, li.commentRepository, li.userRepository, domain.DefaultReactions, li.headerHelper,
+	))
+	li.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+}
+
+func (li *LikeIntegrationSuite) mockLike(like *domain.Like) {
+	li.likeRepository.On("FindLikes", mock.Anything, bson.M{"_id": like.Id}).Return(&[]bson.M{
+		{"_id": like.Id, "user_id": like.UserId, "resource_id": like.ResourceId, "resource_type": like.ResourceType},
+	}, nil)
+	li.likeRepository.On("FindOneLike", mock.Anything, like.Id).Return(like, nil)
+	li.likeRepository.On("DeleteLike", mock.Anything, like.Id).Return(int64(1), nil)
+	li.commentRepository.On("IncrementCommentScore", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(nil)
+	li.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1"}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	li.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid2"}).Return(&[]bson.M{}, nil)
+}
+
+func (li *LikeIntegrationSuite) TestDeletePostLikeOfAComment() {
+	li.mockLike(domain.NewLike("likeid1", "userid1", "commentid1", domain.LikeResourceComment))
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/likes/likeid1", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.DeleteLikePost)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrLikeNotOnPost.Error() + `"}`
+	assert.Equalf(li.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	li.likeRepository.AssertNotCalled(li.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
+func (li *LikeIntegrationSuite) TestDeletePostLikeOfAnotherPost() {
+	li.mockLike(domain.NewLike("likeid1", "userid1", "postid2", domain.LikeResourcePost))
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/likes/likeid1", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.DeleteLikePost)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrLikeNotOnPost.Error() + `"}`
+	assert.Equalf(li.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	li.likeRepository.AssertNotCalled(li.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
+func (li *LikeIntegrationSuite) TestDeleteCommentLikeOfAPost() {
+	li.mockLike(domain.NewLike("likeid1", "userid1", "postid1", domain.LikeResourcePost))
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/likes/likeid1", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.DeleteCommentLike)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrLikeNotOnComment.Error() + `"}`
+	assert.Equalf(li.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	li.likeRepository.AssertNotCalled(li.T(), "DeleteLike", mock.Anything, mock.Anything)
+	li.commentRepository.AssertNotCalled(li.T(), "IncrementCommentScore", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (li *LikeIntegrationSuite) TestDeleteCommentLikeOfAnotherComment() {
+	li.mockLike(domain.NewLike("likeid1", "userid1", "commentid2", domain.LikeResourceComment))
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/likes/likeid1", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.DeleteCommentLike)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrLikeNotOnComment.Error() + `"}`
+	assert.Equalf(li.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	li.likeRepository.AssertNotCalled(li.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
+func (li *LikeIntegrationSuite) TestDeleteCommentLikeUnderAnotherPost() {
+	li.mockLike(domain.NewLike("likeid1", "userid1", "commentid1", domain.LikeResourceComment))
+	req, _ := http.NewRequest("DELETE", "/posts/postid2/comments/commentid1/likes/likeid1", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.DeleteCommentLike)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrCommentNotOnPost.Error() + `"}`
+	assert.Equalf(li.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	li.likeRepository.AssertNotCalled(li.T(), "DeleteLike", mock.Anything, mock.Anything)
+	li.commentRepository.AssertNotCalled(li.T(), "IncrementCommentScore", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (li *LikeIntegrationSuite) TestDeleteCommentLikeSuccessful() {
+	li.mockLike(domain.NewLike("likeid1", "userid1", "commentid1", domain.LikeResourceComment))
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/comments/commentid1/likes/likeid1", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.DeleteCommentLike)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	li.likeRepository.AssertCalled(li.T(), "DeleteLike", mock.Anything, "likeid1")
+	li.commentRepository.AssertCalled(li.T(), "IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentLikeScore)
+}
+
+func (li *LikeIntegrationSuite) mockComment() {
+	li.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	li.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1"}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	li.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid2"}).Return(&[]bson.M{}, nil)
+	li.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+}
+
+func (li *LikeIntegrationSuite) TestPostCommentLikeUnderAnotherPost() {
+	li.mockComment()
+	req, _ := http.NewRequest("POST", "/posts/postid2/comments/commentid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.PostCommentLike)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrCommentNotOnPost.Error() + `"}`
+	assert.Equalf(li.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	li.likeRepository.AssertNotCalled(li.T(), "InsertLike", mock.Anything, mock.Anything)
+}
+
+func (li *LikeIntegrationSuite) TestUnlikeCommentUnderAnotherPost() {
+	li.mockComment()
+	req, _ := http.NewRequest("DELETE", "/posts/postid2/comments/commentid1/likes", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.UnlikeComment)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrCommentNotOnPost.Error() + `"}`
+	assert.Equalf(li.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	li.likeRepository.AssertNotCalled(li.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
+func (li *LikeIntegrationSuite) TestCommentReactionsUnderAnotherPost() {
+	li.mockComment()
+	req, _ := http.NewRequest("PUT", "/posts/postid2/comments/commentid1/reactions", strings.NewReader(`{"reaction":"fire"}`))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(li.likeHandler.CommentReactions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(li.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrCommentNotOnPost.Error() + `"}`
+	assert.Equalf(li.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	li.likeRepository.AssertNotCalled(li.T(), "InsertLike", mock.Anything, mock.Anything)
+}
@@ -7,6 +7,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type mongodbLikeRepository struct {
@@ -19,41 +20,141 @@ func NewMongodbLikeRepository(collection *mongo.Collection) domain.LikeRepositor
 	}
 }
 
-func (mlr *mongodbLikeRepository) InsertLike(like *domain.Like) error {
+func (mlr *mongodbLikeRepository) CreateIndexes(ctx context.Context) error {
+	userResourceIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "resource_id", Value: 1},
+			primitive.E{Key: "resource_type", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err := mlr.collection.Indexes().CreateOne(ctx, userResourceIndex)
+	return err
+}
+
+func (mlr *mongodbLikeRepository) InsertLike(ctx context.Context, like *domain.Like) error {
 	newLike := bson.D{
 		primitive.E{Key: "_id", Value: like.Id},
 		primitive.E{Key: "user_id", Value: like.UserId},
 		primitive.E{Key: "resource_id", Value: like.ResourceId},
 		primitive.E{Key: "resource_type", Value: like.ResourceType},
+		primitive.E{Key: "reaction", Value: like.Reaction},
+		primitive.E{Key: "created_date", Value: like.CreatedDate},
+	}
+	_, err := mlr.collection.InsertOne(ctx, newLike)
+	if mongo.IsDuplicateKeyError(err) {
+		if like.ResourceType == domain.LikeResourceComment {
+			return domain.ErrCommentLikeConflict
+		}
+		return domain.ErrPostLikeConflict
 	}
-	_, err := mlr.collection.InsertOne(context.TODO(), newLike)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (mlr *mongodbLikeRepository) FindLikes(filter interface{}) (*[]bson.M, error) {
-	cursor, err := mlr.collection.Find(context.TODO(), filter)
+func (mlr *mongodbLikeRepository) FindLikes(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	cursor, err := mlr.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (mlr *mongodbLikeRepository) FindPaginatedLikes(ctx context.Context, filter interface{}, skip int64, limit int64) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(bson.D{
+		primitive.E{Key: "created_date", Value: -1},
+		primitive.E{Key: "_id", Value: 1},
+	}).SetSkip(skip).SetLimit(limit)
+	cursor, err := mlr.collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, err
 	}
 	var queryResult []bson.M
-	if err = cursor.All(context.TODO(), &queryResult); err != nil {
+	if err = cursor.All(ctx, &queryResult); err != nil {
 		return nil, err
 	}
 	return &queryResult, nil
 }
 
-func (mlr *mongodbLikeRepository) FindOneLike(likeId string) (*domain.Like, error) {
+func (mlr *mongodbLikeRepository) FindOneLike(ctx context.Context, likeId string) (*domain.Like, error) {
 	filter := bson.M{"_id": likeId}
 	var like domain.Like
-	err := mlr.collection.FindOne(context.TODO(), filter).Decode(&like)
+	err := mlr.collection.FindOne(ctx, filter).Decode(&like)
 	return &like, err
 }
 
-func (mlr *mongodbLikeRepository) DeleteLike(likeId string) error {
+// A like removed by a concurrent request counts 0, so callers adjust the score only once.
+func (mlr *mongodbLikeRepository) DeleteLike(ctx context.Context, likeId string) (int64, error) {
+	filter := bson.M{"_id": likeId}
+	deleteResult, err := mlr.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return deleteResult.DeletedCount, nil
+}
+
+func (mlr *mongodbLikeRepository) DeleteLikes(ctx context.Context, filter interface{}) error {
+	_, err := mlr.collection.DeleteMany(ctx, filter)
+	return err
+}
+
+func (mlr *mongodbLikeRepository) UpdateLikeReaction(ctx context.Context, likeId string, reaction string) error {
 	filter := bson.M{"_id": likeId}
-	_, err := mlr.collection.DeleteOne(context.TODO(), filter)
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "reaction", Value: reaction},
+	},
+	},
+	}
+	_, err := mlr.collection.UpdateOne(ctx, filter, update)
 	return err
 }
+
+func (mlr *mongodbLikeRepository) MigrateReactions(ctx context.Context) error {
+	filter := bson.M{"reaction": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"reaction": domain.ReactionHeart}}
+	_, err := mlr.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+func (mlr *mongodbLikeRepository) CountReactions(ctx context.Context, resourceType string, resourceIds []string) (map[string]map[string]int, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{primitive.E{Key: "$match", Value: bson.M{"resource_type": resourceType, "resource_id": bson.M{"$in": resourceIds}}}},
+		bson.D{primitive.E{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"resource_id": "$resource_id", "reaction": "$reaction"},
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+	cursor, err := mlr.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var groups []struct {
+		Id struct {
+			ResourceId string `bson:"resource_id"`
+			Reaction   string `bson:"reaction"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	}
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+	reactionCounts := make(map[string]map[string]int)
+	for _, group := range groups {
+		if reactionCounts[group.Id.ResourceId] == nil {
+			reactionCounts[group.Id.ResourceId] = map[string]int{}
+		}
+		reaction := group.Id.Reaction
+		if reaction == "" {
+			reaction = domain.ReactionHeart
+		}
+		reactionCounts[group.Id.ResourceId][reaction] += group.Count
+	}
+	return reactionCounts, nil
+}
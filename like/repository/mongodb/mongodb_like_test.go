@@ -5,6 +5,7 @@ import (
 	"instagram-go/domain"
 	"instagram-go/like/repository/mongodb"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -35,17 +36,28 @@ func (lr *LikeRepoSuite) TestInsertDuplicateLike() {
 	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
 	newLike := domain.NewLike("likeid1", "userid1", "postid1", "post")
 
-	_ = likeRepo.InsertLike(newLike)
-	err := likeRepo.InsertLike(newLike)
+	_ = likeRepo.InsertLike(context.TODO(), newLike)
+	err := likeRepo.InsertLike(context.TODO(), newLike)
 
 	assert.Error(lr.T(), err, "Should have return an error but didn't")
 }
 
+func (lr *LikeRepoSuite) TestInsertDuplicateUserResourceLike() {
+	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
+	_ = likeRepo.CreateIndexes(context.TODO())
+
+	_ = likeRepo.InsertLike(context.TODO(), domain.NewLike("likeid1", "userid1", "postid1", "post"))
+	err := likeRepo.InsertLike(context.TODO(), domain.NewLike("likeid2", "userid1", "postid1", "post"))
+
+	expectedError := domain.ErrPostLikeConflict.Error()
+	assert.EqualErrorf(lr.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
 func (lr *LikeRepoSuite) TestInsertLikeSuccessful() {
 	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
 	newLike := domain.NewLike("likeid1", "userid1", "postid1", "post")
 
-	err := likeRepo.InsertLike(newLike)
+	err := likeRepo.InsertLike(context.TODO(), newLike)
 
 	var insertedLike domain.Like
 	lr.collection.FindOne(context.TODO(), bson.M{"_id": "likeid1"}).Decode(&insertedLike)
@@ -67,7 +79,7 @@ func (lr *LikeRepoSuite) TestFindNotExistLikes() {
 
 	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
 	filter := bson.M{"_id": "notExistLikeId"}
-	queryResult, err := likeRepo.FindLikes(filter)
+	queryResult, err := likeRepo.FindLikes(context.TODO(), filter)
 
 	assert.Equalf(lr.T(), 0, len(*queryResult), "Should have return the correct amount of like %v but got %v", 0, len(*queryResult))
 	assert.NoError(lr.T(), err, "Should have not return error")
@@ -84,7 +96,7 @@ func (lr *LikeRepoSuite) TestFindLikesSuccessful() {
 
 	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
 	filter := bson.M{"_id": "likeid1"}
-	queryResult, err := likeRepo.FindLikes(filter)
+	queryResult, err := likeRepo.FindLikes(context.TODO(), filter)
 
 	assert.Equalf(lr.T(), 1, len(*queryResult), "Should have return the correct amount of like %v but got %v", 1, len(*queryResult))
 	assert.Equalf(lr.T(), "likeid1", (*queryResult)[0]["_id"], "Should have return the correct like id %s but got %s", "likeid1", (*queryResult)[0]["_id"])
@@ -96,7 +108,7 @@ func (lr *LikeRepoSuite) TestFindLikesSuccessful() {
 
 func (lr *LikeRepoSuite) TestFindOneNotExistLike() {
 	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
-	_, err := likeRepo.FindOneLike("notExistLikeId")
+	_, err := likeRepo.FindOneLike(context.TODO(), "notExistLikeId")
 	assert.Error(lr.T(), err, "Should return error but didn't")
 }
 
@@ -110,7 +122,7 @@ func (lr *LikeRepoSuite) TestFindOneLikeSuccessful() {
 	_, _ = lr.collection.InsertOne(context.TODO(), like)
 
 	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
-	foundLike, err := likeRepo.FindOneLike("likeid1")
+	foundLike, err := likeRepo.FindOneLike(context.TODO(), "likeid1")
 
 	assert.Equalf(lr.T(), "likeid1", foundLike.Id, "Should have return the correct like id %s but got %s", "likeid1", foundLike.Id)
 	assert.Equalf(lr.T(), "userid1", foundLike.UserId, "Should have return the correct user id %s but got %s", "userid1", foundLike.UserId)
@@ -129,7 +141,7 @@ func (lr *LikeRepoSuite) TestDeleteNotExistLike() {
 	_, _ = lr.collection.InsertOne(context.TODO(), like)
 
 	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
-	err := likeRepo.DeleteLike("notExistLike")
+	deletedCount, err := likeRepo.DeleteLike(context.TODO(), "notExistLike")
 
 	filter := bson.M{}
 	cursor, _ := lr.collection.Find(context.TODO(), filter)
@@ -137,6 +149,7 @@ func (lr *LikeRepoSuite) TestDeleteNotExistLike() {
 	cursor.All(context.TODO(), &queryResult)
 
 	assert.Equalf(lr.T(), 1, len(queryResult), "Should have return the correct amount of likes %v but got %v", 1, len(queryResult))
+	assert.Equalf(lr.T(), int64(0), deletedCount, "Should have return deleted count %v but got %v", 0, deletedCount)
 	assert.NoError(lr.T(), err, "Should have not return error")
 }
 
@@ -150,7 +163,7 @@ func (lr *LikeRepoSuite) TestDeleteLikeSuccessful() {
 	_, _ = lr.collection.InsertOne(context.TODO(), like)
 
 	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
-	err := likeRepo.DeleteLike("likeid1")
+	deletedCount, err := likeRepo.DeleteLike(context.TODO(), "likeid1")
 
 	filter := bson.M{"_id": "likeid1"}
 	cursor, _ := lr.collection.Find(context.TODO(), filter)
@@ -158,5 +171,66 @@ func (lr *LikeRepoSuite) TestDeleteLikeSuccessful() {
 	cursor.All(context.TODO(), &queryResult)
 
 	assert.Equalf(lr.T(), 0, len(queryResult), "Should have return the correct amount of likes %v but got %v", 0, len(queryResult))
+	assert.Equalf(lr.T(), int64(1), deletedCount, "Should have return deleted count %v but got %v", 1, deletedCount)
 	assert.NoError(lr.T(), err, "Should have not return error")
 }
+
+func (lr *LikeRepoSuite) TestDeleteLikesSuccessful() {
+	likes := []interface{}{
+		bson.M{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
+		bson.M{"_id": "likeid2", "user_id": "userid2", "resource_id": "postid1", "resource_type": "post"},
+		bson.M{"_id": "likeid3", "user_id": "userid1", "resource_id": "postid2", "resource_type": "post"},
+	}
+	_, _ = lr.collection.InsertMany(context.TODO(), likes)
+
+	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
+	err := likeRepo.DeleteLikes(context.TODO(), bson.M{"resource_id": "postid1", "resource_type": "post"})
+
+	filter := bson.M{}
+	cursor, _ := lr.collection.Find(context.TODO(), filter)
+	var queryResult []bson.M
+	cursor.All(context.TODO(), &queryResult)
+
+	assert.Equalf(lr.T(), 1, len(queryResult), "Should have return the correct amount of likes %v but got %v", 1, len(queryResult))
+	assert.NoError(lr.T(), err, "Should have not return error")
+}
+
+func (lr *LikeRepoSuite) TestFindPaginatedLikesSuccessful() {
+	now := time.Now()
+	likes := []interface{}{
+		bson.M{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post", "created_date": now.Add(-2 * time.Hour)},
+		bson.M{"_id": "likeid2", "user_id": "userid2", "resource_id": "postid1", "resource_type": "post", "created_date": now},
+		bson.M{"_id": "likeid3", "user_id": "userid3", "resource_id": "postid1", "resource_type": "post", "created_date": now.Add(-time.Hour)},
+		bson.M{"_id": "likeid4", "user_id": "userid1", "resource_id": "postid2", "resource_type": "post", "created_date": now},
+	}
+	_, _ = lr.collection.InsertMany(context.TODO(), likes)
+
+	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
+	queryResult, err := likeRepo.FindPaginatedLikes(context.TODO(), bson.M{"resource_id": "postid1", "resource_type": "post"}, 1, 1)
+
+	assert.NoError(lr.T(), err, "Should have not return error")
+	assert.Equalf(lr.T(), 1, len(*queryResult), "Should have return the correct amount of likes %v but got %v", 1, len(*queryResult))
+	assert.Equalf(lr.T(), "likeid3", (*queryResult)[0]["_id"], "Should have return the second newest like %s but got %s", "likeid3", (*queryResult)[0]["_id"])
+}
+
+func (lr *LikeRepoSuite) TestCountReactionsSuccessful() {
+	likes := []interface{}{
+		bson.M{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post", "reaction": "heart"},
+		bson.M{"_id": "likeid2", "user_id": "userid2", "resource_id": "postid1", "resource_type": "post", "reaction": "laugh"},
+		bson.M{"_id": "likeid3", "user_id": "userid3", "resource_id": "postid1", "resource_type": "post"},
+		bson.M{"_id": "likeid4", "user_id": "userid1", "resource_id": "postid2", "resource_type": "post", "reaction": "wow"},
+		bson.M{"_id": "likeid5", "user_id": "userid1", "resource_id": "postid1", "resource_type": "comment", "reaction": "heart"},
+		bson.M{"_id": "likeid6", "user_id": "userid1", "resource_id": "postid3", "resource_type": "post", "reaction": "heart"},
+	}
+	_, _ = lr.collection.InsertMany(context.TODO(), likes)
+
+	likeRepo := mongodb.NewMongodbLikeRepository(lr.collection)
+	reactionCounts, err := likeRepo.CountReactions(context.TODO(), "post", []string{"postid1", "postid2"})
+
+	assert.NoError(lr.T(), err, "Should have not return error")
+	expectedReactionCounts := map[string]map[string]int{
+		"postid1": {"heart": 2, "laugh": 1},
+		"postid2": {"wow": 1},
+	}
+	assert.Equalf(lr.T(), expectedReactionCounts, reactionCounts, "Should have return the reaction counts %v but got %v", expectedReactionCounts, reactionCounts)
+}
@@ -1,76 +1,108 @@
 package usecase
 
 import (
+	"context"
+	"fmt"
 	"instagram-go/domain"
-	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
 type likeUsecase struct {
-	sync.Mutex
 	headerHelper      domain.IHeaderHelper
 	postRepository    domain.PostRepository
 	likeRepository    domain.LikeRepository
 	commentRepository domain.CommentRepository
+	userRepository    domain.UserRepository
+	reactions         []string
 }
 
-func NewLikeUsecase(likeRepository domain.LikeRepository, postRepository domain.PostRepository, commentRepository domain.CommentRepository, headerHelper domain.IHeaderHelper) domain.LikeUsecase {
+func NewLikeUsecase(likeRepository domain.LikeRepository, postRepository domain.PostRepository, commentRepository domain.CommentRepository, userRepository domain.UserRepository, reactions []string, headerHelper domain.IHeaderHelper) domain.LikeUsecase {
 	return &likeUsecase{
 		likeRepository:    likeRepository,
 		postRepository:    postRepository,
 		commentRepository: commentRepository,
+		userRepository:    userRepository,
+		reactions:         reactions,
 		headerHelper:      headerHelper,
 	}
 }
 
-func (lu *likeUsecase) InsertPostLike(postId string, tokenString string) error {
+// Liking a post again returns the like already there, whatever its reaction.
+func (lu *likeUsecase) InsertPostLike(ctx context.Context, postId string, tokenString string) (*domain.Like, bool, error) {
+	userId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, false, domain.ErrInternalServerError
+	}
+	err = lu.findLikeResource(ctx, domain.LikeResourcePost, postId, userId)
+	if err != nil {
+		return nil, false, err
+	}
+	return lu.react(ctx, domain.LikeResourcePost, postId, userId, domain.ReactionHeart)
+}
+
+func (lu *likeUsecase) DeletePostLike(ctx context.Context, postId string, likeId string, tokenString string) error {
 	userId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	filter := bson.M{"_id": postId}
-	lu.Lock()
-	queryResult, err := lu.postRepository.FindPosts(filter)
-	lu.Unlock()
+	filter := bson.M{"_id": likeId}
+	queryResult, err := lu.likeRepository.FindLikes(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	if len(*queryResult) == 0 {
-		return domain.ErrPostNotFound
+		return domain.ErrLikeNotFound
 	}
 
-	filter = bson.M{"user_id": userId, "resource_id": postId, "resource_type": "post"}
-	lu.Lock()
-	queryResult, err = lu.likeRepository.FindLikes(filter)
-	lu.Unlock()
+	like, err := lu.likeRepository.FindOneLike(ctx, likeId)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	if len(*queryResult) > 0 {
-		return domain.ErrPostLikeConflict
+	if like.UserId != userId {
+		return domain.ErrUnauthorizedLikeDelete
 	}
-	likeId := "like-" + uuid.NewString()
-	like := domain.NewLike(likeId, userId, postId, "post")
-	lu.Lock()
-	err = lu.likeRepository.InsertLike(like)
-	lu.Unlock()
+	if like.ResourceType != domain.LikeResourcePost || like.ResourceId != postId {
+		return domain.ErrLikeNotOnPost
+	}
+
+	deletedCount, err := lu.likeRepository.DeleteLike(ctx, likeId)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
+	if deletedCount == 0 {
+		return domain.ErrLikeNotFound
+	}
+
 	return nil
 }
 
-func (lu *likeUsecase) DeletePostLike(likeId string, tokenString string) error {
+// Liking a comment again returns the like already there, whatever its reaction.
+func (lu *likeUsecase) InsertCommentLike(ctx context.Context, postId string, commentId string, tokenString string) (*domain.Like, bool, error) {
+	userId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, false, domain.ErrInternalServerError
+	}
+	err = lu.findLikeResource(ctx, domain.LikeResourceComment, commentId, userId)
+	if err != nil {
+		return nil, false, err
+	}
+	err = lu.findCommentOnPost(ctx, postId, commentId)
+	if err != nil {
+		return nil, false, err
+	}
+	return lu.react(ctx, domain.LikeResourceComment, commentId, userId, domain.ReactionHeart)
+}
+
+func (lu *likeUsecase) DeleteCommentLike(ctx context.Context, postId string, commentId string, likeId string, tokenString string) error {
 	userId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	filter := bson.M{"_id": likeId}
-	lu.Lock()
-	queryResult, err := lu.likeRepository.FindLikes(filter)
-	lu.Unlock()
+	queryResult, err := lu.likeRepository.FindLikes(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
@@ -78,97 +110,337 @@ func (lu *likeUsecase) DeletePostLike(likeId string, tokenString string) error {
 		return domain.ErrLikeNotFound
 	}
 
-	lu.Lock()
-	like, err := lu.likeRepository.FindOneLike(likeId)
-	lu.Unlock()
+	like, err := lu.likeRepository.FindOneLike(ctx, likeId)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	if like.UserId != userId {
 		return domain.ErrUnauthorizedLikeDelete
 	}
+	if like.ResourceType != domain.LikeResourceComment || like.ResourceId != commentId {
+		return domain.ErrLikeNotOnComment
+	}
+	err = lu.findCommentOnPost(ctx, postId, commentId)
+	if err != nil {
+		return err
+	}
 
-	lu.Lock()
-	err = lu.likeRepository.DeleteLike(likeId)
-	lu.Unlock()
+	deletedCount, err := lu.likeRepository.DeleteLike(ctx, likeId)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
+	if deletedCount == 0 {
+		return domain.ErrLikeNotFound
+	}
 
+	err = lu.commentRepository.IncrementCommentScore(ctx, commentId, -domain.CommentLikeScore)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
 	return nil
 }
 
-func (lu *likeUsecase) InsertCommentLike(commentId string, tokenString string) error {
+func (lu *likeUsecase) PutReaction(ctx context.Context, postId string, resourceType string, resourceId string, reaction string, tokenString string) error {
+	if !lu.isReaction(reaction) {
+		return domain.ErrInvalidReaction
+	}
 	userId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-
-	filter := bson.M{"_id": commentId}
-	lu.Lock()
-	queryResult, err := lu.commentRepository.FindComments(filter)
-	lu.Unlock()
+	err = lu.findLikeResource(ctx, resourceType, resourceId, userId)
 	if err != nil {
-		return domain.ErrInternalServerError
+		return err
 	}
-	if len(*queryResult) == 0 {
-		return domain.ErrCommentNotFound
+	if resourceType == domain.LikeResourceComment {
+		err = lu.findCommentOnPost(ctx, postId, resourceId)
+		if err != nil {
+			return err
+		}
+	}
+	like, inserted, err := lu.react(ctx, resourceType, resourceId, userId, reaction)
+	if err != nil {
+		return err
+	}
+	if inserted || like.Reaction == reaction {
+		return nil
+	}
+	err = lu.likeRepository.UpdateLikeReaction(ctx, like.Id, reaction)
+	if err != nil {
+		return domain.ErrInternalServerError
 	}
+	return nil
+}
 
-	filter = bson.M{"user_id": userId, "resource_id": commentId, "resource_type": "comment"}
-	lu.Lock()
-	queryResult, err = lu.likeRepository.FindLikes(filter)
-	lu.Unlock()
+func (lu *likeUsecase) DeleteReaction(ctx context.Context, postId string, resourceType string, resourceId string, tokenString string) error {
+	userId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	if len(*queryResult) > 0 {
-		return domain.ErrCommentLikeConflict
+	err = lu.findLikeResource(ctx, resourceType, resourceId, userId)
+	if err != nil {
+		return err
+	}
+	if resourceType == domain.LikeResourceComment {
+		err = lu.findCommentOnPost(ctx, postId, resourceId)
+		if err != nil {
+			return err
+		}
 	}
 
-	likeId := "like-" + uuid.NewString()
-	like := domain.NewLike(likeId, userId, commentId, "comment")
-	lu.Lock()
-	err = lu.likeRepository.InsertLike(like)
-	lu.Unlock()
+	deleted, err := lu.unreact(ctx, resourceType, resourceId, userId)
 	if err != nil {
-		return domain.ErrInternalServerError
+		return err
+	}
+	if !deleted {
+		return domain.ErrReactionNotFound
 	}
 	return nil
 }
 
-func (lu *likeUsecase) DeleteCommentLike(likeId string, tokenString string) error {
+func (lu *likeUsecase) UnlikePost(ctx context.Context, postId string, tokenString string) error {
 	userId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	filter := bson.M{"_id": likeId}
-	lu.Lock()
-	queryResult, err := lu.likeRepository.FindLikes(filter)
-	lu.Unlock()
+	err = lu.findLikeResource(ctx, domain.LikeResourcePost, postId, userId)
+	if err != nil {
+		return err
+	}
+	_, err = lu.unreact(ctx, domain.LikeResourcePost, postId, userId)
+	return err
+}
+
+func (lu *likeUsecase) UnlikeComment(ctx context.Context, postId string, commentId string, tokenString string) error {
+	userId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
+	err = lu.findLikeResource(ctx, domain.LikeResourceComment, commentId, userId)
+	if err != nil {
+		return err
+	}
+	err = lu.findCommentOnPost(ctx, postId, commentId)
+	if err != nil {
+		return err
+	}
+	_, err = lu.unreact(ctx, domain.LikeResourceComment, commentId, userId)
+	return err
+}
+
+// A like the user already left is returned as it is, reported by the unique index.
+func (lu *likeUsecase) react(ctx context.Context, resourceType string, resourceId string, userId string, reaction string) (*domain.Like, bool, error) {
+	like := domain.NewLike("like-"+uuid.NewString(), userId, resourceId, resourceType)
+	like.Reaction = reaction
+	like.CreatedDate = time.Now()
+	err := lu.likeRepository.InsertLike(ctx, like)
+	if err == domain.ErrPostLikeConflict || err == domain.ErrCommentLikeConflict {
+		like, err = lu.findUserLike(ctx, resourceType, resourceId, userId)
+		return like, false, err
+	}
+	if err != nil {
+		return nil, false, domain.ErrInternalServerError
+	}
+
+	if resourceType == domain.LikeResourceComment {
+		err = lu.commentRepository.IncrementCommentScore(ctx, resourceId, domain.CommentLikeScore)
+		if err != nil {
+			return nil, false, domain.ErrInternalServerError
+		}
+	}
+	return like, true, nil
+}
+
+func (lu *likeUsecase) findUserLike(ctx context.Context, resourceType string, resourceId string, userId string) (*domain.Like, error) {
+	filter := bson.M{"user_id": userId, "resource_id": resourceId, "resource_type": resourceType}
+	queryResult, err := lu.likeRepository.FindLikes(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
 	if len(*queryResult) == 0 {
-		return domain.ErrLikeNotFound
+		return nil, domain.ErrInternalServerError
+	}
+	like, err := lu.likeRepository.FindOneLike(ctx, fmt.Sprintf("%v", (*queryResult)[0]["_id"]))
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return like, nil
+}
+
+func (lu *likeUsecase) unreact(ctx context.Context, resourceType string, resourceId string, userId string) (bool, error) {
+	filter := bson.M{"user_id": userId, "resource_id": resourceId, "resource_type": resourceType}
+	queryResult, err := lu.likeRepository.FindLikes(ctx, filter)
+	if err != nil {
+		return false, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return false, nil
+	}
+	deletedCount, err := lu.likeRepository.DeleteLike(ctx, fmt.Sprintf("%v", (*queryResult)[0]["_id"]))
+	if err != nil {
+		return false, domain.ErrInternalServerError
+	}
+	if deletedCount == 0 {
+		return false, nil
 	}
 
-	lu.Lock()
-	like, err := lu.likeRepository.FindOneLike(likeId)
-	lu.Unlock()
+	if resourceType == domain.LikeResourceComment {
+		err = lu.commentRepository.IncrementCommentScore(ctx, resourceId, -domain.CommentLikeScore)
+		if err != nil {
+			return false, domain.ErrInternalServerError
+		}
+	}
+	return true, nil
+}
 
+func (lu *likeUsecase) findLikeResource(ctx context.Context, resourceType string, resourceId string, userId string) error {
+	postId := resourceId
+	if resourceType == domain.LikeResourceComment {
+		filter := bson.M{"_id": resourceId, "deleted_at": nil}
+		queryResult, err := lu.commentRepository.FindComments(ctx, filter)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		if len(*queryResult) == 0 {
+			return domain.ErrCommentNotFound
+		}
+		postId = fmt.Sprintf("%v", (*queryResult)[0]["post_id"])
+	}
+	filter := domain.VisiblePostFilter(bson.M{"_id": postId}, userId)
+	queryResult, err := lu.postRepository.FindPosts(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	if like.UserId != userId {
-		return domain.ErrUnauthorizedLikeDelete
+	if len(*queryResult) == 0 {
+		if resourceType == domain.LikeResourceComment {
+			return domain.ErrCommentNotFound
+		}
+		return domain.ErrPostNotFound
 	}
+	return nil
+}
 
-	lu.Lock()
-	err = lu.likeRepository.DeleteLike(likeId)
-	lu.Unlock()
+func (lu *likeUsecase) findCommentOnPost(ctx context.Context, postId string, commentId string) error {
+	filter := bson.M{"_id": commentId, "post_id": postId}
+	queryResult, err := lu.commentRepository.FindComments(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
+	if len(*queryResult) == 0 {
+		return domain.ErrCommentNotOnPost
+	}
 	return nil
 }
+
+func (lu *likeUsecase) isReaction(reaction string) bool {
+	for _, availableReaction := range lu.reactions {
+		if availableReaction == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+func (lu *likeUsecase) FindPostLikes(ctx context.Context, postId string, page int, limit int, tokenString string) (*[]domain.Liker, error) {
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := domain.VisiblePostFilter(bson.M{"_id": postId}, viewerId)
+	queryResult, err := lu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrPostNotFound
+	}
+	post := (*queryResult)[0]
+	if hideLikeCount, _ := post["hide_like_count"].(bool); hideLikeCount && post["user_id"] != viewerId {
+		return nil, domain.ErrHiddenLikes
+	}
+	return lu.findLikers(ctx, domain.LikeResourcePost, postId, skip, pageLimit)
+}
+
+func (lu *likeUsecase) FindCommentLikes(ctx context.Context, postId string, commentId string, page int, limit int, tokenString string) (*[]domain.Liker, error) {
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := lu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := domain.VisiblePostFilter(bson.M{"_id": postId}, viewerId)
+	queryResult, err := lu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrPostNotFound
+	}
+	filter = bson.M{"_id": commentId, "post_id": postId, "deleted_at": nil}
+	if (*queryResult)[0]["user_id"] != viewerId {
+		filter["$or"] = bson.A{bson.M{"hidden": bson.M{"$ne": true}}, bson.M{"user_id": viewerId}}
+	}
+	queryResult, err = lu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrCommentNotFound
+	}
+	return lu.findLikers(ctx, domain.LikeResourceComment, commentId, skip, pageLimit)
+}
+
+func (lu *likeUsecase) findLikers(ctx context.Context, resourceType string, resourceId string, skip int64, limit int64) (*[]domain.Liker, error) {
+	filter := bson.M{"resource_id": resourceId, "resource_type": resourceType}
+	queryResult, err := lu.likeRepository.FindPaginatedLikes(ctx, filter, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	likers := []domain.Liker{}
+	if len(*queryResult) == 0 {
+		return &likers, nil
+	}
+	userIds := []string{}
+	for _, v := range *queryResult {
+		userIds = append(userIds, fmt.Sprintf("%v", v["user_id"]))
+	}
+	users, err := lu.findUsersById(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range *queryResult {
+		user, ok := users[fmt.Sprintf("%v", v["user_id"])]
+		if !ok {
+			continue
+		}
+		likers = append(likers, *domain.NewLiker(user, domain.LikeReaction(v)))
+	}
+	return &likers, nil
+}
+
+func (lu *likeUsecase) findUsersById(ctx context.Context, userIds []string) (map[string]*domain.User, error) {
+	queryResult, err := lu.userRepository.FindUser(ctx, bson.M{"_id": bson.M{"$in": userIds}})
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	users := make(map[string]*domain.User)
+	for _, v := range *queryResult {
+		userBytes, err := bson.Marshal(v)
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		var user domain.User
+		err = bson.Unmarshal(userBytes, &user)
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		users[user.Id] = &user
+	}
+	return users, nil
+}
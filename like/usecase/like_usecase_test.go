@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"context"
 	"errors"
 	"instagram-go/domain"
 	"instagram-go/domain/mocks"
@@ -25,6 +26,7 @@ type LikeUsecaseSuite struct {
 	postRepository    *mocks.PostRepository
 	likeRepository    *mocks.LikeRepository
 	commentRepository *mocks.CommentRepository
+	userRepository    *mocks.UserRepository
 }
 
 func (lu *LikeUsecaseSuite) SetupTest() {
@@ -32,13 +34,14 @@ func (lu *LikeUsecaseSuite) SetupTest() {
 	lu.postRepository = new(mocks.PostRepository)
 	lu.likeRepository = new(mocks.LikeRepository)
 	lu.commentRepository = new(mocks.CommentRepository)
+	lu.userRepository = new(mocks.UserRepository)
 }
 
 func (lu *LikeUsecaseSuite) TestInsertPostLikeGetUserIdFromTokenError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertPostLike("postid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -47,10 +50,10 @@ func (lu *LikeUsecaseSuite) TestInsertPostLikeGetUserIdFromTokenError() {
 
 func (lu *LikeUsecaseSuite) TestInsertPostLikeFindPostsError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(nil, errors.New(""))
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New(""))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertPostLike("postid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -58,10 +61,10 @@ func (lu *LikeUsecaseSuite) TestInsertPostLikeFindPostsError() {
 
 func (lu *LikeUsecaseSuite) TestInsertPostLikePostNotFound() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertPostLike("postid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrPostNotFound.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -69,59 +72,66 @@ func (lu *LikeUsecaseSuite) TestInsertPostLikePostNotFound() {
 
 func (lu *LikeUsecaseSuite) TestInsertPostLikeFindLikesError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
-		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
-	}, nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(domain.ErrPostLikeConflict)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertPostLike("postid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
 func (lu *LikeUsecaseSuite) TestInsertPostLikePostLikeFound() {
+	existingLike := domain.NewLike("likeid1", "userid1", "postid1", domain.LikeResourcePost)
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
-		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
-	}, nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(domain.ErrPostLikeConflict)
+	lu.likeRepository.On("FindLikes", mock.Anything, bson.M{"user_id": "userid1", "resource_id": "postid1", "resource_type": domain.LikeResourcePost}).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
 	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, "likeid1").Return(existingLike, nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertPostLike("postid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	like, inserted, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
 
-	expectedError := domain.ErrPostLikeConflict.Error()
-	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	assert.Falsef(lu.T(), inserted, "Should have returned the existing like but inserted one")
+	assert.Equalf(lu.T(), existingLike, like, "Should have return the existing like %v but got %v", existingLike, like)
+	lu.likeRepository.AssertNotCalled(lu.T(), "UpdateLikeReaction", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (lu *LikeUsecaseSuite) TestInsertPostLikeInsertLikeError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
-	lu.likeRepository.On("InsertLike", mock.AnythingOfType("*domain.Like")).Return(errors.New("InsertLike return error"))
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(errors.New("InsertLike return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertPostLike("postid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+}
+
+func (lu *LikeUsecaseSuite) TestInsertPostLikeInsertLikeConflict() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(domain.ErrPostLikeConflict)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, "likeid1").Return(nil, errors.New("FindOneLike return error"))
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -129,38 +139,40 @@ func (lu *LikeUsecaseSuite) TestInsertPostLikeInsertLikeError() {
 
 func (lu *LikeUsecaseSuite) TestInsertPostLikeInsertLikeSuccessful() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.postRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
-	lu.likeRepository.On("InsertLike", mock.AnythingOfType("*domain.Like")).Return(nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertPostLike("postid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	like, inserted, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
 
 	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	assert.Truef(lu.T(), inserted, "Should have inserted a new like but didn't")
+	assert.Equalf(lu.T(), domain.ReactionHeart, like.Reaction, "Should have return a %s like but got %s", domain.ReactionHeart, like.Reaction)
+	assert.Equalf(lu.T(), "postid1", like.ResourceId, "Should have return a like of %s but got %s", "postid1", like.ResourceId)
 }
 
 func (lu *LikeUsecaseSuite) TestDeletePostLikeGetUserIdFromTokenError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeletePostLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 func (lu *LikeUsecaseSuite) TestDeletePostLikeFindLikesError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeletePostLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -168,10 +180,10 @@ func (lu *LikeUsecaseSuite) TestDeletePostLikeFindLikesError() {
 
 func (lu *LikeUsecaseSuite) TestDeletePostLikeLikeNotFound() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeletePostLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrLikeNotFound.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -179,29 +191,79 @@ func (lu *LikeUsecaseSuite) TestDeletePostLikeLikeNotFound() {
 
 func (lu *LikeUsecaseSuite) TestDeletePostLikeFindOneLikeError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
 	}, nil)
-	lu.likeRepository.On("FindOneLike", mock.AnythingOfType("string")).Return(nil, errors.New("FindOneLike return error"))
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("FindOneLike return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeletePostLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
+func (lu *LikeUsecaseSuite) TestDeletePostLikeLikeOfAnotherPost() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid2", "resource_type": "post"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
+		"likeid1", "userid1", "postid2", "post",
+	), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
+
+	expectedError := domain.ErrLikeNotOnPost.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+	lu.likeRepository.AssertNotCalled(lu.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestDeletePostLikeLikeOfAComment() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "comment"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
+		"likeid1", "userid1", "postid1", "comment",
+	), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
+
+	expectedError := domain.ErrLikeNotOnPost.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+	lu.likeRepository.AssertNotCalled(lu.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
 func (lu *LikeUsecaseSuite) TestDeletePostLikeUnauthorizedLikeDelete() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid2", "resource_id": "postid1", "resource_type": "post"},
 	}, nil)
-	lu.likeRepository.On("FindOneLike", mock.AnythingOfType("string")).Return(domain.NewLike(
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
 		"likeid1", "userid2", "postid1", "post",
 	), nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeletePostLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedLikeDelete.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+}
+
+func (lu *LikeUsecaseSuite) TestDeletePostLikeUnauthorizedLikeDeleteOfAnotherPost() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid2", "resource_id": "postid2", "resource_type": "post"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
+		"likeid1", "userid2", "postid2", "post",
+	), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrUnauthorizedLikeDelete.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -209,16 +271,16 @@ func (lu *LikeUsecaseSuite) TestDeletePostLikeUnauthorizedLikeDelete() {
 
 func (lu *LikeUsecaseSuite) TestDeletePostLikeDeleteLikeError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
 	}, nil)
-	lu.likeRepository.On("FindOneLike", mock.AnythingOfType("string")).Return(domain.NewLike(
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
 		"likeid1", "userid1", "postid1", "post",
 	), nil)
-	lu.likeRepository.On("DeleteLike", mock.AnythingOfType("string")).Return(errors.New("Delete like return error"))
+	lu.likeRepository.On("DeleteLike", mock.Anything, mock.AnythingOfType("string")).Return(int64(0), errors.New("Delete like return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeletePostLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -226,16 +288,16 @@ func (lu *LikeUsecaseSuite) TestDeletePostLikeDeleteLikeError() {
 
 func (lu *LikeUsecaseSuite) TestDeletePostLikeDeleteLikeSuccessful() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
 	}, nil)
-	lu.likeRepository.On("FindOneLike", mock.AnythingOfType("string")).Return(domain.NewLike(
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
 		"likeid1", "userid1", "postid1", "post",
 	), nil)
-	lu.likeRepository.On("DeleteLike", mock.AnythingOfType("string")).Return(nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, mock.AnythingOfType("string")).Return(int64(1), nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeletePostLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeletePostLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	assert.NoErrorf(lu.T(), err, "should have not return error but got %s", err)
 }
@@ -243,8 +305,8 @@ func (lu *LikeUsecaseSuite) TestDeletePostLikeDeleteLikeSuccessful() {
 func (lu *LikeUsecaseSuite) TestInsertCommentLikeGetUserIdFromTokenError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertCommentLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -252,10 +314,10 @@ func (lu *LikeUsecaseSuite) TestInsertCommentLikeGetUserIdFromTokenError() {
 
 func (lu *LikeUsecaseSuite) TestInsertCommentLikeFindCommentError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(nil, errors.New("FindComments return error"))
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindComments return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertCommentLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -263,10 +325,10 @@ func (lu *LikeUsecaseSuite) TestInsertCommentLikeFindCommentError() {
 
 func (lu *LikeUsecaseSuite) TestInsertCommentLikeCommentNotFound() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertCommentLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrCommentNotFound.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -274,47 +336,51 @@ func (lu *LikeUsecaseSuite) TestInsertCommentLikeCommentNotFound() {
 
 func (lu *LikeUsecaseSuite) TestInsertCommentLikeFindLikesError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
-		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
-			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
-	}, nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(domain.ErrCommentLikeConflict)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertCommentLike(context.TODO(), "postid1", "commentid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
 func (lu *LikeUsecaseSuite) TestInsertCommentLikeCommentLikeFound() {
+	existingLike := domain.NewLike("likeid1", "userid1", "commentid1", domain.LikeResourceComment)
+	existingLike.Reaction = "wow"
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
-		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
-			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
-	}, nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
-		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment"},
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(domain.ErrCommentLikeConflict)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment", "reaction": "wow"},
 	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, "likeid1").Return(existingLike, nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	like, inserted, err := likeUsecase.InsertCommentLike(context.TODO(), "postid1", "commentid1", "token1")
 
-	expectedError := domain.ErrCommentLikeConflict.Error()
-	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	assert.Falsef(lu.T(), inserted, "Should have returned the existing like but inserted one")
+	assert.Equalf(lu.T(), "wow", like.Reaction, "Should have kept the %s reaction but got %s", "wow", like.Reaction)
+	lu.likeRepository.AssertNotCalled(lu.T(), "UpdateLikeReaction", mock.Anything, mock.Anything, mock.Anything)
+	lu.commentRepository.AssertNotCalled(lu.T(), "IncrementCommentScore", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (lu *LikeUsecaseSuite) TestInsertCommentLikeInsertLikeError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
-	lu.likeRepository.On("InsertLike", mock.AnythingOfType("*domain.Like")).Return(errors.New("InsertLike return error"))
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(errors.New("InsertLike return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertCommentLike(context.TODO(), "postid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -322,24 +388,26 @@ func (lu *LikeUsecaseSuite) TestInsertCommentLikeInsertLikeError() {
 
 func (lu *LikeUsecaseSuite) TestInsertCommentLikeInsertLikeSuccessful() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.commentRepository.On("FindComments", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid1", "comment": "comment1",
 			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
-	lu.likeRepository.On("InsertLike", mock.AnythingOfType("*domain.Like")).Return(nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(nil)
+	lu.commentRepository.On("IncrementCommentScore", mock.Anything, "commentid1", domain.CommentLikeScore).Return(nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.InsertCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertCommentLike(context.TODO(), "postid1", "commentid1", "token1")
 
 	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.commentRepository.AssertCalled(lu.T(), "IncrementCommentScore", mock.Anything, "commentid1", domain.CommentLikeScore)
 }
 
 func (lu *LikeUsecaseSuite) TestDeleteCommentLikeGetUserIdFromTokenError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeleteCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -347,10 +415,10 @@ func (lu *LikeUsecaseSuite) TestDeleteCommentLikeGetUserIdFromTokenError() {
 
 func (lu *LikeUsecaseSuite) TestDeleteCommentLikeFindLikesError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeleteCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -358,10 +426,10 @@ func (lu *LikeUsecaseSuite) TestDeleteCommentLikeFindLikesError() {
 
 func (lu *LikeUsecaseSuite) TestDeleteCommentLikeLikeNotFound() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeleteCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
 
 	expectedError := domain.ErrLikeNotFound.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -369,46 +437,115 @@ func (lu *LikeUsecaseSuite) TestDeleteCommentLikeLikeNotFound() {
 
 func (lu *LikeUsecaseSuite) TestDeleteCommentLikeFindOneLikeError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment"},
 	}, nil)
-	lu.likeRepository.On("FindOneLike", mock.AnythingOfType("string")).Return(nil, errors.New("FindOneLike return error"))
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("FindOneLike return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeleteCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
+func (lu *LikeUsecaseSuite) TestDeleteCommentLikeLikeOfAnotherComment() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid2", "resource_type": "comment"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
+		"likeid1", "userid1", "commentid2", "comment",
+	), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
+
+	expectedError := domain.ErrLikeNotOnComment.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+	lu.likeRepository.AssertNotCalled(lu.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestDeleteCommentLikeLikeOfAPost() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "post"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
+		"likeid1", "userid1", "commentid1", "post",
+	), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
+
+	expectedError := domain.ErrLikeNotOnComment.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+	lu.likeRepository.AssertNotCalled(lu.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
 func (lu *LikeUsecaseSuite) TestDeleteCommentLikeUnauthorizedLikeDelete() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid2", "resource_id": "commentid1", "resource_type": "comment"},
 	}, nil)
-	lu.likeRepository.On("FindOneLike", mock.AnythingOfType("string")).Return(domain.NewLike(
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
 		"likeid1", "userid2", "commentid1", "comment",
 	), nil)
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeleteCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedLikeDelete.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+}
+
+func (lu *LikeUsecaseSuite) TestDeleteCommentLikeUnauthorizedLikeDeleteOfAnotherComment() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid2", "resource_id": "commentid2", "resource_type": "comment"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
+		"likeid1", "userid2", "commentid2", "comment",
+	), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
 
 	expectedError := domain.ErrUnauthorizedLikeDelete.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
+func (lu *LikeUsecaseSuite) TestDeleteCommentLikeCommentOfAnotherPost() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
+		"likeid1", "userid1", "commentid1", "comment",
+	), nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid2"}).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid2", "commentid1", "likeid1", "token1")
+
+	expectedError := domain.ErrCommentNotOnPost.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+	lu.likeRepository.AssertNotCalled(lu.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
 func (lu *LikeUsecaseSuite) TestDeleteCommentLikeDeleteLikeError() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment"},
 	}, nil)
-	lu.likeRepository.On("FindOneLike", mock.AnythingOfType("string")).Return(domain.NewLike(
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
 		"likeid1", "userid1", "commentid1", "comment",
 	), nil)
-	lu.likeRepository.On("DeleteLike", mock.AnythingOfType("string")).Return(errors.New("DeleteLike return error"))
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1"}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, mock.AnythingOfType("string")).Return(int64(0), errors.New("DeleteLike return error"))
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeleteCommentLike("likeid1", "token1")
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -416,16 +553,429 @@ func (lu *LikeUsecaseSuite) TestDeleteCommentLikeDeleteLikeError() {
 
 func (lu *LikeUsecaseSuite) TestDeleteCommentLikeDeleteLikeSuccessful() {
 	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	lu.likeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
+		"likeid1", "userid1", "commentid1", "comment",
+	), nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1"}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, mock.AnythingOfType("string")).Return(int64(1), nil)
+	lu.commentRepository.On("IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentLikeScore).Return(nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.commentRepository.AssertCalled(lu.T(), "IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentLikeScore)
+}
+
+func (lu *LikeUsecaseSuite) TestDeleteCommentLikeDeletedConcurrently() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment"},
 	}, nil)
-	lu.likeRepository.On("FindOneLike", mock.AnythingOfType("string")).Return(domain.NewLike(
+	lu.likeRepository.On("FindOneLike", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewLike(
 		"likeid1", "userid1", "commentid1", "comment",
 	), nil)
-	lu.likeRepository.On("DeleteLike", mock.AnythingOfType("string")).Return(nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1"}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, "likeid1").Return(int64(0), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteCommentLike(context.TODO(), "postid1", "commentid1", "likeid1", "token1")
+
+	expectedError := domain.ErrLikeNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	lu.commentRepository.AssertNotCalled(lu.T(), "IncrementCommentScore", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestUnlikePostPostNotFound() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.UnlikePost(context.TODO(), "postid1", "token1")
 
-	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.headerHelper)
-	err := likeUsecase.DeleteCommentLike("likeid1", "token1")
+	expectedError := domain.ErrPostNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+}
+
+func (lu *LikeUsecaseSuite) TestUnlikePostLikeNotFound() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.UnlikePost(context.TODO(), "postid1", "token1")
 
 	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.likeRepository.AssertNotCalled(lu.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestUnlikePostDeleteLikeError() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
+	}, nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, "likeid1").Return(int64(0), errors.New("DeleteLike return error"))
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.UnlikePost(context.TODO(), "postid1", "token1")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
+}
+
+func (lu *LikeUsecaseSuite) TestUnlikePostSuccessful() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, bson.M{"user_id": "userid1", "resource_id": "postid1", "resource_type": domain.LikeResourcePost}).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
+	}, nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, "likeid1").Return(int64(1), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.UnlikePost(context.TODO(), "postid1", "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.likeRepository.AssertCalled(lu.T(), "DeleteLike", mock.Anything, "likeid1")
+}
+
+func (lu *LikeUsecaseSuite) TestInsertCommentLikeCommentOfAnotherPost() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid2"}).Return(&[]bson.M{}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, _, err := likeUsecase.InsertCommentLike(context.TODO(), "postid2", "commentid1", "token1")
+
+	expectedError := domain.ErrCommentNotOnPost.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	lu.likeRepository.AssertNotCalled(lu.T(), "InsertLike", mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestUnlikeCommentCommentOfAnotherPost() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid2"}).Return(&[]bson.M{}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.UnlikeComment(context.TODO(), "postid2", "commentid1", "token1")
+
+	expectedError := domain.ErrCommentNotOnPost.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	lu.likeRepository.AssertNotCalled(lu.T(), "DeleteLike", mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestUnlikeCommentSuccessful() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, bson.M{"user_id": "userid1", "resource_id": "commentid1", "resource_type": domain.LikeResourceComment}).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment"},
+	}, nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, "likeid1").Return(int64(1), nil)
+	lu.commentRepository.On("IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentLikeScore).Return(nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.UnlikeComment(context.TODO(), "postid1", "commentid1", "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.commentRepository.AssertCalled(lu.T(), "IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentLikeScore)
+}
+
+func (lu *LikeUsecaseSuite) TestInsertPostLikeKeepsExistingReaction() {
+	existingLike := domain.NewLike("likeid1", "userid1", "postid1", domain.LikeResourcePost)
+	existingLike.Reaction = "laugh"
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(domain.ErrPostLikeConflict)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post", "reaction": "laugh"},
+	}, nil)
+	lu.likeRepository.On("FindOneLike", mock.Anything, "likeid1").Return(existingLike, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	like, inserted, err := likeUsecase.InsertPostLike(context.TODO(), "postid1", "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	assert.Falsef(lu.T(), inserted, "Should have returned the existing like but inserted one")
+	assert.Equalf(lu.T(), "laugh", like.Reaction, "Should have kept the %s reaction but got %s", "laugh", like.Reaction)
+	lu.likeRepository.AssertNotCalled(lu.T(), "UpdateLikeReaction", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestPutReactionInvalidReaction() {
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.PutReaction(context.TODO(), "postid1", domain.LikeResourcePost, "postid1", "thumbsdown", "token1")
+
+	expectedError := domain.ErrInvalidReaction.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (lu *LikeUsecaseSuite) TestPutReactionPostNotFound() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, domain.VisiblePostFilter(bson.M{"_id": "postid1"}, "userid1")).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.PutReaction(context.TODO(), "postid1", domain.LikeResourcePost, "postid1", "laugh", "token1")
+
+	expectedError := domain.ErrPostNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (lu *LikeUsecaseSuite) TestPutReactionCommentNotFound() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.PutReaction(context.TODO(), "postid1", domain.LikeResourceComment, "commentid1", "laugh", "token1")
+
+	expectedError := domain.ErrCommentNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (lu *LikeUsecaseSuite) TestPutReactionCommentOfHiddenPost() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, domain.VisiblePostFilter(bson.M{"_id": "postid1"}, "userid1")).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.PutReaction(context.TODO(), "postid1", domain.LikeResourceComment, "commentid1", "laugh", "token1")
+
+	expectedError := domain.ErrCommentNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	lu.likeRepository.AssertNotCalled(lu.T(), "InsertLike", mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestPutReactionInsertCommentReactionSuccessful() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1"}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.MatchedBy(func(like *domain.Like) bool {
+		return like.Reaction == "fire" && like.ResourceId == "commentid1" && like.ResourceType == domain.LikeResourceComment
+	})).Return(nil)
+	lu.commentRepository.On("IncrementCommentScore", mock.Anything, "commentid1", domain.CommentLikeScore).Return(nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.PutReaction(context.TODO(), "postid1", domain.LikeResourceComment, "commentid1", "fire", "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.commentRepository.AssertCalled(lu.T(), "IncrementCommentScore", mock.Anything, "commentid1", domain.CommentLikeScore)
+}
+
+func (lu *LikeUsecaseSuite) TestPutReactionSwitchReactionSuccessful() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1"}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment"},
+	}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(domain.ErrCommentLikeConflict)
+	lu.likeRepository.On("FindOneLike", mock.Anything, "likeid1").Return(domain.NewLike("likeid1", "userid1", "commentid1", domain.LikeResourceComment), nil)
+	lu.likeRepository.On("UpdateLikeReaction", mock.Anything, "likeid1", "sad").Return(nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.PutReaction(context.TODO(), "postid1", domain.LikeResourceComment, "commentid1", "sad", "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.likeRepository.AssertCalled(lu.T(), "UpdateLikeReaction", mock.Anything, "likeid1", "sad")
+	lu.commentRepository.AssertNotCalled(lu.T(), "IncrementCommentScore", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestPutReactionSameReactionSuccessful() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post", "reaction": "wow"},
+	}, nil)
+	lu.likeRepository.On("InsertLike", mock.Anything, mock.AnythingOfType("*domain.Like")).Return(domain.ErrPostLikeConflict)
+	existingLike := domain.NewLike("likeid1", "userid1", "postid1", domain.LikeResourcePost)
+	existingLike.Reaction = "wow"
+	lu.likeRepository.On("FindOneLike", mock.Anything, "likeid1").Return(existingLike, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.PutReaction(context.TODO(), "postid1", domain.LikeResourcePost, "postid1", "wow", "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.likeRepository.AssertNotCalled(lu.T(), "UpdateLikeReaction", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestPutReactionCommentOfAnotherPost() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid2"}).Return(&[]bson.M{}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.PutReaction(context.TODO(), "postid2", domain.LikeResourceComment, "commentid1", "fire", "token1")
+
+	expectedError := domain.ErrCommentNotOnPost.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	lu.likeRepository.AssertNotCalled(lu.T(), "InsertLike", mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestDeleteReactionReactionNotFound() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteReaction(context.TODO(), "postid1", domain.LikeResourcePost, "postid1", "token1")
+
+	expectedError := domain.ErrReactionNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (lu *LikeUsecaseSuite) TestDeleteReactionCommentSuccessful() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment", "reaction": "angry"},
+	}, nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, "likeid1").Return(int64(1), nil)
+	lu.commentRepository.On("IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentLikeScore).Return(nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteReaction(context.TODO(), "postid1", domain.LikeResourceComment, "commentid1", "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	lu.likeRepository.AssertCalled(lu.T(), "DeleteLike", mock.Anything, "likeid1")
+	lu.commentRepository.AssertCalled(lu.T(), "IncrementCommentScore", mock.Anything, "commentid1", -domain.CommentLikeScore)
+}
+
+func (lu *LikeUsecaseSuite) TestFindPostLikesInvalidPagination() {
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, err := likeUsecase.FindPostLikes(context.TODO(), "postid1", 0, 20, "token1")
+
+	expectedError := domain.ErrInvalidPagination.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (lu *LikeUsecaseSuite) TestFindPostLikesPostNotFound() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, err := likeUsecase.FindPostLikes(context.TODO(), "postid1", 1, 20, "token1")
+
+	expectedError := domain.ErrPostNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (lu *LikeUsecaseSuite) TestFindPostLikesHiddenLikes() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid2", "hide_like_count": true}}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, err := likeUsecase.FindPostLikes(context.TODO(), "postid1", 1, 20, "token1")
+
+	expectedError := domain.ErrHiddenLikes.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	lu.likeRepository.AssertNotCalled(lu.T(), "FindPaginatedLikes", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (lu *LikeUsecaseSuite) TestFindPostLikesFindPaginatedLikesError() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid2"}}, nil)
+	lu.likeRepository.On("FindPaginatedLikes", mock.Anything, mock.Anything, int64(0), int64(20)).Return(nil, errors.New("FindPaginatedLikes return error"))
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, err := likeUsecase.FindPostLikes(context.TODO(), "postid1", 1, 20, "token1")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (lu *LikeUsecaseSuite) TestFindPostLikesHiddenLikesOwnerSuccessful() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid1", "hide_like_count": true}}, nil)
+	lu.likeRepository.On("FindPaginatedLikes", mock.Anything, bson.M{"resource_id": "postid1", "resource_type": domain.LikeResourcePost}, int64(20), int64(20)).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid2", "resource_id": "postid1", "resource_type": "post", "reaction": "laugh"},
+		{"_id": "likeid2", "user_id": "userid3", "resource_id": "postid1", "resource_type": "post"},
+	}, nil)
+	lu.userRepository.On("FindUser", mock.Anything, bson.M{"_id": bson.M{"$in": []string{"userid2", "userid3"}}}).Return(&[]bson.M{
+		{"_id": "userid3", "username": "username3", "full_name": "fullname3"},
+		{"_id": "userid2", "username": "username2", "full_name": "fullname2"},
+	}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	likers, err := likeUsecase.FindPostLikes(context.TODO(), "postid1", 2, 20, "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	expectedLikers := []domain.Liker{
+		*domain.NewLiker(domain.NewUser("userid2", "username2", "fullname2", "", "", nil), "laugh"),
+		*domain.NewLiker(domain.NewUser("userid3", "username3", "fullname3", "", "", nil), domain.ReactionHeart),
+	}
+	assert.Equalf(lu.T(), expectedLikers, *likers, "Should have return likers %v but got %v", expectedLikers, *likers)
+}
+
+func (lu *LikeUsecaseSuite) TestFindPostLikesSkipsMissingUser() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid1"}}, nil)
+	lu.likeRepository.On("FindPaginatedLikes", mock.Anything, mock.Anything, int64(0), int64(20)).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid2", "resource_id": "postid1", "resource_type": "post"},
+		{"_id": "likeid2", "user_id": "deleteduserid", "resource_id": "postid1", "resource_type": "post"},
+	}, nil)
+	lu.userRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "userid2", "username": "username2", "full_name": "fullname2"},
+	}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	likers, err := likeUsecase.FindPostLikes(context.TODO(), "postid1", 1, 20, "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	expectedLikers := []domain.Liker{
+		*domain.NewLiker(domain.NewUser("userid2", "username2", "fullname2", "", "", nil), domain.ReactionHeart),
+	}
+	assert.Equalf(lu.T(), expectedLikers, *likers, "Should have return likers %v but got %v", expectedLikers, *likers)
+	lu.userRepository.AssertNumberOfCalls(lu.T(), "FindUser", 1)
+}
+
+func (lu *LikeUsecaseSuite) TestFindCommentLikesCommentNotFound() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid2"}}, nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1", "deleted_at": nil,
+		"$or": bson.A{bson.M{"hidden": bson.M{"$ne": true}}, bson.M{"user_id": "userid1"}}}).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	_, err := likeUsecase.FindCommentLikes(context.TODO(), "postid1", "commentid1", 1, 20, "token1")
+
+	expectedError := domain.ErrCommentNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (lu *LikeUsecaseSuite) TestFindCommentLikesSuccessful() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1", "user_id": "userid1", "hide_like_count": true}}, nil)
+	lu.commentRepository.On("FindComments", mock.Anything, bson.M{"_id": "commentid1", "post_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "commentid1"}}, nil)
+	lu.likeRepository.On("FindPaginatedLikes", mock.Anything, bson.M{"resource_id": "commentid1", "resource_type": domain.LikeResourceComment}, int64(0), int64(20)).Return(&[]bson.M{}, nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	likers, err := likeUsecase.FindCommentLikes(context.TODO(), "postid1", "commentid1", 1, 20, "token1")
+
+	assert.NoErrorf(lu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(lu.T(), []domain.Liker{}, *likers, "Should have return no likers but got %v", *likers)
+}
+
+func (lu *LikeUsecaseSuite) TestDeleteReactionDeletedConcurrently() {
+	lu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	lu.commentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "commentid1", "post_id": "postid1"}}, nil)
+	lu.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	lu.likeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "commentid1", "resource_type": "comment", "reaction": "angry"},
+	}, nil)
+	lu.likeRepository.On("DeleteLike", mock.Anything, "likeid1").Return(int64(0), nil)
+
+	likeUsecase := usecase.NewLikeUsecase(lu.likeRepository, lu.postRepository, lu.commentRepository, lu.userRepository, domain.DefaultReactions, lu.headerHelper)
+	err := likeUsecase.DeleteReaction(context.TODO(), "postid1", domain.LikeResourceComment, "commentid1", "token1")
+
+	expectedError := domain.ErrReactionNotFound.Error()
+	assert.EqualErrorf(lu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	lu.commentRepository.AssertNotCalled(lu.T(), "IncrementCommentScore", mock.Anything, mock.Anything, mock.Anything)
 }
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"encoding/json"
+	"instagram-go/domain"
+	"net/http"
+	"strings"
+)
+
+type MentionHandler struct {
+	mentionUsecase domain.MentionUsecase
+}
+
+func NewMentionHandler(mentionUsecase domain.MentionUsecase) domain.MentionHandler {
+	return &MentionHandler{
+		mentionUsecase: mentionUsecase,
+	}
+}
+
+func (mh *MentionHandler) UserMentions(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		mh.getUserMentions(w, r)
+		return
+	}
+}
+
+func (mh *MentionHandler) getUserMentions(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(mentionGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	tokenString := r.Header.Get("Authorization")
+	mentions, err := mh.mentionUsecase.FindUserMentions(r.Context(), userId, page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(mentionGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataMentions := domain.NewDataMentions(*mentions)
+	response := domain.NewDataResponseMentions(*dataMentions)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func mentionGetStatusCode(err error) int {
+	switch err {
+	case domain.ErrInternalServerError:
+		return http.StatusInternalServerError
+	case domain.ErrUserNotFound:
+		return http.StatusNotFound
+	case domain.ErrInvalidPagination:
+		return http.StatusBadRequest
+	}
+	return http.StatusOK
+}
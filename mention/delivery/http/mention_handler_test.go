@@ -0,0 +1,78 @@
+package http_test
+
+import (
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	mentionHttp "instagram-go/mention/delivery/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMentionHandlerSuite(t *testing.T) {
+	suite.Run(t, new(MentionHandlerSuite))
+}
+
+type MentionHandlerSuite struct {
+	suite.Suite
+	mentionUsecase *mocks.MentionUsecase
+}
+
+func (mh *MentionHandlerSuite) SetupTest() {
+	mh.mentionUsecase = new(mocks.MentionUsecase)
+}
+
+func (mh *MentionHandlerSuite) TestGetUserMentionsUserNotFound() {
+	mh.mentionUsecase.On("FindUserMentions", mock.Anything, "userid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, domain.ErrUserNotFound)
+	mentionHandler := mentionHttp.NewMentionHandler(mh.mentionUsecase)
+	req, _ := http.NewRequest("GET", "/users/userid1/mentions", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(mentionHandler.UserMentions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(mh.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUserNotFound.Error() + `"}`
+	assert.Equalf(mh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (mh *MentionHandlerSuite) TestGetUserMentionsSuccessful() {
+	mh.mentionUsecase.On("FindUserMentions", mock.Anything, "userid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(&[]domain.UserMention{}, nil)
+	mentionHandler := mentionHttp.NewMentionHandler(mh.mentionUsecase)
+	req, _ := http.NewRequest("GET", "/users/userid1/mentions", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(mentionHandler.UserMentions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(mh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"mentions":[]}}`
+	assert.Equalf(mh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (mh *MentionHandlerSuite) TestGetUserMentionsInvalidPagination() {
+	mentionHandler := mentionHttp.NewMentionHandler(mh.mentionUsecase)
+	req, _ := http.NewRequest("GET", "/users/userid1/mentions?page=first", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(mentionHandler.UserMentions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(mh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPagination.Error() + `"}`
+	assert.Equalf(mh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	mh.mentionUsecase.AssertNotCalled(mh.T(), "FindUserMentions", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (mh *MentionHandlerSuite) TestGetUserMentionsPaginated() {
+	mh.mentionUsecase.On("FindUserMentions", mock.Anything, "userid1", 2, 5, mock.AnythingOfType("string")).Return(&[]domain.UserMention{}, nil)
+	mentionHandler := mentionHttp.NewMentionHandler(mh.mentionUsecase)
+	req, _ := http.NewRequest("GET", "/users/userid1/mentions?page=2&limit=5", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(mentionHandler.UserMentions)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(mh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	mh.mentionUsecase.AssertCalled(mh.T(), "FindUserMentions", mock.Anything, "userid1", 2, 5, mock.AnythingOfType("string"))
+}
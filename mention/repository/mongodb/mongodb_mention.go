@@ -0,0 +1,121 @@
+package mongodb
+
+import (
+	"context"
+	"instagram-go/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodbMentionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongodbMentionRepository(collection *mongo.Collection) domain.MentionRepository {
+	return &mongodbMentionRepository{
+		collection: collection,
+	}
+}
+
+func (mmr *mongodbMentionRepository) CreateIndexes(ctx context.Context) error {
+	userIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "created_date", Value: -1},
+		},
+	}
+	resourceIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "resource_id", Value: 1},
+			primitive.E{Key: "resource_type", Value: 1},
+		},
+	}
+	_, err := mmr.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{userIndex, resourceIndex})
+	return err
+}
+
+func (mmr *mongodbMentionRepository) InsertMentions(ctx context.Context, mentions []domain.UserMention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+	var newMentions []interface{}
+	for _, mention := range mentions {
+		newMention := bson.D{
+			primitive.E{Key: "_id", Value: mention.Id},
+			primitive.E{Key: "user_id", Value: mention.UserId},
+			primitive.E{Key: "author_id", Value: mention.AuthorId},
+			primitive.E{Key: "post_id", Value: mention.PostId},
+			primitive.E{Key: "resource_id", Value: mention.ResourceId},
+			primitive.E{Key: "resource_type", Value: mention.ResourceType},
+			primitive.E{Key: "created_date", Value: mention.CreatedDate},
+		}
+		newMentions = append(newMentions, newMention)
+	}
+	_, err := mmr.collection.InsertMany(ctx, newMentions)
+	return err
+}
+
+func (mmr *mongodbMentionRepository) FindMentions(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "created_date", Value: -1}})
+	cursor, err := mmr.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+// Every mention is checked against its post, and comment mentions against their comment too, before paging.
+func (mmr *mongodbMentionRepository) FindVisibleMentions(ctx context.Context, filter interface{}, postFilter interface{}, commentFilter interface{}, skip int64, limit int64) (*[]bson.M, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{primitive.E{Key: "$match", Value: filter}},
+		bson.D{primitive.E{Key: "$sort", Value: bson.D{primitive.E{Key: "created_date", Value: -1}}}},
+		bson.D{primitive.E{Key: "$lookup", Value: bson.M{
+			"from": "posts",
+			"let":  bson.M{"post_id": "$post_id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$_id", "$$post_id"}}}},
+				bson.M{"$match": postFilter},
+			},
+			"as": "post",
+		}}},
+		bson.D{primitive.E{Key: "$match", Value: bson.M{"post": bson.M{"$ne": bson.A{}}}}},
+		bson.D{primitive.E{Key: "$lookup", Value: bson.M{
+			"from": "comments",
+			"let":  bson.M{"resource_id": "$resource_id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$_id", "$$resource_id"}}}},
+				bson.M{"$match": commentFilter},
+			},
+			"as": "comment",
+		}}},
+		bson.D{primitive.E{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"resource_type": bson.M{"$ne": "comment"}},
+			bson.M{"comment": bson.M{"$ne": bson.A{}}},
+		}}}},
+		bson.D{primitive.E{Key: "$skip", Value: skip}},
+		bson.D{primitive.E{Key: "$limit", Value: limit}},
+		bson.D{primitive.E{Key: "$project", Value: bson.M{"post": 0, "comment": 0}}},
+	}
+	cursor, err := mmr.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (mmr *mongodbMentionRepository) DeleteMentions(ctx context.Context, resourceId string, resourceType string) error {
+	filter := bson.M{"resource_id": resourceId, "resource_type": resourceType}
+	_, err := mmr.collection.DeleteMany(ctx, filter)
+	return err
+}
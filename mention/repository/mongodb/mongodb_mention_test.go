@@ -0,0 +1,97 @@
+package mongodb_test
+
+import (
+	"context"
+	"instagram-go/domain"
+	"instagram-go/mention/repository/mongodb"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestMentionRepoSuite(t *testing.T) {
+	suite.Run(t, new(MentionRepoSuite))
+}
+
+type MentionRepoSuite struct {
+	suite.Suite
+	collection *mongo.Collection
+}
+
+func (mr *MentionRepoSuite) SetupSuite() {
+	client, _ := mongo.Connect(context.TODO(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	mr.collection = client.Database("instagram_test").Collection("mentions")
+}
+
+func (mr *MentionRepoSuite) AfterTest(suiteName, testName string) {
+	mr.collection.Drop(context.TODO())
+}
+
+func (mr *MentionRepoSuite) TestInsertAndFindMentionsSuccessful() {
+	mentionRepo := mongodb.NewMongodbMentionRepository(mr.collection)
+	_ = mentionRepo.CreateIndexes(context.TODO())
+	now := time.Now()
+	mentions := []domain.UserMention{
+		*domain.NewUserMention("mentionid1", "userid1", "userid2", "postid1", "postid1", "post", now.Add(-time.Hour)),
+		*domain.NewUserMention("mentionid2", "userid1", "userid2", "postid1", "commentid1", "comment", now),
+		*domain.NewUserMention("mentionid3", "userid3", "userid2", "postid1", "commentid1", "comment", now),
+	}
+
+	err := mentionRepo.InsertMentions(context.TODO(), mentions)
+	assert.NoErrorf(mr.T(), err, "Should have not return error but got %s", err)
+	queryResult, err := mentionRepo.FindMentions(context.TODO(), bson.M{"user_id": "userid1"})
+
+	assert.NoErrorf(mr.T(), err, "Should have not return error but got %s", err)
+	assert.Lenf(mr.T(), *queryResult, 2, "Should have return %d mentions but got %d", 2, len(*queryResult))
+	assert.Equalf(mr.T(), "mentionid2", (*queryResult)[0]["_id"], "Should have return newest mention %s first but got %s", "mentionid2", (*queryResult)[0]["_id"])
+}
+
+func (mr *MentionRepoSuite) TestFindVisibleMentionsSkipsHiddenResources() {
+	postsCollection := mr.collection.Database().Collection("posts")
+	commentsCollection := mr.collection.Database().Collection("comments")
+	defer postsCollection.Drop(context.TODO())
+	defer commentsCollection.Drop(context.TODO())
+	_, _ = postsCollection.InsertMany(context.TODO(), []interface{}{
+		bson.M{"_id": "postid1", "deleted_at": nil},
+		bson.M{"_id": "postid2", "deleted_at": time.Now()},
+	})
+	_, _ = commentsCollection.InsertMany(context.TODO(), []interface{}{
+		bson.M{"_id": "commentid1", "post_id": "postid1", "deleted_at": nil},
+		bson.M{"_id": "commentid2", "post_id": "postid1", "deleted_at": time.Now()},
+	})
+	mentionRepo := mongodb.NewMongodbMentionRepository(mr.collection)
+	now := time.Now()
+	mentions := []domain.UserMention{
+		*domain.NewUserMention("mentionid1", "userid1", "userid2", "postid1", "postid1", "post", now.Add(-3*time.Hour)),
+		*domain.NewUserMention("mentionid2", "userid1", "userid2", "postid2", "postid2", "post", now.Add(-2*time.Hour)),
+		*domain.NewUserMention("mentionid3", "userid1", "userid2", "postid1", "commentid1", "comment", now.Add(-time.Hour)),
+		*domain.NewUserMention("mentionid4", "userid1", "userid2", "postid1", "commentid2", "comment", now),
+	}
+	_ = mentionRepo.InsertMentions(context.TODO(), mentions)
+
+	queryResult, err := mentionRepo.FindVisibleMentions(context.TODO(), bson.M{"user_id": "userid1"}, bson.M{"deleted_at": nil}, bson.M{"deleted_at": nil}, 1, 10)
+
+	assert.NoErrorf(mr.T(), err, "Should have not return error but got %s", err)
+	assert.Lenf(mr.T(), *queryResult, 1, "Should have return %d mention but got %d", 1, len(*queryResult))
+	assert.Equalf(mr.T(), "mentionid1", (*queryResult)[0]["_id"], "Should have return %s but got %s", "mentionid1", (*queryResult)[0]["_id"])
+}
+
+func (mr *MentionRepoSuite) TestDeleteMentionsSuccessful() {
+	mentionRepo := mongodb.NewMongodbMentionRepository(mr.collection)
+	mentions := []domain.UserMention{
+		*domain.NewUserMention("mentionid1", "userid1", "userid2", "postid1", "postid1", "post", time.Now()),
+		*domain.NewUserMention("mentionid2", "userid1", "userid2", "postid1", "commentid1", "comment", time.Now()),
+	}
+	_ = mentionRepo.InsertMentions(context.TODO(), mentions)
+
+	err := mentionRepo.DeleteMentions(context.TODO(), "postid1", "post")
+
+	assert.NoErrorf(mr.T(), err, "Should have not return error but got %s", err)
+	queryResult, _ := mentionRepo.FindMentions(context.TODO(), bson.M{})
+	assert.Lenf(mr.T(), *queryResult, 1, "Should have left %d mention but got %d", 1, len(*queryResult))
+}
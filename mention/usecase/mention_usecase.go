@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"instagram-go/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type mentionUsecase struct {
+	mentionRepository domain.MentionRepository
+	userRepository    domain.UserRepository
+	headerHelper      domain.IHeaderHelper
+}
+
+func NewMentionUsecase(mentionRepository domain.MentionRepository, userRepository domain.UserRepository, headerHelper domain.IHeaderHelper) domain.MentionUsecase {
+	return &mentionUsecase{
+		mentionRepository: mentionRepository,
+		userRepository:    userRepository,
+		headerHelper:      headerHelper,
+	}
+}
+
+// Mentions in posts or comments the viewer can't see are left out, and come back when those are restored.
+func (mu *mentionUsecase) FindUserMentions(ctx context.Context, userId string, page int, limit int, tokenString string) (*[]domain.UserMention, error) {
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := mu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": userId}
+	queryResult, err := mu.userRepository.FindUser(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrUserNotFound
+	}
+
+	filter = bson.M{"user_id": userId}
+	postFilter := domain.VisiblePostFilter(bson.M{}, viewerId)
+	commentFilter := bson.M{"deleted_at": nil, "$or": bson.A{bson.M{"hidden": bson.M{"$ne": true}}, bson.M{"user_id": viewerId}}}
+	queryResult, err = mu.mentionRepository.FindVisibleMentions(ctx, filter, postFilter, commentFilter, skip, pageLimit)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	var mentions []domain.UserMention
+	for _, v := range *queryResult {
+		id := fmt.Sprintf("%v", v["_id"])
+		mentionedUserId := fmt.Sprintf("%v", v["user_id"])
+		authorId := fmt.Sprintf("%v", v["author_id"])
+		postId := fmt.Sprintf("%v", v["post_id"])
+		resourceId := fmt.Sprintf("%v", v["resource_id"])
+		resourceType := fmt.Sprintf("%v", v["resource_type"])
+		createdDate := v["created_date"].(primitive.DateTime).Time()
+		mention := domain.NewUserMention(id, mentionedUserId, authorId, postId, resourceId, resourceType, createdDate)
+		mentions = append(mentions, *mention)
+	}
+	return &mentions, nil
+}
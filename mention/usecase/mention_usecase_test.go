@@ -0,0 +1,95 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	"instagram-go/mention/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMentionUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(MentionUsecaseSuite))
+}
+
+type MentionUsecaseSuite struct {
+	suite.Suite
+	mentionRepository *mocks.MentionRepository
+	userRepository    *mocks.UserRepository
+	headerHelper      *mocks.IHeaderHelper
+}
+
+func (mu *MentionUsecaseSuite) SetupTest() {
+	mu.mentionRepository = new(mocks.MentionRepository)
+	mu.userRepository = new(mocks.UserRepository)
+	mu.headerHelper = new(mocks.IHeaderHelper)
+	mu.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+}
+
+func (mu *MentionUsecaseSuite) TestFindUserMentionsUserNotFound() {
+	mu.userRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	mentionUsecase := usecase.NewMentionUsecase(mu.mentionRepository, mu.userRepository, mu.headerHelper)
+	_, err := mentionUsecase.FindUserMentions(context.TODO(), "userid1", 1, 10, "token1")
+
+	expectedError := domain.ErrUserNotFound.Error()
+	assert.EqualErrorf(mu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (mu *MentionUsecaseSuite) TestFindUserMentionsFindVisibleMentionsError() {
+	mu.userRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+	mu.mentionRepository.On("FindVisibleMentions", mock.Anything, mock.AnythingOfType("M"), mock.AnythingOfType("M"), mock.AnythingOfType("M"), int64(0), int64(10)).Return(nil, errors.New("FindVisibleMentions return error"))
+
+	mentionUsecase := usecase.NewMentionUsecase(mu.mentionRepository, mu.userRepository, mu.headerHelper)
+	_, err := mentionUsecase.FindUserMentions(context.TODO(), "userid1", 1, 10, "token1")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(mu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (mu *MentionUsecaseSuite) TestFindUserMentionsSuccessful() {
+	mu.userRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+	expectedPostFilter := domain.VisiblePostFilter(bson.M{}, "userid2")
+	expectedCommentFilter := bson.M{"deleted_at": nil, "$or": bson.A{bson.M{"hidden": bson.M{"$ne": true}}, bson.M{"user_id": "userid2"}}}
+	mu.mentionRepository.On("FindVisibleMentions", mock.Anything, bson.M{"user_id": "userid1"}, expectedPostFilter, expectedCommentFilter, int64(0), int64(10)).Return(&[]bson.M{
+		{"_id": "mentionid1", "user_id": "userid1", "author_id": "userid2", "post_id": "postid1", "resource_id": "commentid1",
+			"resource_type": "comment", "created_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+
+	mentionUsecase := usecase.NewMentionUsecase(mu.mentionRepository, mu.userRepository, mu.headerHelper)
+	mentions, err := mentionUsecase.FindUserMentions(context.TODO(), "userid1", 1, 10, "token1")
+
+	assert.NoErrorf(mu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(mu.T(), 1, len(*mentions), "Should have return %d mention but got %d", 1, len(*mentions))
+	assert.Equalf(mu.T(), "commentid1", (*mentions)[0].ResourceId, "Should have return resource id %s but got %s", "commentid1", (*mentions)[0].ResourceId)
+	assert.Equalf(mu.T(), "comment", (*mentions)[0].ResourceType, "Should have return resource type %s but got %s", "comment", (*mentions)[0].ResourceType)
+}
+
+func (mu *MentionUsecaseSuite) TestFindUserMentionsInvalidPagination() {
+	mentionUsecase := usecase.NewMentionUsecase(mu.mentionRepository, mu.userRepository, mu.headerHelper)
+	_, err := mentionUsecase.FindUserMentions(context.TODO(), "userid1", 0, 10, "token1")
+
+	expectedError := domain.ErrInvalidPagination.Error()
+	assert.EqualErrorf(mu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	mu.mentionRepository.AssertNotCalled(mu.T(), "FindVisibleMentions", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (mu *MentionUsecaseSuite) TestFindUserMentionsPaginates() {
+	mu.userRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+	mu.mentionRepository.On("FindVisibleMentions", mock.Anything, mock.AnythingOfType("M"), mock.AnythingOfType("M"), mock.AnythingOfType("M"), int64(5), int64(5)).Return(&[]bson.M{}, nil)
+
+	mentionUsecase := usecase.NewMentionUsecase(mu.mentionRepository, mu.userRepository, mu.headerHelper)
+	mentions, err := mentionUsecase.FindUserMentions(context.TODO(), "userid1", 2, 5, "token1")
+
+	assert.NoErrorf(mu.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(mu.T(), 0, len(*mentions), "Should have return %d mentions but got %d", 0, len(*mentions))
+	mu.mentionRepository.AssertCalled(mu.T(), "FindVisibleMentions", mock.Anything, mock.AnythingOfType("M"), mock.AnythingOfType("M"), mock.AnythingOfType("M"), int64(5), int64(5))
+}
@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"instagram-go/domain"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type PostHandler struct {
@@ -40,6 +43,143 @@ func (ph *PostHandler) Post(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (ph *PostHandler) PostVisualMedias(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PATCH":
+		ph.patchPostVisualMedias(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) HashtagPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getHashtagPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) PostUserTags(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		ph.putPostUserTags(w, r)
+		return
+	case "DELETE":
+		ph.deletePostUserTag(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) UserTaggedPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getUserTaggedPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) LocationPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getLocationPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) NearbyPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getNearbyPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) PostStatus(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		ph.putPostStatus(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) PostArchive(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		ph.postPostArchive(w, r)
+		return
+	case "DELETE":
+		ph.deletePostArchive(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) UserArchivedPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getUserArchivedPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) PostRestore(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		ph.postPostRestore(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) UserDeletedPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getUserDeletedPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) SavedPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getSavedPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) CollectionPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getCollectionPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) PostPin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		ph.putPostPin(w, r)
+		return
+	case "DELETE":
+		ph.deletePostPin(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) UserPosts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		ph.getUserPosts(w, r)
+		return
+	}
+}
+
+func (ph *PostHandler) PostSettings(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		ph.putPostSettings(w, r)
+		return
+	}
+}
+
 func (ph *PostHandler) postPost(w http.ResponseWriter, r *http.Request) {
 	tokenString := r.Header.Get("Authorization")
 
@@ -73,10 +213,7 @@ func (ph *PostHandler) postPost(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		defer visualMedia.Close()
-		fileNameParts := strings.Split(v.Filename, ".")
-		extension := fileNameParts[len(fileNameParts)-1]
-		if extension != "gif" && extension != "jpg" && extension != "png" &&
-			extension != "tiff" && extension != "webm" && extension != "mp4" {
+		if !domain.IsSupportedVisualMedia(v) {
 			response := domain.NewMessage(domain.ErrUnsupportedVisualMediaType.Error())
 			responseBytes, errMarshal := json.Marshal(response)
 			if errMarshal != nil {
@@ -105,8 +242,64 @@ func (ph *PostHandler) postPost(w http.ResponseWriter, r *http.Request) {
 	}
 	var post domain.Post
 	post.Caption = caption
+	for _, altText := range formData.Value["alt_texts"] {
+		post.VisualMedias = append(post.VisualMedias, domain.VisualMedia{AltText: altText})
+	}
+	userTags, errUserTags := parseVisualMediaUserTags(r.FormValue("user_tags"))
+	if errUserTags != nil {
+		response := domain.NewMessage(errUserTags.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(errUserTags))
+		w.Write(responseBytes)
+		return
+	}
+	for _, v := range userTags {
+		for len(post.VisualMedias) <= v.VisualMediaIndex {
+			post.VisualMedias = append(post.VisualMedias, domain.VisualMedia{})
+		}
+		userTag := domain.NewUserTag(v.UserId, v.X, v.Y)
+		post.VisualMedias[v.VisualMediaIndex].UserTags = append(post.VisualMedias[v.VisualMediaIndex].UserTags, *userTag)
+	}
+	location, errLocation := parseLocation(r.FormValue("location"), r.FormValue("location_from_exif") == "true")
+	if errLocation != nil {
+		response := domain.NewMessage(errLocation.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(errLocation))
+		w.Write(responseBytes)
+		return
+	}
+	post.Location = location
+	post.CommentsDisabled = r.FormValue("comments_disabled") == "true"
+	post.HideLikeCount = r.FormValue("hide_like_count") == "true"
+	post.Status = r.FormValue("status")
+	if publishAtValue := r.FormValue("publish_at"); publishAtValue != "" {
+		publishAt, errPublishAt := time.Parse(time.RFC3339, publishAtValue)
+		if errPublishAt != nil {
+			response := domain.NewMessage(domain.ErrInvalidPostStatus.Error())
+			responseBytes, errMarshal := json.Marshal(response)
+			if errMarshal != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(errMarshal.Error()))
+				return
+			}
+			w.WriteHeader(postGetStatusCode(domain.ErrInvalidPostStatus))
+			w.Write(responseBytes)
+			return
+		}
+		post.PublishAt = &publishAt
+	}
 
-	err := ph.postUsecase.InsertPost(&post, tokenString, visualMedias)
+	err := ph.postUsecase.InsertPost(r.Context(), &post, tokenString, visualMedias)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -131,7 +324,8 @@ func (ph *PostHandler) postPost(w http.ResponseWriter, r *http.Request) {
 }
 
 func (ph *PostHandler) getPosts(w http.ResponseWriter, r *http.Request) {
-	posts, err := ph.postUsecase.FindPosts()
+	tokenString := r.Header.Get("Authorization")
+	posts, err := ph.postUsecase.FindPosts(r.Context(), tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -156,54 +350,70 @@ func (ph *PostHandler) getPosts(w http.ResponseWriter, r *http.Request) {
 	w.Write(responseBytes)
 }
 
-func (ph *PostHandler) putPost(w http.ResponseWriter, r *http.Request) {
-	bodyBytes, err := ioutil.ReadAll(r.Body)
-	defer r.Body.Close()
+func (ph *PostHandler) getHashtagPosts(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	tag := urlParts[2]
+
+	page, limit, err := domain.ParsePagination(r)
 	if err != nil {
-		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
 		responseBytes, errMarshal := json.Marshal(response)
 		if errMarshal != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(errMarshal.Error()))
 			return
 		}
-		w.WriteHeader(postGetStatusCode(domain.ErrInternalServerError))
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
 		w.Write(responseBytes)
 		return
 	}
-	var post domain.Post
-	err = json.Unmarshal(bodyBytes, &post)
+
+	tokenString := r.Header.Get("Authorization")
+	posts, err := ph.postUsecase.FindHashtagPosts(r.Context(), tag, page, limit, tokenString)
 	if err != nil {
-		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
 		if errMarshal != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(errMarshal.Error()))
 			return
 		}
-		w.WriteHeader(postGetStatusCode(domain.ErrInternalServerError))
+		w.WriteHeader(postGetStatusCode(err))
 		w.Write(responseBytes)
 		return
 	}
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
 
-	if post.Caption == "" {
-		response := domain.NewMessage(domain.ErrMissingCaptionInput.Error())
+func (ph *PostHandler) getUserTaggedPosts(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
 		responseBytes, errMarshal := json.Marshal(response)
 		if errMarshal != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(errMarshal.Error()))
 			return
 		}
-		w.WriteHeader(postGetStatusCode(domain.ErrMissingCaptionInput))
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
 		w.Write(responseBytes)
 		return
 	}
 
-	urlParts := strings.Split(r.URL.String(), "/")
-	postId := urlParts[2]
 	tokenString := r.Header.Get("Authorization")
-
-	err = ph.postUsecase.UpdatePost(postId, post.Caption, tokenString)
+	posts, err := ph.postUsecase.FindUserTaggedPosts(r.Context(), userId, page, limit, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -216,8 +426,8 @@ func (ph *PostHandler) putPost(w http.ResponseWriter, r *http.Request) {
 		w.Write(responseBytes)
 		return
 	}
-
-	response := domain.NewMessage("Post successfully Updated")
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
 	responseBytes, errMarshal := json.Marshal(response)
 	if errMarshal != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -228,12 +438,26 @@ func (ph *PostHandler) putPost(w http.ResponseWriter, r *http.Request) {
 	w.Write(responseBytes)
 }
 
-func (ph *PostHandler) deletePost(w http.ResponseWriter, r *http.Request) {
-	urlParts := strings.Split(r.URL.String(), "/")
-	postId := urlParts[2]
-	tokenString := r.Header.Get("Authorization")
+func (ph *PostHandler) getUserPosts(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
 
-	err := ph.postUsecase.DeletePost(postId, tokenString)
+	tokenString := r.Header.Get("Authorization")
+	posts, err := ph.postUsecase.FindUserPosts(r.Context(), userId, page, limit, tokenString)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -246,12 +470,880 @@ func (ph *PostHandler) deletePost(w http.ResponseWriter, r *http.Request) {
 		w.Write(responseBytes)
 		return
 	}
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) getLocationPosts(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	locationId := urlParts[2]
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
 
-	response := domain.NewMessage("Post successfully Deleted")
+	tokenString := r.Header.Get("Authorization")
+	posts, err := ph.postUsecase.FindLocationPosts(r.Context(), locationId, page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
 	responseBytes, errMarshal := json.Marshal(response)
 	if errMarshal != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(err.Error()))
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) getNearbyPosts(w http.ResponseWriter, r *http.Request) {
+	point, radius, err := parseNearbyQuery(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidNearbyQuery.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidNearbyQuery))
+		w.Write(responseBytes)
+		return
+	}
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	tokenString := r.Header.Get("Authorization")
+	posts, err := ph.postUsecase.FindNearbyPosts(r.Context(), point, radius, page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) getUserArchivedPosts(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	posts, err := ph.postUsecase.FindArchivedPosts(r.Context(), userId, page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) postPostArchive(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ph.postUsecase.ArchivePost(r.Context(), postId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post successfully Archived")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) deletePostArchive(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ph.postUsecase.RestorePost(r.Context(), postId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post successfully Restored")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) putPostPin(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ph.postUsecase.PinPost(r.Context(), postId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post successfully Pinned")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) deletePostPin(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ph.postUsecase.UnpinPost(r.Context(), postId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post successfully Unpinned")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) putPost(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInternalServerError))
+		w.Write(responseBytes)
+		return
+	}
+	var post domain.Post
+	err = json.Unmarshal(bodyBytes, &post)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInternalServerError))
+		w.Write(responseBytes)
+		return
+	}
+
+	if post.Caption == "" {
+		response := domain.NewMessage(domain.ErrMissingCaptionInput.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrMissingCaptionInput))
+		w.Write(responseBytes)
+		return
+	}
+
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err = ph.postUsecase.UpdatePost(r.Context(), postId, post.Caption, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) patchPostVisualMedias(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	r.ParseMultipartForm(10 << 20)
+	var newVisualMedias []*multipart.FileHeader
+	if r.MultipartForm != nil {
+		newVisualMedias = r.MultipartForm.File["visual_medias"]
+	}
+	for _, v := range newVisualMedias {
+		if !domain.IsSupportedVisualMedia(v) {
+			response := domain.NewMessage(domain.ErrUnsupportedVisualMediaType.Error())
+			responseBytes, errMarshal := json.Marshal(response)
+			if errMarshal != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(errMarshal.Error()))
+				return
+			}
+			w.WriteHeader(postGetStatusCode(domain.ErrUnsupportedVisualMediaType))
+			w.Write(responseBytes)
+			return
+		}
+	}
+
+	var visualMediaOrder []int
+	for _, v := range r.Form["visual_media_order"] {
+		index, err := strconv.Atoi(v)
+		if err != nil {
+			response := domain.NewMessage(domain.ErrInvalidVisualMediaOrder.Error())
+			responseBytes, errMarshal := json.Marshal(response)
+			if errMarshal != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(errMarshal.Error()))
+				return
+			}
+			w.WriteHeader(postGetStatusCode(domain.ErrInvalidVisualMediaOrder))
+			w.Write(responseBytes)
+			return
+		}
+		visualMediaOrder = append(visualMediaOrder, index)
+	}
+
+	err := ph.postUsecase.UpdatePostVisualMedias(r.Context(), postId, visualMediaOrder, newVisualMedias, r.Form["alt_texts"], tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post visual medias successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) putPostStatus(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInternalServerError))
+		w.Write(responseBytes)
+		return
+	}
+	var body struct {
+		Status    string     `json:"status"`
+		PublishAt *time.Time `json:"publish_at"`
+	}
+	err = json.Unmarshal(bodyBytes, &body)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPostStatus.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPostStatus))
+		w.Write(responseBytes)
+		return
+	}
+
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err = ph.postUsecase.UpdatePostStatus(r.Context(), postId, body.Status, body.PublishAt, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post status successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) putPostSettings(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInternalServerError))
+		w.Write(responseBytes)
+		return
+	}
+	var body struct {
+		CommentsDisabled bool `json:"comments_disabled"`
+		HideLikeCount    bool `json:"hide_like_count"`
+	}
+	err = json.Unmarshal(bodyBytes, &body)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPostSettings.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPostSettings))
+		w.Write(responseBytes)
+		return
+	}
+
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err = ph.postUsecase.UpdatePostSettings(r.Context(), postId, body.CommentsDisabled, body.HideLikeCount, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post settings successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) putPostUserTags(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInternalServerError))
+		w.Write(responseBytes)
+		return
+	}
+	var body struct {
+		UserTags []domain.VisualMediaUserTag `json:"user_tags"`
+	}
+	err = json.Unmarshal(bodyBytes, &body)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidUserTags.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidUserTags))
+		w.Write(responseBytes)
+		return
+	}
+
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err = ph.postUsecase.UpdatePostUserTags(r.Context(), postId, body.UserTags, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post user tags successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) deletePostUserTag(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ph.postUsecase.DeletePostUserTag(r.Context(), postId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post user tag successfully Deleted")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) deletePost(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ph.postUsecase.DeletePost(r.Context(), postId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post successfully Deleted")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func parseVisualMediaUserTags(value string) ([]domain.VisualMediaUserTag, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var userTags []domain.VisualMediaUserTag
+	if err := json.Unmarshal([]byte(value), &userTags); err != nil {
+		return nil, domain.ErrInvalidUserTags
+	}
+	for _, v := range userTags {
+		if v.VisualMediaIndex < 0 {
+			return nil, domain.ErrInvalidUserTags
+		}
+	}
+	return userTags, nil
+}
+
+// The coordinates may only be left out when fromExif reads them from the visual medias.
+func parseLocation(value string, fromExif bool) (*domain.Location, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var input struct {
+		Name      string   `json:"name"`
+		Latitude  *float64 `json:"lat"`
+		Longitude *float64 `json:"lng"`
+	}
+	if err := json.Unmarshal([]byte(value), &input); err != nil {
+		return nil, domain.ErrInvalidLocation
+	}
+	if input.Latitude == nil && input.Longitude == nil {
+		if !fromExif {
+			return nil, domain.ErrMissingLocationPoint
+		}
+		return domain.NewLocation("", input.Name, nil), nil
+	}
+	if input.Latitude == nil || input.Longitude == nil {
+		return nil, domain.ErrInvalidLocation
+	}
+	return domain.NewLocation("", input.Name, domain.NewGeoPoint(*input.Latitude, *input.Longitude)), nil
+}
+
+func parseNearbyQuery(r *http.Request) (*domain.GeoPoint, float64, error) {
+	query := r.URL.Query()
+	latitude, err := strconv.ParseFloat(query.Get("lat"), 64)
+	if err != nil {
+		return nil, 0, err
+	}
+	longitude, err := strconv.ParseFloat(query.Get("lng"), 64)
+	if err != nil {
+		return nil, 0, err
+	}
+	radius := float64(domain.DefaultNearbyRadius)
+	if radiusQuery := query.Get("radius"); radiusQuery != "" {
+		radius, err = strconv.ParseFloat(radiusQuery, 64)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return domain.NewGeoPoint(latitude, longitude), radius, nil
+}
+
+func (ph *PostHandler) getUserDeletedPosts(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	posts, err := ph.postUsecase.FindDeletedPosts(r.Context(), userId, page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) postPostRestore(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := ph.postUsecase.RestoreDeletedPost(r.Context(), postId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Post successfully Restored")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) getSavedPosts(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	posts, err := ph.postUsecase.FindSavedPosts(r.Context(), "", page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (ph *PostHandler) getCollectionPosts(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	collectionId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	page, limit, err := domain.ParsePagination(r)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidPagination.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(domain.ErrInvalidPagination))
+		w.Write(responseBytes)
+		return
+	}
+
+	posts, err := ph.postUsecase.FindSavedPosts(r.Context(), collectionId, page, limit, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(postGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataPosts := domain.NewDataPosts(*posts)
+	response := domain.NewDataResponsePosts(*dataPosts)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -260,13 +1352,21 @@ func (ph *PostHandler) deletePost(w http.ResponseWriter, r *http.Request) {
 
 func postGetStatusCode(err error) int {
 	switch err {
-	case domain.ErrMissingVisualMediasInput, domain.ErrUnsupportedVisualMediaType, domain.ErrMissingCaptionInput:
+	case domain.ErrMissingVisualMediasInput, domain.ErrUnsupportedVisualMediaType, domain.ErrMissingCaptionInput,
+		domain.ErrInvalidVisualMediaOrder, domain.ErrInvalidPagination, domain.ErrInvalidUserTags, domain.ErrTaggedUserNotFound,
+		domain.ErrInvalidLocation, domain.ErrMissingLocationPoint, domain.ErrInvalidNearbyQuery, domain.ErrInvalidPostStatus,
+		domain.ErrUnpublishedPostArchive, domain.ErrUnpublishedPostPin, domain.ErrInvalidPostSettings:
 		return http.StatusBadRequest
 	case domain.ErrInternalServerError:
 		return http.StatusInternalServerError
-	case domain.ErrPostNotFound:
+	case domain.ErrPostNotFound, domain.ErrUserNotFound, domain.ErrUserTagNotFound, domain.ErrCollectionNotFound:
 		return http.StatusNotFound
-	case domain.ErrUnauthorizedPostUpdate, domain.ErrUnauthorizedPostDelete:
+	case domain.ErrPostArchiveConflict, domain.ErrPostNotArchived, domain.ErrPostNotDeleted, domain.ErrPostPinConflict,
+		domain.ErrPostNotPinned, domain.ErrPinnedPostLimit:
+		return http.StatusConflict
+	case domain.ErrUnauthorizedPostUpdate, domain.ErrUnauthorizedPostDelete, domain.ErrUnauthorizedPostArchive,
+		domain.ErrUnauthorizedArchiveView, domain.ErrUnauthorizedPostRestore, domain.ErrUnauthorizedTrashView,
+		domain.ErrUnauthorizedCollectionView, domain.ErrUnauthorizedPostPin:
 		return http.StatusUnauthorized
 	}
 	return http.StatusOK
@@ -13,6 +13,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -113,7 +114,7 @@ func (ph *PostHandlerSuite) TestPostPostInsertPostError() {
 	fw, _ = writer.CreateFormField("caption")
 	_, _ = io.Copy(fw, strings.NewReader("a new caption"))
 	writer.Close()
-	ph.postUsecase.On("InsertPost", mock.AnythingOfType("*domain.Post"), mock.AnythingOfType("string"), mock.AnythingOfType("[]*multipart.FileHeader")).Return(domain.ErrInternalServerError)
+	ph.postUsecase.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post"), mock.AnythingOfType("string"), mock.AnythingOfType("[]*multipart.FileHeader")).Return(domain.ErrInternalServerError)
 	postHandler := postHttp.NewPostHandler(ph.postUsecase)
 	req, _ := http.NewRequest("POST", "/posts", bytes.NewReader(body.Bytes()))
 	req.Header.Set("Content-Type", writer.FormDataContentType())
@@ -144,7 +145,7 @@ func (ph *PostHandlerSuite) TestPostPostSuccessful() {
 	fw, _ = writer.CreateFormField("caption")
 	_, _ = io.Copy(fw, strings.NewReader("a new caption"))
 	writer.Close()
-	ph.postUsecase.On("InsertPost", mock.AnythingOfType("*domain.Post"), mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	ph.postUsecase.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post"), mock.AnythingOfType("string"), mock.Anything).Return(nil)
 	postHandler := postHttp.NewPostHandler(ph.postUsecase)
 	req, _ := http.NewRequest("POST", "/posts", bytes.NewReader(body.Bytes()))
 	req.Header.Set("Content-Type", writer.FormDataContentType())
@@ -157,10 +158,202 @@ func (ph *PostHandlerSuite) TestPostPostSuccessful() {
 	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
 
+func (ph *PostHandlerSuite) TestPostPostInvalidUserTags() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	fw, _ = writer.CreateFormField("caption")
+	_, _ = io.Copy(fw, strings.NewReader("a new caption"))
+	fw, _ = writer.CreateFormField("user_tags")
+	_, _ = io.Copy(fw, strings.NewReader(`[{"visual_media_index":-1,"user_id":"userid1","x":0.5,"y":0.5}]`))
+	writer.Close()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("POST", "/posts", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.Posts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidUserTags.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+	ph.postUsecase.AssertNotCalled(ph.T(), "InsertPost", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (ph *PostHandlerSuite) TestPostPostWithUserTagsSuccessful() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	fw, _ = writer.CreateFormFile("visual_medias", "png.png")
+	file, _ = os.Open("./test_visual_medias/png.png")
+	_, _ = io.Copy(fw, file)
+	fw, _ = writer.CreateFormField("caption")
+	_, _ = io.Copy(fw, strings.NewReader("a new caption"))
+	fw, _ = writer.CreateFormField("user_tags")
+	_, _ = io.Copy(fw, strings.NewReader(`[{"visual_media_index":1,"user_id":"userid1","x":0.25,"y":0.75}]`))
+	writer.Close()
+	ph.postUsecase.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post"), mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("POST", "/posts", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.Posts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
+	post := ph.postUsecase.Calls[0].Arguments.Get(1).(*domain.Post)
+	expectedVisualMedias := []domain.VisualMedia{{}, {UserTags: []domain.UserTag{*domain.NewUserTag("userid1", 0.25, 0.75)}}}
+	assert.Equalf(ph.T(), expectedVisualMedias, post.VisualMedias, "Should have passed visual medias %v but got %v", expectedVisualMedias, post.VisualMedias)
+}
+
+func (ph *PostHandlerSuite) TestPostPostMissingLocationPoint() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	fw, _ = writer.CreateFormField("caption")
+	_, _ = io.Copy(fw, strings.NewReader("a new caption"))
+	fw, _ = writer.CreateFormField("location")
+	_, _ = io.Copy(fw, strings.NewReader(`{"name":"Sydney"}`))
+	writer.Close()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("POST", "/posts", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.Posts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrMissingLocationPoint.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPostPostWithLocationFromExifSuccessful() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	fw, _ = writer.CreateFormField("caption")
+	_, _ = io.Copy(fw, strings.NewReader("a new caption"))
+	fw, _ = writer.CreateFormField("location")
+	_, _ = io.Copy(fw, strings.NewReader(`{"name":"Sydney"}`))
+	fw, _ = writer.CreateFormField("location_from_exif")
+	_, _ = io.Copy(fw, strings.NewReader("true"))
+	writer.Close()
+	ph.postUsecase.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post"), mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("POST", "/posts", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.Posts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
+	post := ph.postUsecase.Calls[0].Arguments.Get(1).(*domain.Post)
+	expectedLocation := domain.NewLocation("", "Sydney", nil)
+	assert.Equalf(ph.T(), expectedLocation, post.Location, "Should have passed location %v but got %v", expectedLocation, post.Location)
+}
+
+func (ph *PostHandlerSuite) TestGetLocationPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/locations/location-1/posts?limit=5", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindLocationPosts", mock.Anything, "location-1", 1, 5, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.LocationPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	ph.postUsecase.AssertCalled(ph.T(), "FindLocationPosts", mock.Anything, "location-1", 1, 5, mock.AnythingOfType("string"))
+}
+
+func (ph *PostHandlerSuite) TestGetNearbyPostsInvalidNearbyQuery() {
+	req, _ := http.NewRequest("GET", "/posts/nearby?lat=north&lng=151.2", nil)
+	rr := httptest.NewRecorder()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.NearbyPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidNearbyQuery.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetNearbyPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/posts/nearby?lat=-33.875&lng=151.2", nil)
+	rr := httptest.NewRecorder()
+	point := domain.NewGeoPoint(-33.875, 151.2)
+	ph.postUsecase.On("FindNearbyPosts", mock.Anything, point, float64(domain.DefaultNearbyRadius), 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.NearbyPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	ph.postUsecase.AssertCalled(ph.T(), "FindNearbyPosts", mock.Anything, point, float64(domain.DefaultNearbyRadius), 1, domain.DefaultPageLimit, mock.AnythingOfType("string"))
+}
+
+func (ph *PostHandlerSuite) TestPostPostInvalidPublishAt() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	fw, _ = writer.CreateFormField("caption")
+	_, _ = io.Copy(fw, strings.NewReader("a new caption"))
+	fw, _ = writer.CreateFormField("status")
+	_, _ = io.Copy(fw, strings.NewReader(domain.PostStatusScheduled))
+	fw, _ = writer.CreateFormField("publish_at")
+	_, _ = io.Copy(fw, strings.NewReader("tomorrow"))
+	writer.Close()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("POST", "/posts", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.Posts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPostStatus.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPostPostScheduledSuccessful() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	fw, _ = writer.CreateFormField("caption")
+	_, _ = io.Copy(fw, strings.NewReader("a new caption"))
+	fw, _ = writer.CreateFormField("status")
+	_, _ = io.Copy(fw, strings.NewReader(domain.PostStatusScheduled))
+	fw, _ = writer.CreateFormField("publish_at")
+	_, _ = io.Copy(fw, strings.NewReader("2030-01-02T15:04:05Z"))
+	writer.Close()
+	ph.postUsecase.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post"), mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("POST", "/posts", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.Posts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
+	post := ph.postUsecase.Calls[0].Arguments.Get(1).(*domain.Post)
+	expectedPublishAt := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+	assert.Equalf(ph.T(), domain.PostStatusScheduled, post.Status, "Should have passed status %s but got %s", domain.PostStatusScheduled, post.Status)
+	assert.Truef(ph.T(), expectedPublishAt.Equal(*post.PublishAt), "Should have passed publish at %v but got %v", expectedPublishAt, post.PublishAt)
+}
+
 func (ph *PostHandlerSuite) TestGetPostsFindPostError() {
 	req, _ := http.NewRequest("GET", "/posts", nil)
 	rr := httptest.NewRecorder()
-	ph.postUsecase.On("FindPosts").Return(nil, domain.ErrInternalServerError)
+	ph.postUsecase.On("FindPosts", mock.Anything, mock.AnythingOfType("string")).Return(nil, domain.ErrInternalServerError)
 	postHandler := postHttp.NewPostHandler(ph.postUsecase)
 	handler := http.HandlerFunc(postHandler.Posts)
 	handler.ServeHTTP(rr, req)
@@ -173,13 +366,76 @@ func (ph *PostHandlerSuite) TestGetPostsFindPostError() {
 func (ph *PostHandlerSuite) TestGetPostsSuccessful() {
 	req, _ := http.NewRequest("GET", "/posts", nil)
 	rr := httptest.NewRecorder()
-	ph.postUsecase.On("FindPosts").Return(&[]domain.Post{}, nil)
+	ph.postUsecase.On("FindPosts", mock.Anything, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
 	postHandler := postHttp.NewPostHandler(ph.postUsecase)
 	handler := http.HandlerFunc(postHandler.Posts)
 	handler.ServeHTTP(rr, req)
 
 	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
 }
+
+func (ph *PostHandlerSuite) TestGetHashtagPostsInvalidPagination() {
+	req, _ := http.NewRequest("GET", "/hashtags/golang/posts?page=first", nil)
+	rr := httptest.NewRecorder()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.HashtagPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPagination.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetHashtagPostsFindHashtagPostsError() {
+	req, _ := http.NewRequest("GET", "/hashtags/golang/posts?page=0", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindHashtagPosts", mock.Anything, "golang", 0, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, domain.ErrInvalidPagination)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.HashtagPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPagination.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetHashtagPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/hashtags/golang/posts?page=2&limit=5", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindHashtagPosts", mock.Anything, "golang", 2, 5, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.HashtagPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	ph.postUsecase.AssertCalled(ph.T(), "FindHashtagPosts", mock.Anything, "golang", 2, 5, mock.AnythingOfType("string"))
+}
+
+func (ph *PostHandlerSuite) TestGetUserTaggedPostsFindUserTaggedPostsError() {
+	req, _ := http.NewRequest("GET", "/users/userid1/tagged", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindUserTaggedPosts", mock.Anything, "userid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, domain.ErrUserNotFound)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.UserTaggedPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUserNotFound.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetUserTaggedPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/users/userid1/tagged?page=3&limit=10", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindUserTaggedPosts", mock.Anything, "userid1", 3, 10, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.UserTaggedPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	ph.postUsecase.AssertCalled(ph.T(), "FindUserTaggedPosts", mock.Anything, "userid1", 3, 10, mock.AnythingOfType("string"))
+}
+
 func (ph *PostHandlerSuite) TestPutPostMissingCaption() {
 	requestBody, _ := json.Marshal(map[string]string{
 		"caption": "",
@@ -202,7 +458,7 @@ func (ph *PostHandlerSuite) TestPutPostUpdatePostError() {
 	})
 	req, _ := http.NewRequest("PUT", "/posts/postid1", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
-	ph.postUsecase.On("UpdatePost", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	ph.postUsecase.On("UpdatePost", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
 	postHandler := postHttp.NewPostHandler(ph.postUsecase)
 	handler := http.HandlerFunc(postHandler.Post)
 	handler.ServeHTTP(rr, req)
@@ -218,7 +474,7 @@ func (ph *PostHandlerSuite) TestPutPostSuccessful() {
 	})
 	req, _ := http.NewRequest("PUT", "/posts/postid1", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
-	ph.postUsecase.On("UpdatePost", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	ph.postUsecase.On("UpdatePost", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 	postHandler := postHttp.NewPostHandler(ph.postUsecase)
 	handler := http.HandlerFunc(postHandler.Post)
 	handler.ServeHTTP(rr, req)
@@ -228,11 +484,258 @@ func (ph *PostHandlerSuite) TestPutPostSuccessful() {
 	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
 
+func (ph *PostHandlerSuite) TestPatchPostVisualMediasInvalidVisualMediaOrder() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormField("visual_media_order")
+	_, _ = io.Copy(fw, strings.NewReader("first"))
+	writer.Close()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("PATCH", "/posts/postid1/media", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.PostVisualMedias)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidVisualMediaOrder.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPatchPostVisualMediasUnsupportedVisualMediaType() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "bmp.bmp")
+	file, _ := os.Open("./test_visual_medias/bmp.bmp")
+	_, _ = io.Copy(fw, file)
+	writer.Close()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("PATCH", "/posts/postid1/media", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.PostVisualMedias)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnsupportedVisualMediaType.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPatchPostVisualMediasUpdatePostVisualMediasError() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormField("visual_media_order")
+	_, _ = io.Copy(fw, strings.NewReader("1"))
+	writer.Close()
+	ph.postUsecase.On("UpdatePostVisualMedias", mock.Anything, "postid1", []int{1}, mock.Anything, mock.Anything, mock.AnythingOfType("string")).Return(domain.ErrUnauthorizedPostUpdate)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("PATCH", "/posts/postid1/media", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.PostVisualMedias)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnauthorizedPostUpdate.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPatchPostVisualMediasSuccessful() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormField("visual_media_order")
+	_, _ = io.Copy(fw, strings.NewReader("1"))
+	fw, _ = writer.CreateFormField("visual_media_order")
+	_, _ = io.Copy(fw, strings.NewReader("0"))
+	fw, _ = writer.CreateFormFile("visual_medias", "png.png")
+	file, _ := os.Open("./test_visual_medias/png.png")
+	_, _ = io.Copy(fw, file)
+	writer.Close()
+	ph.postUsecase.On("UpdatePostVisualMedias", mock.Anything, "postid1", []int{1, 0}, mock.AnythingOfType("[]*multipart.FileHeader"), mock.Anything, mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	req, _ := http.NewRequest("PATCH", "/posts/postid1/media", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(postHandler.PostVisualMedias)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post visual medias successfully Updated"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPutPostUserTagsInvalidBody() {
+	req, _ := http.NewRequest("PUT", "/posts/postid1/tags", strings.NewReader(`{"user_tags":"userid1"}`))
+	rr := httptest.NewRecorder()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostUserTags)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidUserTags.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPutPostUserTagsSuccessful() {
+	req, _ := http.NewRequest("PUT", "/posts/postid1/tags", strings.NewReader(`{"user_tags":[{"visual_media_index":0,"user_id":"userid2","x":0.1,"y":0.2}]}`))
+	rr := httptest.NewRecorder()
+	userTags := []domain.VisualMediaUserTag{{VisualMediaIndex: 0, UserId: "userid2", X: 0.1, Y: 0.2}}
+	ph.postUsecase.On("UpdatePostUserTags", mock.Anything, "postid1", userTags, mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostUserTags)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post user tags successfully Updated"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestDeletePostUserTagDeletePostUserTagError() {
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/tags", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("DeletePostUserTag", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(domain.ErrUserTagNotFound)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostUserTags)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUserTagNotFound.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestDeletePostUserTagSuccessful() {
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/tags", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("DeletePostUserTag", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostUserTags)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post user tag successfully Deleted"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPutPostStatusUpdatePostStatusError() {
+	req, _ := http.NewRequest("PUT", "/posts/postid1/status", strings.NewReader(`{"status":"draft"}`))
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("UpdatePostStatus", mock.Anything, "postid1", domain.PostStatusDraft, (*time.Time)(nil), mock.AnythingOfType("string")).Return(domain.ErrInvalidPostStatus)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostStatus)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPostStatus.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPutPostStatusSuccessful() {
+	req, _ := http.NewRequest("PUT", "/posts/postid1/status", strings.NewReader(`{"status":"scheduled","publish_at":"2030-01-02T15:04:05Z"}`))
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("UpdatePostStatus", mock.Anything, "postid1", domain.PostStatusScheduled, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostStatus)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post status successfully Updated"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPutPostSettingsInvalidPostSettings() {
+	req, _ := http.NewRequest("PUT", "/posts/postid1/settings", strings.NewReader(`{"comments_disabled":"yes"}`))
+	rr := httptest.NewRecorder()
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostSettings)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidPostSettings.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPutPostSettingsSuccessful() {
+	req, _ := http.NewRequest("PUT", "/posts/postid1/settings", strings.NewReader(`{"comments_disabled":true,"hide_like_count":true}`))
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("UpdatePostSettings", mock.Anything, "postid1", true, true, mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostSettings)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post settings successfully Updated"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPostPostArchiveArchivePostError() {
+	req, _ := http.NewRequest("POST", "/posts/postid1/archive", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("ArchivePost", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(domain.ErrPostArchiveConflict)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostArchive)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusConflict, rr.Code, "Should have responded with http status code %v but got %v", http.StatusConflict, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrPostArchiveConflict.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPostPostArchiveSuccessful() {
+	req, _ := http.NewRequest("POST", "/posts/postid1/archive", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("ArchivePost", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostArchive)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post successfully Archived"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestDeletePostArchiveSuccessful() {
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/archive", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("RestorePost", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostArchive)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post successfully Restored"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetUserArchivedPostsUnauthorizedArchiveView() {
+	req, _ := http.NewRequest("GET", "/users/userid1/archive", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindArchivedPosts", mock.Anything, "userid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, domain.ErrUnauthorizedArchiveView)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.UserArchivedPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnauthorizedArchiveView.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetUserArchivedPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/users/userid1/archive?page=2", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindArchivedPosts", mock.Anything, "userid1", 2, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.UserArchivedPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	ph.postUsecase.AssertCalled(ph.T(), "FindArchivedPosts", mock.Anything, "userid1", 2, domain.DefaultPageLimit, mock.AnythingOfType("string"))
+}
+
 func (ph *PostHandlerSuite) TestDeletePostDeletePostError() {
 	req, _ := http.NewRequest("DELETE", "/posts/postid1", nil)
 	rr := httptest.NewRecorder()
 
-	ph.postUsecase.On("DeletePost", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
+	ph.postUsecase.On("DeletePost", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.ErrInternalServerError)
 	postHandler := postHttp.NewPostHandler(ph.postUsecase)
 	handler := http.HandlerFunc(postHandler.Post)
 	handler.ServeHTTP(rr, req)
@@ -246,7 +749,7 @@ func (ph *PostHandlerSuite) TestDeletePostSuccessful() {
 	req, _ := http.NewRequest("DELETE", "/posts/postid1", nil)
 	rr := httptest.NewRecorder()
 
-	ph.postUsecase.On("DeletePost", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	ph.postUsecase.On("DeletePost", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 	postHandler := postHttp.NewPostHandler(ph.postUsecase)
 	handler := http.HandlerFunc(postHandler.Post)
 	handler.ServeHTTP(rr, req)
@@ -255,3 +758,143 @@ func (ph *PostHandlerSuite) TestDeletePostSuccessful() {
 	expectedBody := `{"message":"Post successfully Deleted"}`
 	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
+
+func (ph *PostHandlerSuite) TestPostPostRestorePostNotDeleted() {
+	req, _ := http.NewRequest("POST", "/posts/postid1/restore", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("RestoreDeletedPost", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(domain.ErrPostNotDeleted)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostRestore)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusConflict, rr.Code, "Should have responded with http status code %v but got %v", http.StatusConflict, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrPostNotDeleted.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPostPostRestoreSuccessful() {
+	req, _ := http.NewRequest("POST", "/posts/postid1/restore", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("RestoreDeletedPost", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostRestore)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post successfully Restored"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetUserDeletedPostsUnauthorizedTrashView() {
+	req, _ := http.NewRequest("GET", "/users/userid1/recently-deleted/posts", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindDeletedPosts", mock.Anything, "userid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, domain.ErrUnauthorizedTrashView)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.UserDeletedPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnauthorizedTrashView.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetUserDeletedPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/users/userid1/recently-deleted/posts?limit=5", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindDeletedPosts", mock.Anything, "userid1", 1, 5, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.UserDeletedPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	ph.postUsecase.AssertCalled(ph.T(), "FindDeletedPosts", mock.Anything, "userid1", 1, 5, mock.AnythingOfType("string"))
+}
+
+func (ph *PostHandlerSuite) TestGetSavedPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/saves?page=2&limit=5", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindSavedPosts", mock.Anything, "", 2, 5, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.SavedPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	ph.postUsecase.AssertCalled(ph.T(), "FindSavedPosts", mock.Anything, "", 2, 5, mock.AnythingOfType("string"))
+}
+
+func (ph *PostHandlerSuite) TestGetCollectionPostsUnauthorizedCollectionView() {
+	req, _ := http.NewRequest("GET", "/collections/collectionid1/posts", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindSavedPosts", mock.Anything, "collectionid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(nil, domain.ErrUnauthorizedCollectionView)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.CollectionPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnauthorizedCollectionView.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetCollectionPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/collections/collectionid1/posts", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindSavedPosts", mock.Anything, "collectionid1", 1, domain.DefaultPageLimit, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.CollectionPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"posts":[]}}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestGetUserPostsSuccessful() {
+	req, _ := http.NewRequest("GET", "/users/userid1/posts?page=2&limit=5", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("FindUserPosts", mock.Anything, "userid1", 2, 5, mock.AnythingOfType("string")).Return(&[]domain.Post{}, nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.UserPosts)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	ph.postUsecase.AssertCalled(ph.T(), "FindUserPosts", mock.Anything, "userid1", 2, 5, mock.AnythingOfType("string"))
+}
+
+func (ph *PostHandlerSuite) TestPutPostPinPinnedPostLimit() {
+	req, _ := http.NewRequest("PUT", "/posts/postid4/pin", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("PinPost", mock.Anything, "postid4", mock.AnythingOfType("string")).Return(domain.ErrPinnedPostLimit)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostPin)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusConflict, rr.Code, "Should have responded with http status code %v but got %v", http.StatusConflict, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrPinnedPostLimit.Error() + `"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestPutPostPinSuccessful() {
+	req, _ := http.NewRequest("PUT", "/posts/postid1/pin", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("PinPost", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostPin)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post successfully Pinned"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (ph *PostHandlerSuite) TestDeletePostPinSuccessful() {
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/pin", nil)
+	rr := httptest.NewRecorder()
+	ph.postUsecase.On("UnpinPost", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(nil)
+	postHandler := postHttp.NewPostHandler(ph.postUsecase)
+	handler := http.HandlerFunc(postHandler.PostPin)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(ph.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Post successfully Unpinned"}`
+	assert.Equalf(ph.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
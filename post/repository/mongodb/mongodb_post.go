@@ -3,10 +3,12 @@ package mongodb
 import (
 	"context"
 	"instagram-go/domain"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type mongodbPostRepository struct {
@@ -19,58 +21,274 @@ func NewMongodbPostRepository(collection *mongo.Collection) domain.PostRepositor
 	}
 }
 
-func (pr *mongodbPostRepository) InsertPost(post *domain.Post) error {
+func (pr *mongodbPostRepository) CreateIndexes(ctx context.Context) error {
+	hashtagIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "hashtags", Value: 1},
+			primitive.E{Key: "created_date", Value: -1},
+		},
+	}
+	userTagIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "visual_medias.user_tags.user_id", Value: 1},
+			primitive.E{Key: "created_date", Value: -1},
+		},
+	}
+	locationIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "location.id", Value: 1},
+			primitive.E{Key: "created_date", Value: -1},
+		},
+	}
+	locationPointIndex := mongo.IndexModel{
+		Keys: bson.D{primitive.E{Key: "location.point", Value: "2dsphere"}},
+	}
+	scheduledIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "status", Value: 1},
+			primitive.E{Key: "publish_at", Value: 1},
+		},
+	}
+	archiveIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "archived_date", Value: 1},
+			primitive.E{Key: "created_date", Value: -1},
+		},
+	}
+	trashIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "deleted_at", Value: 1},
+		},
+	}
+	purgeIndex := mongo.IndexModel{
+		Keys: bson.D{primitive.E{Key: "deleted_at", Value: 1}},
+	}
+	profileIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "pinned_date", Value: 1},
+			primitive.E{Key: "created_date", Value: -1},
+		},
+	}
+	_, err := pr.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{hashtagIndex, userTagIndex, locationIndex, locationPointIndex, scheduledIndex, archiveIndex, trashIndex, purgeIndex, profileIndex})
+	return err
+}
+
+func (pr *mongodbPostRepository) InsertPost(ctx context.Context, post *domain.Post) error {
 	newPost := bson.D{
 		primitive.E{Key: "_id", Value: post.Id},
 		primitive.E{Key: "user_id", Value: post.UserId},
-		primitive.E{Key: "visual_media_urls", Value: post.VisualMediaUrls},
+		primitive.E{Key: "visual_medias", Value: post.VisualMedias},
 		primitive.E{Key: "caption", Value: post.Caption},
+		primitive.E{Key: "hashtags", Value: post.Hashtags},
+		primitive.E{Key: "mentions", Value: post.Mentions},
+		primitive.E{Key: "status", Value: post.Status},
+		primitive.E{Key: "publish_at", Value: post.PublishAt},
+		primitive.E{Key: "comments_disabled", Value: post.CommentsDisabled},
+		primitive.E{Key: "hide_like_count", Value: post.HideLikeCount},
 		primitive.E{Key: "created_date", Value: post.CreatedDate},
 		primitive.E{Key: "updated_date", Value: post.UpdatedDate},
 	}
-	_, err := pr.collection.InsertOne(context.TODO(), newPost)
+	if post.Location != nil {
+		newPost = append(newPost, primitive.E{Key: "location", Value: post.Location})
+	}
+	_, err := pr.collection.InsertOne(ctx, newPost)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (pr *mongodbPostRepository) FindPosts(filter interface{}) (*[]bson.M, error) {
-	cursor, err := pr.collection.Find(context.TODO(), filter)
+func (pr *mongodbPostRepository) FindPosts(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	cursor, err := pr.collection.Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 	var queryResult []bson.M
-	if err = cursor.All(context.TODO(), &queryResult); err != nil {
+	if err = cursor.All(ctx, &queryResult); err != nil {
 		return nil, err
 	}
 	return &queryResult, nil
 }
 
-func (pr *mongodbPostRepository) FindOnePost(searchedPostId string) (*domain.Post, error) {
+func (pr *mongodbPostRepository) FindPaginatedPosts(ctx context.Context, filter interface{}, skip int64, limit int64) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "created_date", Value: -1}}).SetSkip(skip).SetLimit(limit)
+	cursor, err := pr.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (pr *mongodbPostRepository) FindOnePost(ctx context.Context, searchedPostId string) (*domain.Post, error) {
 	var post domain.Post
 	filter := bson.M{"_id": searchedPostId}
-	err := pr.collection.FindOne(context.TODO(), filter).Decode(&post)
+	err := pr.collection.FindOne(ctx, filter).Decode(&post)
 	return &post, err
 }
 
-func (pr *mongodbPostRepository) UpdatePost(updatedPostId string, newCaption string) error {
+func (pr *mongodbPostRepository) UpdatePost(ctx context.Context, updatedPostId string, newCaption string, newHashtags []string, newMentions []domain.Mention) error {
 	filter := bson.M{"_id": updatedPostId}
 	update := bson.D{primitive.E{
 		Key: "$set",
 		Value: bson.D{primitive.E{
 			Key:   "caption",
 			Value: newCaption,
+		}, primitive.E{
+			Key:   "hashtags",
+			Value: newHashtags,
+		}, primitive.E{
+			Key:   "mentions",
+			Value: newMentions,
+		},
+		},
+	},
+	}
+	_, err := pr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (pr *mongodbPostRepository) UpdatePostVisualMedias(ctx context.Context, updatedPostId string, visualMedias []domain.VisualMedia) error {
+	filter := bson.M{"_id": updatedPostId}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "visual_medias",
+			Value: visualMedias,
 		},
 		},
 	},
 	}
-	_, err := pr.collection.UpdateOne(context.TODO(), filter, update)
+	_, err := pr.collection.UpdateOne(ctx, filter, update)
 	return err
 }
 
-func (pr *mongodbPostRepository) DeletePost(deletedPostId string) error {
+// Publishing a post also moves its created_date to publishAt so it is listed as new.
+func (pr *mongodbPostRepository) UpdatePostStatus(ctx context.Context, updatedPostId string, oldStatus string, newStatus string, publishAt *time.Time) (bool, error) {
+	filter := bson.M{"_id": updatedPostId, "status": oldStatus}
+	fields := bson.D{
+		primitive.E{Key: "status", Value: newStatus},
+		primitive.E{Key: "publish_at", Value: publishAt},
+	}
+	if newStatus == domain.PostStatusPublished && publishAt != nil {
+		fields = append(fields, primitive.E{Key: "created_date", Value: *publishAt})
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: fields}}
+	result, err := pr.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (pr *mongodbPostRepository) UpdatePostArchivedDate(ctx context.Context, updatedPostId string, archivedDate *time.Time) error {
+	filter := bson.M{"_id": updatedPostId}
+	fields := bson.D{primitive.E{Key: "archived_date", Value: archivedDate}}
+	if archivedDate != nil {
+		fields = append(fields, primitive.E{Key: "pinned_date", Value: nil})
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: fields}}
+	_, err := pr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (pr *mongodbPostRepository) UpdatePostDeletedAt(ctx context.Context, updatedPostId string, deletedAt *time.Time) error {
+	filter := bson.M{"_id": updatedPostId}
+	fields := bson.D{primitive.E{Key: "deleted_at", Value: deletedAt}}
+	if deletedAt != nil {
+		fields = append(fields, primitive.E{Key: "pinned_date", Value: nil})
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: fields}}
+	_, err := pr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// Pinning only matches an unpinned post and unpinning a pinned one, so concurrent calls free or take one slot.
+func (pr *mongodbPostRepository) UpdatePostPinnedDate(ctx context.Context, updatedPostId string, pinnedDate *time.Time) (bool, error) {
+	filter := bson.M{"_id": updatedPostId, "pinned_date": nil}
+	if pinnedDate == nil {
+		filter["pinned_date"] = bson.M{"$ne": nil}
+	}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "pinned_date",
+			Value: pinnedDate,
+		},
+		},
+	},
+	}
+	result, err := pr.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (pr *mongodbPostRepository) UpdatePostSettings(ctx context.Context, updatedPostId string, commentsDisabled bool, hideLikeCount bool) error {
+	filter := bson.M{"_id": updatedPostId}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{
+			primitive.E{Key: "comments_disabled", Value: commentsDisabled},
+			primitive.E{Key: "hide_like_count", Value: hideLikeCount},
+		},
+	}}
+	_, err := pr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (pr *mongodbPostRepository) DeletePost(ctx context.Context, deletedPostId string) error {
 	filter := bson.M{"_id": deletedPostId}
-	_, err := pr.collection.DeleteOne(context.TODO(), filter)
+	_, err := pr.collection.DeleteOne(ctx, filter)
+	return err
+}
+
+func (pr *mongodbPostRepository) MigrateVisualMediaUrls(ctx context.Context) error {
+	filter := bson.M{"visual_media_urls": bson.M{"$exists": true}}
+	extension := bson.M{"$toLower": bson.M{"$arrayElemAt": bson.A{bson.M{"$split": bson.A{"$$url", "."}}, -1}}}
+	update := bson.A{
+		bson.M{"$set": bson.M{
+			"visual_medias": bson.M{"$map": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$visual_media_urls", bson.A{}}},
+				"as":    "url",
+				"in": bson.M{"$let": bson.M{
+					"vars": bson.M{"extension": extension},
+					"in": bson.M{
+						"type": bson.M{"$cond": bson.A{
+							bson.M{"$in": bson.A{"$$extension", bson.A{"mp4", "webm"}}},
+							domain.VisualMediaTypeVideo,
+							domain.VisualMediaTypeImage,
+						}},
+						"mime_type": bson.M{"$switch": bson.M{
+							"branches": bson.A{
+								bson.M{"case": bson.M{"$eq": bson.A{"$$extension", "jpg"}}, "then": "image/jpeg"},
+								bson.M{"case": bson.M{"$eq": bson.A{"$$extension", "png"}}, "then": "image/png"},
+								bson.M{"case": bson.M{"$eq": bson.A{"$$extension", "gif"}}, "then": "image/gif"},
+								bson.M{"case": bson.M{"$eq": bson.A{"$$extension", "tiff"}}, "then": "image/tiff"},
+								bson.M{"case": bson.M{"$eq": bson.A{"$$extension", "mp4"}}, "then": "video/mp4"},
+								bson.M{"case": bson.M{"$eq": bson.A{"$$extension", "webm"}}, "then": "video/webm"},
+							},
+							"default": "application/octet-stream",
+						}},
+						"width":    0,
+						"height":   0,
+						"duration": 0,
+						"size":     0,
+						"alt_text": "",
+						"variants": bson.A{bson.M{"type": domain.VisualMediaVariantOriginal, "url": "$$url"}},
+					},
+				}},
+			}},
+		}},
+		bson.M{"$unset": "visual_media_urls"},
+	}
+	_, err := pr.collection.UpdateMany(ctx, filter, update)
 	return err
 }
@@ -34,9 +34,9 @@ func (pr *PostRepoSuite) AfterTest(suiteName, testName string) {
 }
 
 func (pr *PostRepoSuite) TestInsertPostSuccessful() {
-	newPost := domain.NewPost("postid1", "userid1", []string{}, "caption1", 0, time.Now(), time.Now())
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
-	err := postRepo.InsertPost(newPost)
+	err := postRepo.InsertPost(context.TODO(), newPost)
 
 	var insertedPost domain.Post
 	pr.collection.FindOne(context.TODO(), bson.M{"_id": "postid1"}).Decode(&insertedPost)
@@ -47,28 +47,28 @@ func (pr *PostRepoSuite) TestInsertPostSuccessful() {
 }
 
 func (pr *PostRepoSuite) TestInsertDuplicatePost() {
-	newPost := domain.NewPost("postid1", "userid1", []string{}, "caption1", 0, time.Now(), time.Now())
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
-	_ = postRepo.InsertPost(newPost)
-	err := postRepo.InsertPost(newPost)
+	_ = postRepo.InsertPost(context.TODO(), newPost)
+	err := postRepo.InsertPost(context.TODO(), newPost)
 
 	assert.Error(pr.T(), err, "Should have return error but didn't")
 }
 
 func (pr *PostRepoSuite) TestFindPostsSuccessful() {
 	post := bson.M{
-		"_id":               "postid1",
-		"user_id":           "userid1",
-		"visual_media_urls": nil,
-		"caption":           "caption1",
-		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": nil,
+		"caption":       "caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 	}
 	_, _ = pr.collection.InsertOne(context.TODO(), post)
 
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
 	filter := bson.M{"_id": "postid1"}
-	queryResult, err := postRepo.FindPosts(filter)
+	queryResult, err := postRepo.FindPosts(context.TODO(), filter)
 
 	assert.Equalf(pr.T(), 1, len(*queryResult), "Should have return the correct amount of post %v but got %v", 1, len(*queryResult))
 	assert.Equalf(pr.T(), "postid1", (*queryResult)[0]["_id"], "Should have received the correct postid %s but got %s", "postid1", (*queryResult)[0]["_id"])
@@ -79,36 +79,97 @@ func (pr *PostRepoSuite) TestFindPostsSuccessful() {
 
 func (pr *PostRepoSuite) TestFindNotExistPosts() {
 	post := bson.M{
-		"_id":               "postid1",
-		"user_id":           "userid1",
-		"visual_media_urls": nil,
-		"caption":           "caption1",
-		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": nil,
+		"caption":       "caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 	}
 	_, _ = pr.collection.InsertOne(context.TODO(), post)
 
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
 	filter := bson.M{"_id": "nonExistPost"}
-	queryResult, err := postRepo.FindPosts(filter)
+	queryResult, err := postRepo.FindPosts(context.TODO(), filter)
 
 	assert.Equalf(pr.T(), 0, len(*queryResult), "Should have return the correct amount of post %v but got %v", 0, len(*queryResult))
 	assert.NoError(pr.T(), err, "Should have not returned error")
 }
 
+func (pr *PostRepoSuite) TestFindPaginatedPostsSuccessful() {
+	now := time.Now()
+	posts := []interface{}{
+		bson.M{"_id": "postid1", "user_id": "userid1", "caption": "#golang", "hashtags": bson.A{"golang"}, "created_date": primitive.NewDateTimeFromTime(now.Add(-2 * time.Hour))},
+		bson.M{"_id": "postid2", "user_id": "userid1", "caption": "#golang", "hashtags": bson.A{"golang"}, "created_date": primitive.NewDateTimeFromTime(now.Add(-1 * time.Hour))},
+		bson.M{"_id": "postid3", "user_id": "userid1", "caption": "#mongodb", "hashtags": bson.A{"mongodb"}, "created_date": primitive.NewDateTimeFromTime(now)},
+		bson.M{"_id": "postid4", "user_id": "userid1", "caption": "#golang", "hashtags": bson.A{"golang"}, "created_date": primitive.NewDateTimeFromTime(now)},
+	}
+	_, _ = pr.collection.InsertMany(context.TODO(), posts)
+
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.CreateIndexes(context.TODO())
+	queryResult, err := postRepo.FindPaginatedPosts(context.TODO(), bson.M{"hashtags": "golang"}, 1, 1)
+
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	assert.Lenf(pr.T(), *queryResult, 1, "Should have return %d post but got %d", 1, len(*queryResult))
+	assert.Equalf(pr.T(), "postid2", (*queryResult)[0]["_id"], "Should have return post %s but got %s", "postid2", (*queryResult)[0]["_id"])
+}
+
+func (pr *PostRepoSuite) TestFindPaginatedPostsByTaggedUserSuccessful() {
+	now := time.Now()
+	posts := []interface{}{
+		bson.M{"_id": "postid1", "user_id": "userid1", "visual_medias": bson.A{bson.M{"user_tags": bson.A{bson.M{"user_id": "userid2", "x": 0.5, "y": 0.5}}}}, "created_date": primitive.NewDateTimeFromTime(now.Add(-1 * time.Hour))},
+		bson.M{"_id": "postid2", "user_id": "userid1", "visual_medias": bson.A{bson.M{}, bson.M{"user_tags": bson.A{bson.M{"user_id": "userid2", "x": 0.1, "y": 0.1}}}}, "created_date": primitive.NewDateTimeFromTime(now)},
+		bson.M{"_id": "postid3", "user_id": "userid1", "visual_medias": bson.A{bson.M{"user_tags": bson.A{bson.M{"user_id": "userid3", "x": 0.5, "y": 0.5}}}}, "created_date": primitive.NewDateTimeFromTime(now)},
+	}
+	_, _ = pr.collection.InsertMany(context.TODO(), posts)
+
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.CreateIndexes(context.TODO())
+	queryResult, err := postRepo.FindPaginatedPosts(context.TODO(), bson.M{"visual_medias.user_tags.user_id": "userid2"}, 0, 10)
+
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	assert.Lenf(pr.T(), *queryResult, 2, "Should have return %d posts but got %d", 2, len(*queryResult))
+	assert.Equalf(pr.T(), "postid2", (*queryResult)[0]["_id"], "Should have return post %s but got %s", "postid2", (*queryResult)[0]["_id"])
+}
+
+func (pr *PostRepoSuite) TestFindPaginatedPostsNearbySuccessful() {
+	now := time.Now()
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.CreateIndexes(context.TODO())
+	operaHouse := domain.NewPost("postid1", "userid1", nil, "caption1", 0, now, now)
+	operaHouse.Location = domain.NewLocation("location-1", "Sydney Opera House", domain.NewGeoPoint(-33.8568, 151.2153))
+	harbourBridge := domain.NewPost("postid2", "userid1", nil, "caption2", 0, now, now)
+	harbourBridge.Location = domain.NewLocation("location-2", "Sydney Harbour Bridge", domain.NewGeoPoint(-33.8523, 151.2108))
+	melbourne := domain.NewPost("postid3", "userid1", nil, "caption3", 0, now, now)
+	melbourne.Location = domain.NewLocation("location-3", "Melbourne", domain.NewGeoPoint(-37.8136, 144.9631))
+	noLocation := domain.NewPost("postid4", "userid1", nil, "caption4", 0, now, now)
+	for _, post := range []*domain.Post{operaHouse, harbourBridge, melbourne, noLocation} {
+		_ = postRepo.InsertPost(context.TODO(), post)
+	}
+
+	filter := bson.M{"location.point": bson.M{"$geoWithin": bson.M{"$centerSphere": bson.A{[]float64{151.2153, -33.8568}, 1000.0 / 6378100}}}}
+	queryResult, err := postRepo.FindPaginatedPosts(context.TODO(), filter, 0, 10)
+
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	assert.Lenf(pr.T(), *queryResult, 2, "Should have return %d posts but got %d", 2, len(*queryResult))
+	_, hasLocation := (*queryResult)[0]["location"]
+	assert.Truef(pr.T(), hasLocation, "Should have stored the location")
+}
+
 func (pr *PostRepoSuite) TestFindOnePostSuccessful() {
 	post := bson.M{
-		"_id":               "postid1",
-		"user_id":           "userid1",
-		"visual_media_urls": nil,
-		"caption":           "caption1",
-		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": nil,
+		"caption":       "caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 	}
 	_, _ = pr.collection.InsertOne(context.TODO(), post)
 
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
-	foundPost, err := postRepo.FindOnePost("postid1")
+	foundPost, err := postRepo.FindOnePost(context.TODO(), "postid1")
 	assert.Equalf(pr.T(), "postid1", foundPost.Id, "Should have return the correct _id %s but got %s", "postid1", foundPost.Id)
 	assert.Equalf(pr.T(), "userid1", foundPost.UserId, "Should have return the correct user_id %s but got %s", "userid1", foundPost.UserId)
 	assert.Equalf(pr.T(), "caption1", foundPost.Caption, "Should have return the correct caption %s but got %s", "caption1", foundPost.Caption)
@@ -117,23 +178,23 @@ func (pr *PostRepoSuite) TestFindOnePostSuccessful() {
 
 func (pr *PostRepoSuite) TestFindOneNotExistPost() {
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
-	_, err := postRepo.FindOnePost("notExistPostId")
+	_, err := postRepo.FindOnePost(context.TODO(), "notExistPostId")
 	assert.Error(pr.T(), err, "Should return error but didn't")
 }
 
 func (pr *PostRepoSuite) TestUpdateNotExistPost() {
 	post := bson.M{
-		"_id":               "postid1",
-		"user_id":           "userid1",
-		"visual_media_urls": nil,
-		"caption":           "caption1",
-		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": nil,
+		"caption":       "caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 	}
 	_, _ = pr.collection.InsertOne(context.TODO(), post)
 
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
-	err := postRepo.UpdatePost("notExistPostId", "newcaption1")
+	err := postRepo.UpdatePost(context.TODO(), "notExistPostId", "newcaption1", nil, nil)
 
 	var notUpdatedPost domain.Post
 	pr.collection.FindOne(context.TODO(), bson.M{"_id": "postid1"}).Decode(&notUpdatedPost)
@@ -145,39 +206,121 @@ func (pr *PostRepoSuite) TestUpdateNotExistPost() {
 
 func (pr *PostRepoSuite) TestUpdatePostSuccessful() {
 	post := bson.M{
-		"_id":               "postid1",
-		"user_id":           "userid1",
-		"visual_media_urls": nil,
-		"caption":           "caption1",
-		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": nil,
+		"caption":       "caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 	}
 	_, _ = pr.collection.InsertOne(context.TODO(), post)
 
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
-	err := postRepo.UpdatePost("postid1", "newcaption1")
+	mentions := []domain.Mention{*domain.NewMention("userid2", "username2", 20, 10)}
+	err := postRepo.UpdatePost(context.TODO(), "postid1", "newcaption1 #golang @username2", []string{"golang"}, mentions)
 
 	var updatedPost domain.Post
 	pr.collection.FindOne(context.TODO(), bson.M{"_id": "postid1"}).Decode(&updatedPost)
 	assert.Equalf(pr.T(), "postid1", updatedPost.Id, "Should have received the correct _id %s but got %s", "postid1", updatedPost.Id)
 	assert.Equalf(pr.T(), "userid1", updatedPost.UserId, "Should have received the correct user_id %s but got %s", "userid1", updatedPost.UserId)
-	assert.Equalf(pr.T(), "newcaption1", updatedPost.Caption, "Should have received the correct caption %s but got %s", "newcaption1", updatedPost.Caption)
+	assert.Equalf(pr.T(), "newcaption1 #golang @username2", updatedPost.Caption, "Should have received the correct caption %s but got %s", "newcaption1 #golang @username2", updatedPost.Caption)
+	assert.Equalf(pr.T(), mentions, updatedPost.Mentions, "Should have received the correct mentions %v but got %v", mentions, updatedPost.Mentions)
+	assert.Equalf(pr.T(), []string{"golang"}, updatedPost.Hashtags, "Should have received the correct hashtags %v but got %v", []string{"golang"}, updatedPost.Hashtags)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+}
+
+func (pr *PostRepoSuite) TestUpdatePostVisualMediasSuccessful() {
+	post := bson.M{
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": nil,
+		"caption":       "caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
+	}
+	_, _ = pr.collection.InsertOne(context.TODO(), post)
+
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	visualMedias := []domain.VisualMedia{
+		*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/png", 10, 20, 0, 100, "alt1", []domain.VisualMediaVariant{*domain.NewVisualMediaVariant(domain.VisualMediaVariantOriginal, "png.png")}),
+		*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 30, 40, 0, 200, "alt2", []domain.VisualMediaVariant{*domain.NewVisualMediaVariant(domain.VisualMediaVariantOriginal, "jpg.jpg")}),
+	}
+	err := postRepo.UpdatePostVisualMedias(context.TODO(), "postid1", visualMedias)
+
+	var updatedPost domain.Post
+	pr.collection.FindOne(context.TODO(), bson.M{"_id": "postid1"}).Decode(&updatedPost)
+	assert.Equalf(pr.T(), visualMedias, updatedPost.VisualMedias, "Should have stored visual medias %v but got %v", visualMedias, updatedPost.VisualMedias)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+}
+
+func (pr *PostRepoSuite) TestUpdatePostStatusSuccessful() {
+	now := time.Now()
+	publishAt := now.Add(time.Hour).Truncate(time.Millisecond)
+	post := domain.NewPost("postid1", "userid1", nil, "caption1", 0, now, now)
+	post.Status = domain.PostStatusDraft
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.InsertPost(context.TODO(), post)
+
+	updated, err := postRepo.UpdatePostStatus(context.TODO(), "postid1", domain.PostStatusDraft, domain.PostStatusPublished, &publishAt)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	assert.Truef(pr.T(), updated, "Should have updated the post status")
+
+	updated, err = postRepo.UpdatePostStatus(context.TODO(), "postid1", domain.PostStatusDraft, domain.PostStatusPublished, &publishAt)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	assert.Falsef(pr.T(), updated, "Should have not updated a post no longer in draft")
+
+	foundPost, _ := postRepo.FindOnePost(context.TODO(), "postid1")
+	assert.Equalf(pr.T(), domain.PostStatusPublished, foundPost.Status, "Should have status %s but got %s", domain.PostStatusPublished, foundPost.Status)
+	assert.Truef(pr.T(), publishAt.Equal(foundPost.CreatedDate), "Should have moved created date to %v but got %v", publishAt, foundPost.CreatedDate)
+}
+
+func (pr *PostRepoSuite) TestUpdatePostArchivedDateSuccessful() {
+	now := time.Now()
+	archivedDate := now.Truncate(time.Millisecond)
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.InsertPost(context.TODO(), domain.NewPost("postid1", "userid1", nil, "caption1", 0, now, now))
+
+	err := postRepo.UpdatePostArchivedDate(context.TODO(), "postid1", &archivedDate)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	queryResult, _ := postRepo.FindPosts(context.TODO(), bson.M{"archived_date": nil})
+	assert.Lenf(pr.T(), *queryResult, 0, "Should have return %d posts but got %d", 0, len(*queryResult))
+
+	err = postRepo.UpdatePostArchivedDate(context.TODO(), "postid1", nil)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	queryResult, _ = postRepo.FindPosts(context.TODO(), bson.M{"archived_date": nil})
+	assert.Lenf(pr.T(), *queryResult, 1, "Should have return %d post but got %d", 1, len(*queryResult))
+}
+
+func (pr *PostRepoSuite) TestUpdatePostDeletedAtSuccessful() {
+	now := time.Now()
+	deletedAt := now.Add(-time.Hour)
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.InsertPost(context.TODO(), domain.NewPost("postid1", "userid1", nil, "caption1", 0, now, now))
+
+	err := postRepo.UpdatePostDeletedAt(context.TODO(), "postid1", &deletedAt)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	queryResult, _ := postRepo.FindPosts(context.TODO(), bson.M{"deleted_at": bson.M{"$lte": now}})
+	assert.Lenf(pr.T(), *queryResult, 1, "Should have return %d post but got %d", 1, len(*queryResult))
+
+	err = postRepo.UpdatePostDeletedAt(context.TODO(), "postid1", nil)
 	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	queryResult, _ = postRepo.FindPosts(context.TODO(), bson.M{"deleted_at": nil})
+	assert.Lenf(pr.T(), *queryResult, 1, "Should have return %d post but got %d", 1, len(*queryResult))
 }
 
 func (pr *PostRepoSuite) TestDeletePostSuccessful() {
 	post := bson.M{
-		"_id":               "postid1",
-		"user_id":           "userid1",
-		"visual_media_urls": nil,
-		"caption":           "caption1",
-		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": nil,
+		"caption":       "caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 	}
 	_, _ = pr.collection.InsertOne(context.TODO(), post)
 
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
-	err := postRepo.DeletePost("postid1")
+	err := postRepo.DeletePost(context.TODO(), "postid1")
 
 	filter := bson.M{"_id": "postid1"}
 	cursor, _ := pr.collection.Find(context.TODO(), filter)
@@ -190,16 +333,16 @@ func (pr *PostRepoSuite) TestDeletePostSuccessful() {
 
 func (pr *PostRepoSuite) TestDeleteNotExistPost() {
 	post := bson.M{
-		"_id":               "postid1",
-		"user_id":           "userid1",
-		"visual_media_urls": nil,
-		"caption":           "caption1",
-		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": nil,
+		"caption":       "caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 	}
 	_, _ = pr.collection.InsertOne(context.TODO(), post)
 	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
-	err := postRepo.DeletePost("notExistPostId")
+	err := postRepo.DeletePost(context.TODO(), "notExistPostId")
 
 	filter := bson.M{}
 	cursor, _ := pr.collection.Find(context.TODO(), filter)
@@ -209,3 +352,73 @@ func (pr *PostRepoSuite) TestDeleteNotExistPost() {
 	assert.Equalf(pr.T(), 1, len(queryResult), "Should have return the correct amount of post %v but got %v", 1, len(queryResult))
 	assert.NoError(pr.T(), err, "Should have not return error")
 }
+
+func (pr *PostRepoSuite) TestMigrateVisualMediaUrlsSuccessful() {
+	post := bson.M{
+		"_id":               "postid1",
+		"user_id":           "userid1",
+		"visual_media_urls": bson.A{"./visual_medias/postid10.jpg", "./visual_medias/postid11.mp4"},
+		"caption":           "caption1",
+		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+	}
+	_, _ = pr.collection.InsertOne(context.TODO(), post)
+
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	err := postRepo.MigrateVisualMediaUrls(context.TODO())
+
+	var migratedPost domain.Post
+	pr.collection.FindOne(context.TODO(), bson.M{"_id": "postid1"}).Decode(&migratedPost)
+	assert.Equalf(pr.T(), 2, len(migratedPost.VisualMedias), "Should have migrated %v visual medias but got %v", 2, len(migratedPost.VisualMedias))
+	assert.Equalf(pr.T(), domain.VisualMediaTypeImage, migratedPost.VisualMedias[0].Type, "Should have type %s but got %s", domain.VisualMediaTypeImage, migratedPost.VisualMedias[0].Type)
+	assert.Equalf(pr.T(), "image/jpeg", migratedPost.VisualMedias[0].MimeType, "Should have mime type %s but got %s", "image/jpeg", migratedPost.VisualMedias[0].MimeType)
+	assert.Equalf(pr.T(), domain.VisualMediaTypeVideo, migratedPost.VisualMedias[1].Type, "Should have type %s but got %s", domain.VisualMediaTypeVideo, migratedPost.VisualMedias[1].Type)
+	assert.Equalf(pr.T(), "./visual_medias/postid11.mp4", migratedPost.VisualMedias[1].Variants[0].Url, "Should have kept url %s but got %s", "./visual_medias/postid11.mp4", migratedPost.VisualMedias[1].Variants[0].Url)
+	count, _ := pr.collection.CountDocuments(context.TODO(), bson.M{"visual_media_urls": bson.M{"$exists": true}})
+	assert.Equalf(pr.T(), int64(0), count, "Should have removed visual_media_urls but %v posts still have it", count)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+}
+
+func (pr *PostRepoSuite) TestUpdatePostArchivedDateUnpinsPost() {
+	now := time.Now()
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.InsertPost(context.TODO(), domain.NewPost("postid1", "userid1", nil, "caption1", 0, now, now))
+	_, _ = postRepo.UpdatePostPinnedDate(context.TODO(), "postid1", &now)
+	queryResult, _ := postRepo.FindPosts(context.TODO(), bson.M{"pinned_date": bson.M{"$ne": nil}})
+	assert.Lenf(pr.T(), *queryResult, 1, "Should have return %d post but got %d", 1, len(*queryResult))
+
+	err := postRepo.UpdatePostArchivedDate(context.TODO(), "postid1", &now)
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	queryResult, _ = postRepo.FindPosts(context.TODO(), bson.M{"pinned_date": bson.M{"$ne": nil}})
+	assert.Lenf(pr.T(), *queryResult, 0, "Should have return %d posts but got %d", 0, len(*queryResult))
+}
+
+func (pr *PostRepoSuite) TestUpdatePostPinnedDateOnlyOnce() {
+	now := time.Now()
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.InsertPost(context.TODO(), domain.NewPost("postid1", "userid1", nil, "caption1", 0, now, now))
+
+	pinned, err := postRepo.UpdatePostPinnedDate(context.TODO(), "postid1", &now)
+	pinnedAgain, _ := postRepo.UpdatePostPinnedDate(context.TODO(), "postid1", &now)
+	unpinned, _ := postRepo.UpdatePostPinnedDate(context.TODO(), "postid1", nil)
+	unpinnedAgain, _ := postRepo.UpdatePostPinnedDate(context.TODO(), "postid1", nil)
+
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	assert.Truef(pr.T(), pinned, "Should have pinned the post")
+	assert.Falsef(pr.T(), pinnedAgain, "Should have not pinned an already pinned post")
+	assert.Truef(pr.T(), unpinned, "Should have unpinned the post")
+	assert.Falsef(pr.T(), unpinnedAgain, "Should have not unpinned an already unpinned post")
+}
+
+func (pr *PostRepoSuite) TestUpdatePostSettingsSuccessful() {
+	now := time.Now()
+	postRepo := mongodb.NewMongodbPostRepository(pr.collection)
+	_ = postRepo.InsertPost(context.TODO(), domain.NewPost("postid1", "userid1", nil, "caption1", 0, now, now))
+
+	err := postRepo.UpdatePostSettings(context.TODO(), "postid1", true, true)
+	post, _ := postRepo.FindOnePost(context.TODO(), "postid1")
+
+	assert.NoErrorf(pr.T(), err, "Should have not return error but got %s", err)
+	assert.Truef(pr.T(), post.CommentsDisabled, "Should have disabled comments")
+	assert.Truef(pr.T(), post.HideLikeCount, "Should have hidden the like count")
+}
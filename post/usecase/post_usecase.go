@@ -1,14 +1,15 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"instagram-go/domain"
 	"mime/multipart"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,24 +17,39 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const earthRadius = 6378100
+
 type postUsecase struct {
-	postRepository domain.PostRepository
-	likeRepository domain.LikeRepository
-	headerHelper   domain.IHeaderHelper
-	fileOsHelper   domain.IFileOsHelper
-	sync.Mutex
+	postRepository       domain.PostRepository
+	likeRepository       domain.LikeRepository
+	commentRepository    domain.CommentRepository
+	hashtagRepository    domain.HashtagRepository
+	userRepository       domain.UserRepository
+	mentionRepository    domain.MentionRepository
+	saveRepository       domain.SaveRepository
+	collectionRepository domain.CollectionRepository
+	highlightRepository  domain.HighlightRepository
+	headerHelper         domain.IHeaderHelper
+	fileOsHelper         domain.IFileOsHelper
 }
 
-func NewPostUseCase(postRepository domain.PostRepository, likeRepository domain.LikeRepository, headerHelper domain.IHeaderHelper, fileOsHelper domain.IFileOsHelper) domain.PostUsecase {
+func NewPostUseCase(postRepository domain.PostRepository, likeRepository domain.LikeRepository, commentRepository domain.CommentRepository, hashtagRepository domain.HashtagRepository, userRepository domain.UserRepository, mentionRepository domain.MentionRepository, saveRepository domain.SaveRepository, collectionRepository domain.CollectionRepository, highlightRepository domain.HighlightRepository, headerHelper domain.IHeaderHelper, fileOsHelper domain.IFileOsHelper) domain.PostUsecase {
 	return &postUsecase{
-		postRepository: postRepository,
-		likeRepository: likeRepository,
-		fileOsHelper:   fileOsHelper,
-		headerHelper:   headerHelper,
+		postRepository:       postRepository,
+		likeRepository:       likeRepository,
+		commentRepository:    commentRepository,
+		hashtagRepository:    hashtagRepository,
+		userRepository:       userRepository,
+		mentionRepository:    mentionRepository,
+		saveRepository:       saveRepository,
+		collectionRepository: collectionRepository,
+		highlightRepository:  highlightRepository,
+		fileOsHelper:         fileOsHelper,
+		headerHelper:         headerHelper,
 	}
 }
 
-func (pu *postUsecase) InsertPost(post *domain.Post, tokenString string, visualMedias []*multipart.FileHeader) error {
+func (pu *postUsecase) InsertPost(ctx context.Context, post *domain.Post, tokenString string, visualMedias []*multipart.FileHeader) error {
 	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
@@ -45,88 +61,411 @@ func (pu *postUsecase) InsertPost(post *domain.Post, tokenString string, visualM
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	for k, v := range visualMedias {
-		visualMedia, err := v.Open()
+	altTexts := make([]string, len(visualMedias))
+	userTags := make([][]domain.UserTag, len(visualMedias))
+	for k, v := range post.VisualMedias {
+		if k < len(altTexts) {
+			altTexts[k] = v.AltText
+			userTags[k] = v.UserTags
+		} else if len(v.UserTags) > 0 {
+			return domain.ErrInvalidUserTags
+		}
+	}
+	err = pu.validateUserTags(ctx, userTags)
+	if err != nil {
+		return err
+	}
+	if post.Location != nil {
+		err = pu.resolveLocation(post.Location, visualMedias)
 		if err != nil {
-			return domain.ErrInternalServerError
+			return err
 		}
-		defer visualMedia.Close()
+	}
+	now := time.Now()
+	if post.Status == "" {
+		post.Status = domain.PostStatusPublished
+	}
+	if !isValidPostStatus(post.Status, post.PublishAt, now) {
+		return domain.ErrInvalidPostStatus
+	}
+	if post.Status == domain.PostStatusPublished {
+		post.PublishAt = &now
+	}
+	post.VisualMedias = nil
+	for k, v := range visualMedias {
 		fileNameParts := strings.Split(v.Filename, ".")
 		extension := fileNameParts[len(fileNameParts)-1]
 		visualMediaUrl := "./visual_medias/" + post.Id + strconv.Itoa(k) + "." + extension
-		out, err := pu.fileOsHelper.Create(visualMediaUrl)
+		visualMedia, err := domain.SaveVisualMedia(pu.fileOsHelper, v, visualMediaUrl, altTexts[k])
 		if err != nil {
 			return domain.ErrInternalServerError
 		}
-		defer out.Close()
-		_, err = pu.fileOsHelper.Copy(out, visualMedia)
-		if err != nil {
-			return domain.ErrInternalServerError
-		}
-		post.VisualMediaUrls = append(post.VisualMediaUrls, visualMediaUrl)
+		visualMedia.UserTags = userTags[k]
+		post.VisualMedias = append(post.VisualMedias, *visualMedia)
+	}
+	post.Hashtags = domain.ExtractHashtags(post.Caption)
+	post.Mentions, err = domain.ResolveMentions(ctx, pu.userRepository, post.Caption)
+	if err != nil {
+		return domain.ErrInternalServerError
 	}
-	post.CreatedDate = time.Now()
+	post.CreatedDate = now
 	post.UpdatedDate = post.CreatedDate
 
-	pu.Lock()
-	err = pu.postRepository.InsertPost(post)
-	pu.Unlock()
+	err = pu.postRepository.InsertPost(ctx, post)
+
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if !post.IsPublic() {
+		return nil
+	}
+
+	err = pu.hashtagRepository.UpdateHashtagPostCounts(ctx, post.Hashtags, 1)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	userMentions := domain.NewUserMentions(post.Mentions, post.UserId, post.Id, post.Id, "post", post.CreatedDate)
+	err = pu.mentionRepository.InsertMentions(ctx, userMentions)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (pu *postUsecase) validateUserTags(ctx context.Context, userTags [][]domain.UserTag) error {
+	var userIds []string
+	seen := make(map[string]bool)
+	for _, visualMediaUserTags := range userTags {
+		if len(visualMediaUserTags) > domain.MaxUserTagsPerVisualMedia {
+			return domain.ErrInvalidUserTags
+		}
+		tagged := make(map[string]bool)
+		for _, userTag := range visualMediaUserTags {
+			if userTag.UserId == "" || tagged[userTag.UserId] ||
+				userTag.X < 0 || userTag.X > 1 || userTag.Y < 0 || userTag.Y > 1 {
+				return domain.ErrInvalidUserTags
+			}
+			tagged[userTag.UserId] = true
+			if !seen[userTag.UserId] {
+				seen[userTag.UserId] = true
+				userIds = append(userIds, userTag.UserId)
+			}
+		}
+	}
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": userIds}}
+	queryResult, err := pu.userRepository.FindUser(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) != len(userIds) {
+		return domain.ErrTaggedUserNotFound
+	}
+	return nil
+}
+
+func isValidPostStatus(status string, publishAt *time.Time, now time.Time) bool {
+	switch status {
+	case domain.PostStatusDraft, domain.PostStatusPublished:
+		return publishAt == nil
+	case domain.PostStatusScheduled:
+		return publishAt != nil && publishAt.After(now)
+	}
+	return false
+}
+
+// A location without coordinates takes them from the exif data of the visual medias.
+func (pu *postUsecase) resolveLocation(location *domain.Location, visualMedias []*multipart.FileHeader) error {
+	location.Name = strings.TrimSpace(location.Name)
+	if location.Point == nil {
+		for _, v := range visualMedias {
+			file, err := v.Open()
+			if err != nil {
+				return domain.ErrInternalServerError
+			}
+			point, err := pu.fileOsHelper.DecodeImageGPS(file)
+			file.Close()
+			if err == nil {
+				location.Point = point
+				break
+			}
+		}
+		if location.Point == nil {
+			return domain.ErrMissingLocationPoint
+		}
+	}
+	if location.Name == "" || !location.Point.IsValid() {
+		return domain.ErrInvalidLocation
+	}
+	location.Id = domain.NewLocationId(location.Name, location.Point)
+	return nil
+}
+
+func (pu *postUsecase) FindPosts(ctx context.Context, tokenString string) (*[]domain.Post, error) {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := domain.VisiblePostFilter(bson.M{}, userId)
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return pu.buildViewedPosts(ctx, queryResult, userId)
+}
+
+func (pu *postUsecase) buildViewedPosts(ctx context.Context, queryResult *[]bson.M, viewerId string) (*[]domain.Post, error) {
+	posts, err := pu.buildPosts(ctx, queryResult, viewerId)
+	if err != nil {
+		return nil, err
+	}
+	err = pu.enrichPosts(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
 
+func (pu *postUsecase) enrichPosts(ctx context.Context, posts *[]domain.Post, viewerId string) error {
+	var postIds []string
+	for _, post := range *posts {
+		postIds = append(postIds, post.Id)
+	}
+	reactions, err := domain.FindViewerReactions(ctx, pu.likeRepository, viewerId, domain.LikeResourcePost, postIds)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	saves, err := domain.FindViewerSaves(ctx, pu.saveRepository, viewerId, postIds)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
+	for i := range *posts {
+		post := &(*posts)[i]
+		liked := reactions[post.Id] != ""
+		saved := saves[post.Id]
+		post.ViewerHasLiked = &liked
+		post.ViewerReaction = reactions[post.Id]
+		post.ViewerHasSaved = &saved
+	}
 	return nil
 }
 
-func (pu *postUsecase) FindPosts() (*[]domain.Post, error) {
-	filter := bson.M{}
-	pu.Lock()
-	queryResult, err := pu.postRepository.FindPosts(filter)
-	pu.Unlock()
+func publicFilter(filter bson.M) bson.M {
+	filter["status"] = bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}}
+	filter["archived_date"] = nil
+	filter["deleted_at"] = nil
+	return filter
+}
+
+func (pu *postUsecase) FindHashtagPosts(ctx context.Context, tag string, page int, limit int, tokenString string) (*[]domain.Post, error) {
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := publicFilter(bson.M{"hashtags": domain.NormalizeHashtag(tag)})
+	queryResult, err := pu.postRepository.FindPaginatedPosts(ctx, filter, skip, pageLimit)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return pu.buildViewedPosts(ctx, queryResult, viewerId)
+}
+
+func (pu *postUsecase) FindUserTaggedPosts(ctx context.Context, userId string, page int, limit int, tokenString string) (*[]domain.Post, error) {
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": userId}
+	queryResult, err := pu.userRepository.FindUser(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrUserNotFound
+	}
+
+	filter = publicFilter(bson.M{"visual_medias.user_tags.user_id": userId})
+	queryResult, err = pu.postRepository.FindPaginatedPosts(ctx, filter, skip, pageLimit)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return pu.buildViewedPosts(ctx, queryResult, viewerId)
+}
+
+func (pu *postUsecase) FindLocationPosts(ctx context.Context, locationId string, page int, limit int, tokenString string) (*[]domain.Post, error) {
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := publicFilter(bson.M{"location.id": locationId})
+	queryResult, err := pu.postRepository.FindPaginatedPosts(ctx, filter, skip, pageLimit)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return pu.buildViewedPosts(ctx, queryResult, viewerId)
+}
+
+func (pu *postUsecase) FindNearbyPosts(ctx context.Context, point *domain.GeoPoint, radius float64, page int, limit int, tokenString string) (*[]domain.Post, error) {
+	if !point.IsValid() || radius <= 0 {
+		return nil, domain.ErrInvalidNearbyQuery
+	}
+	if radius > domain.MaxNearbyRadius {
+		radius = domain.MaxNearbyRadius
+	}
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := publicFilter(bson.M{"location.point": bson.M{
+		"$geoWithin": bson.M{"$centerSphere": bson.A{point.Coordinates, radius / earthRadius}},
+	}})
+	queryResult, err := pu.postRepository.FindPaginatedPosts(ctx, filter, skip, pageLimit)
 	if err != nil {
 		return nil, domain.ErrInternalServerError
 	}
+	return pu.buildViewedPosts(ctx, queryResult, viewerId)
+}
+
+// The like count of a post whose author hid it is left out unless viewerId is the author.
+func (pu *postUsecase) buildPosts(ctx context.Context, queryResult *[]bson.M, viewerId string) (*[]domain.Post, error) {
 	var posts []domain.Post
+	if len(*queryResult) == 0 {
+		return &posts, nil
+	}
+	var postIds []string
+	for _, v := range *queryResult {
+		postIds = append(postIds, fmt.Sprintf("%v", v["_id"]))
+	}
+	postReactionCounts, err := pu.likeRepository.CountReactions(ctx, domain.LikeResourcePost, postIds)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
 	for _, v := range *queryResult {
 		id := fmt.Sprintf("%v", v["_id"])
 		userId := fmt.Sprintf("%v", v["user_id"])
-		var visualMediaUrls []string
-
-		if visualMediaUrlsPrimitive, ok := v["visual_media_urls"].(primitive.A); ok {
-			visualMediaUrlsInterface := []interface{}(visualMediaUrlsPrimitive)
-			visualMediaUrls = make([]string, len(visualMediaUrlsInterface))
-			for i, url := range visualMediaUrlsInterface {
-				visualMediaUrls[i] = url.(string)
-			}
+		visualMedias, err := decodeVisualMedias(v["visual_medias"])
+		if err != nil {
+			return nil, domain.ErrInternalServerError
 		}
 		caption := fmt.Sprintf("%v", v["caption"])
 		createdDate := v["created_date"].(primitive.DateTime).Time()
 		updatedDate := v["updated_date"].(primitive.DateTime).Time()
 
-		filter := bson.M{"resource_id": id, "resource_type": "post"}
-		pu.Lock()
-		likes, err := pu.likeRepository.FindLikes(filter)
-		pu.Unlock()
+		reactionCounts := postReactionCounts[id]
+		if reactionCounts == nil {
+			reactionCounts = map[string]int{}
+		}
+		post := domain.NewPost(id, userId, visualMedias, caption, reactionCounts[domain.ReactionHeart], createdDate, updatedDate)
+		post.ReactionCounts = reactionCounts
+		post.Hashtags = decodeHashtags(v["hashtags"])
+		post.Mentions, err = domain.DecodeMentions(v["mentions"])
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		post.Location, err = decodeLocation(v["location"])
 		if err != nil {
 			return nil, domain.ErrInternalServerError
 		}
-		likeCount := len(*likes)
-		post := domain.NewPost(id, userId, visualMediaUrls, caption, likeCount, createdDate, updatedDate)
+		if status, ok := v["status"].(string); ok {
+			post.Status = status
+		}
+		if publishAt, ok := v["publish_at"].(primitive.DateTime); ok {
+			publishAtTime := publishAt.Time()
+			post.PublishAt = &publishAtTime
+		}
+		if archivedDate, ok := v["archived_date"].(primitive.DateTime); ok {
+			archivedDateTime := archivedDate.Time()
+			post.ArchivedDate = &archivedDateTime
+		}
+		if deletedAt, ok := v["deleted_at"].(primitive.DateTime); ok {
+			deletedAtTime := deletedAt.Time()
+			post.DeletedAt = &deletedAtTime
+		}
+		if pinnedDate, ok := v["pinned_date"].(primitive.DateTime); ok {
+			pinnedDateTime := pinnedDate.Time()
+			post.PinnedDate = &pinnedDateTime
+		}
+		post.CommentsDisabled, _ = v["comments_disabled"].(bool)
+		post.HideLikeCount, _ = v["hide_like_count"].(bool)
+		if post.HideLikeCount && post.UserId != viewerId {
+			post.LikeCount = nil
+			post.ReactionCounts = nil
+		}
 		posts = append(posts, *post)
 	}
 	return &posts, nil
 }
 
-func (pu *postUsecase) UpdatePost(updatedPostId string, newCaption string, tokenString string) error {
+func decodeVisualMedias(value interface{}) ([]domain.VisualMedia, error) {
+	var decoded struct {
+		VisualMedias []domain.VisualMedia `bson:"visual_medias"`
+	}
+	if value == nil {
+		return decoded.VisualMedias, nil
+	}
+	visualMediasBytes, err := bson.Marshal(bson.M{"visual_medias": value})
+	if err != nil {
+		return nil, err
+	}
+	err = bson.Unmarshal(visualMediasBytes, &decoded)
+	return decoded.VisualMedias, err
+}
+
+func decodeLocation(value interface{}) (*domain.Location, error) {
+	var decoded struct {
+		Location *domain.Location `bson:"location"`
+	}
+	if value == nil {
+		return nil, nil
+	}
+	locationBytes, err := bson.Marshal(bson.M{"location": value})
+	if err != nil {
+		return nil, err
+	}
+	err = bson.Unmarshal(locationBytes, &decoded)
+	return decoded.Location, err
+}
+
+func decodeHashtags(value interface{}) []string {
+	values, ok := value.(primitive.A)
+	if !ok {
+		return nil
+	}
+	var hashtags []string
+	for _, v := range values {
+		hashtags = append(hashtags, fmt.Sprintf("%v", v))
+	}
+	return hashtags
+}
+
+func (pu *postUsecase) UpdatePost(ctx context.Context, updatedPostId string, newCaption string, tokenString string) error {
 	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 
-	filter := bson.M{"_id": updatedPostId}
-	pu.Lock()
-	queryResult, err := pu.postRepository.FindPosts(filter)
-	pu.Unlock()
+	filter := bson.M{"_id": updatedPostId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
@@ -134,9 +473,7 @@ func (pu *postUsecase) UpdatePost(updatedPostId string, newCaption string, token
 		return domain.ErrPostNotFound
 	}
 
-	pu.Lock()
-	post, err := pu.postRepository.FindOnePost(updatedPostId)
-	pu.Unlock()
+	post, err := pu.postRepository.FindOnePost(ctx, updatedPostId)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
@@ -144,24 +481,49 @@ func (pu *postUsecase) UpdatePost(updatedPostId string, newCaption string, token
 		return domain.ErrUnauthorizedPostUpdate
 	}
 
-	pu.Lock()
-	err = pu.postRepository.UpdatePost(updatedPostId, newCaption)
-	pu.Unlock()
+	newHashtags := domain.ExtractHashtags(newCaption)
+	newMentions, err := domain.ResolveMentions(ctx, pu.userRepository, newCaption)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = pu.postRepository.UpdatePost(ctx, updatedPostId, newCaption, newHashtags, newMentions)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if !post.IsPublic() {
+		return nil
+	}
+
+	addedHashtags, removedHashtags := domain.DiffHashtags(post.Hashtags, newHashtags)
+	err = pu.hashtagRepository.UpdateHashtagPostCounts(ctx, addedHashtags, 1)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = pu.hashtagRepository.UpdateHashtagPostCounts(ctx, removedHashtags, -1)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	err = pu.mentionRepository.DeleteMentions(ctx, updatedPostId, "post")
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	userMentions := domain.NewUserMentions(newMentions, post.UserId, updatedPostId, updatedPostId, "post", post.CreatedDate)
+	err = pu.mentionRepository.InsertMentions(ctx, userMentions)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	return nil
 }
 
-func (pu *postUsecase) DeletePost(deletedPostId string, tokenString string) error {
+func (pu *postUsecase) UpdatePostVisualMedias(ctx context.Context, updatedPostId string, visualMediaOrder []int, newVisualMedias []*multipart.FileHeader, newAltTexts []string, tokenString string) error {
 	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
-	filter := bson.M{"_id": deletedPostId}
-	pu.Lock()
-	queryResult, err := pu.postRepository.FindPosts(filter)
-	pu.Unlock()
+
+	filter := bson.M{"_id": updatedPostId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
@@ -169,21 +531,784 @@ func (pu *postUsecase) DeletePost(deletedPostId string, tokenString string) erro
 		return domain.ErrPostNotFound
 	}
 
-	pu.Lock()
-	post, err := pu.postRepository.FindOnePost(deletedPostId)
-	pu.Unlock()
+	post, err := pu.postRepository.FindOnePost(ctx, updatedPostId)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	if post.UserId != userId {
-		return domain.ErrUnauthorizedPostDelete
+		return domain.ErrUnauthorizedPostUpdate
+	}
+
+	kept := make([]bool, len(post.VisualMedias))
+	var visualMedias []domain.VisualMedia
+	for _, index := range visualMediaOrder {
+		if index < 0 || index >= len(post.VisualMedias) || kept[index] {
+			return domain.ErrInvalidVisualMediaOrder
+		}
+		kept[index] = true
+		visualMedias = append(visualMedias, post.VisualMedias[index])
+	}
+	if len(visualMedias)+len(newVisualMedias) == 0 {
+		return domain.ErrMissingVisualMediasInput
+	}
+
+	newPath := filepath.Join(".", "visual_medias")
+	err = pu.fileOsHelper.MkDirAll(newPath, os.ModePerm)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	var savedVisualMedias []domain.VisualMedia
+	for k, v := range newVisualMedias {
+		altText := ""
+		if k < len(newAltTexts) {
+			altText = newAltTexts[k]
+		}
+		fileNameParts := strings.Split(v.Filename, ".")
+		extension := fileNameParts[len(fileNameParts)-1]
+		visualMediaUrl := "./visual_medias/" + post.Id + "-" + uuid.NewString() + "." + extension
+		visualMedia, err := domain.SaveVisualMedia(pu.fileOsHelper, v, visualMediaUrl, altText)
+		if err != nil {
+			domain.RemoveVisualMediaFiles(pu.fileOsHelper, savedVisualMedias)
+			return domain.ErrInternalServerError
+		}
+		savedVisualMedias = append(savedVisualMedias, *visualMedia)
+	}
+	visualMedias = append(visualMedias, savedVisualMedias...)
+
+	err = pu.postRepository.UpdatePostVisualMedias(ctx, updatedPostId, visualMedias)
+	if err != nil {
+		domain.RemoveVisualMediaFiles(pu.fileOsHelper, savedVisualMedias)
+		return domain.ErrInternalServerError
+	}
+
+	var removedVisualMedias []domain.VisualMedia
+	for index, visualMedia := range post.VisualMedias {
+		if !kept[index] {
+			removedVisualMedias = append(removedVisualMedias, visualMedia)
+		}
+	}
+	removedVisualMedias, err = pu.withoutHighlightedVisualMedias(ctx, removedVisualMedias)
+	if err == nil {
+		domain.RemoveVisualMediaFiles(pu.fileOsHelper, removedVisualMedias)
+	}
+	return nil
+}
+
+func (pu *postUsecase) UpdatePostUserTags(ctx context.Context, updatedPostId string, userTags []domain.VisualMediaUserTag, tokenString string) error {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	filter := bson.M{"_id": updatedPostId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrPostNotFound
+	}
+
+	post, err := pu.postRepository.FindOnePost(ctx, updatedPostId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if post.UserId != userId {
+		return domain.ErrUnauthorizedPostUpdate
+	}
+
+	visualMediaUserTags := make([][]domain.UserTag, len(post.VisualMedias))
+	for _, v := range userTags {
+		if v.VisualMediaIndex < 0 || v.VisualMediaIndex >= len(post.VisualMedias) {
+			return domain.ErrInvalidUserTags
+		}
+		userTag := domain.NewUserTag(v.UserId, v.X, v.Y)
+		visualMediaUserTags[v.VisualMediaIndex] = append(visualMediaUserTags[v.VisualMediaIndex], *userTag)
+	}
+	err = pu.validateUserTags(ctx, visualMediaUserTags)
+	if err != nil {
+		return err
+	}
+	for k := range post.VisualMedias {
+		post.VisualMedias[k].UserTags = visualMediaUserTags[k]
 	}
 
-	pu.Lock()
-	err = pu.postRepository.DeletePost(deletedPostId)
-	pu.Unlock()
+	err = pu.postRepository.UpdatePostVisualMedias(ctx, updatedPostId, post.VisualMedias)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	return nil
 }
+
+func (pu *postUsecase) DeletePostUserTag(ctx context.Context, updatedPostId string, tokenString string) error {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	filter := bson.M{"_id": updatedPostId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrPostNotFound
+	}
+
+	post, err := pu.postRepository.FindOnePost(ctx, updatedPostId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	found := false
+	for k, visualMedia := range post.VisualMedias {
+		var userTags []domain.UserTag
+		for _, userTag := range visualMedia.UserTags {
+			if userTag.UserId == userId {
+				found = true
+				continue
+			}
+			userTags = append(userTags, userTag)
+		}
+		post.VisualMedias[k].UserTags = userTags
+	}
+	if !found {
+		return domain.ErrUserTagNotFound
+	}
+
+	err = pu.postRepository.UpdatePostVisualMedias(ctx, updatedPostId, post.VisualMedias)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (pu *postUsecase) UpdatePostStatus(ctx context.Context, updatedPostId string, status string, publishAt *time.Time, tokenString string) error {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	filter := bson.M{"_id": updatedPostId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrPostNotFound
+	}
+
+	post, err := pu.postRepository.FindOnePost(ctx, updatedPostId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if post.UserId != userId {
+		return domain.ErrUnauthorizedPostUpdate
+	}
+	if post.IsPublished() || !isValidPostStatus(status, publishAt, time.Now()) {
+		return domain.ErrInvalidPostStatus
+	}
+
+	if status == domain.PostStatusPublished {
+		return pu.publishPost(ctx, post, time.Now())
+	}
+	updated, err := pu.postRepository.UpdatePostStatus(ctx, updatedPostId, post.Status, status, publishAt)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if !updated {
+		return domain.ErrInvalidPostStatus
+	}
+	return nil
+}
+
+func (pu *postUsecase) PublishScheduledPosts(ctx context.Context) error {
+	now := time.Now()
+	filter := bson.M{"status": domain.PostStatusScheduled, "publish_at": bson.M{"$lte": now}, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	for _, v := range *queryResult {
+		post, err := pu.postRepository.FindOnePost(ctx, fmt.Sprintf("%v", v["_id"]))
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		err = pu.publishPost(ctx, post, now)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A post that another call already published is skipped.
+func (pu *postUsecase) publishPost(ctx context.Context, post *domain.Post, publishedDate time.Time) error {
+	updated, err := pu.postRepository.UpdatePostStatus(ctx, post.Id, post.Status, domain.PostStatusPublished, &publishedDate)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if !updated {
+		return nil
+	}
+	return pu.countPost(ctx, post, publishedDate)
+}
+
+func (pu *postUsecase) countPost(ctx context.Context, post *domain.Post, createdDate time.Time) error {
+	err := pu.hashtagRepository.UpdateHashtagPostCounts(ctx, post.Hashtags, 1)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	userMentions := domain.NewUserMentions(post.Mentions, post.UserId, post.Id, post.Id, "post", createdDate)
+	err = pu.mentionRepository.InsertMentions(ctx, userMentions)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (pu *postUsecase) uncountPost(ctx context.Context, post *domain.Post) error {
+	err := pu.hashtagRepository.UpdateHashtagPostCounts(ctx, post.Hashtags, -1)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = pu.mentionRepository.DeleteMentions(ctx, post.Id, "post")
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (pu *postUsecase) UpdatePostSettings(ctx context.Context, updatedPostId string, commentsDisabled bool, hideLikeCount bool, tokenString string) error {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	filter := bson.M{"_id": updatedPostId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrPostNotFound
+	}
+
+	post, err := pu.postRepository.FindOnePost(ctx, updatedPostId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if post.UserId != userId {
+		return domain.ErrUnauthorizedPostUpdate
+	}
+
+	err = pu.postRepository.UpdatePostSettings(ctx, updatedPostId, commentsDisabled, hideLikeCount)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (pu *postUsecase) ArchivePost(ctx context.Context, archivedPostId string, tokenString string) error {
+	post, err := pu.findArchivablePost(ctx, archivedPostId, tokenString)
+	if err != nil {
+		return err
+	}
+	if !post.IsPublished() {
+		return domain.ErrUnpublishedPostArchive
+	}
+	if post.ArchivedDate != nil {
+		return domain.ErrPostArchiveConflict
+	}
+
+	_, err = pu.releasePinnedSlot(ctx, post)
+	if err != nil {
+		return err
+	}
+	archivedDate := time.Now()
+	err = pu.postRepository.UpdatePostArchivedDate(ctx, archivedPostId, &archivedDate)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return pu.uncountPost(ctx, post)
+}
+
+func (pu *postUsecase) RestorePost(ctx context.Context, restoredPostId string, tokenString string) error {
+	post, err := pu.findArchivablePost(ctx, restoredPostId, tokenString)
+	if err != nil {
+		return err
+	}
+	if post.ArchivedDate == nil {
+		return domain.ErrPostNotArchived
+	}
+
+	err = pu.postRepository.UpdatePostArchivedDate(ctx, restoredPostId, nil)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return pu.countPost(ctx, post, post.CreatedDate)
+}
+
+func (pu *postUsecase) findArchivablePost(ctx context.Context, postId string, tokenString string) (*domain.Post, error) {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+
+	filter := bson.M{"_id": postId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrPostNotFound
+	}
+
+	post, err := pu.postRepository.FindOnePost(ctx, postId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if post.UserId != userId {
+		return nil, domain.ErrUnauthorizedPostArchive
+	}
+	return post, nil
+}
+
+func (pu *postUsecase) FindArchivedPosts(ctx context.Context, userId string, page int, limit int, tokenString string) (*[]domain.Post, error) {
+	callerId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if callerId != userId {
+		return nil, domain.ErrUnauthorizedArchiveView
+	}
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"user_id": userId, "archived_date": bson.M{"$ne": nil}, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPaginatedPosts(ctx, filter, skip, pageLimit)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return pu.buildPosts(ctx, queryResult, userId)
+}
+
+// Saves of posts that are no longer public are skipped, so they come back if the post is restored.
+func (pu *postUsecase) FindSavedPosts(ctx context.Context, collectionId string, page int, limit int, tokenString string) (*[]domain.Post, error) {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"user_id": userId}
+	if collectionId != "" {
+		queryResult, err := pu.collectionRepository.FindCollections(ctx, bson.M{"_id": collectionId})
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		if len(*queryResult) == 0 {
+			return nil, domain.ErrCollectionNotFound
+		}
+		collection, err := pu.collectionRepository.FindOneCollection(ctx, collectionId)
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		if collection.UserId != userId {
+			return nil, domain.ErrUnauthorizedCollectionView
+		}
+		filter["collection_id"] = collectionId
+	}
+	queryResult, err := pu.saveRepository.FindSavedPosts(ctx, filter, publicFilter(bson.M{}), skip, pageLimit)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return pu.buildViewedPosts(ctx, queryResult, userId)
+}
+
+func (pu *postUsecase) FindUserPosts(ctx context.Context, userId string, page int, limit int, tokenString string) (*[]domain.Post, error) {
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	viewerId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": userId}
+	queryResult, err := pu.userRepository.FindUser(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrUserNotFound
+	}
+
+	filter = publicFilter(bson.M{"user_id": userId, "pinned_date": bson.M{"$ne": nil}})
+	pinnedPosts, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	sort.SliceStable(*pinnedPosts, func(i, j int) bool {
+		return (*pinnedPosts)[i]["pinned_date"].(primitive.DateTime) > (*pinnedPosts)[j]["pinned_date"].(primitive.DateTime)
+	})
+
+	var userPosts []bson.M
+	pinnedCount := int64(len(*pinnedPosts))
+	if skip < pinnedCount {
+		end := skip + pageLimit
+		if end > pinnedCount {
+			end = pinnedCount
+		}
+		userPosts = append(userPosts, (*pinnedPosts)[skip:end]...)
+	}
+	otherSkip := skip - pinnedCount
+	if otherSkip < 0 {
+		otherSkip = 0
+	}
+	otherLimit := pageLimit - int64(len(userPosts))
+	if otherLimit > 0 {
+		filter = publicFilter(bson.M{"user_id": userId, "pinned_date": nil})
+		queryResult, err = pu.postRepository.FindPaginatedPosts(ctx, filter, otherSkip, otherLimit)
+		if err != nil {
+			return nil, domain.ErrInternalServerError
+		}
+		userPosts = append(userPosts, *queryResult...)
+	}
+	return pu.buildViewedPosts(ctx, &userPosts, viewerId)
+}
+
+func (pu *postUsecase) PinPost(ctx context.Context, pinnedPostId string, tokenString string) error {
+	post, err := pu.findPinnablePost(ctx, pinnedPostId, tokenString)
+	if err != nil {
+		return err
+	}
+	if !post.IsPublic() {
+		return domain.ErrUnpublishedPostPin
+	}
+	if post.PinnedDate != nil {
+		return domain.ErrPostPinConflict
+	}
+
+	incremented, err := pu.userRepository.IncrementUserPinnedCount(ctx, post.UserId, domain.MaxPinnedPosts)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if !incremented {
+		return domain.ErrPinnedPostLimit
+	}
+
+	pinnedDate := time.Now()
+	pinned, err := pu.postRepository.UpdatePostPinnedDate(ctx, pinnedPostId, &pinnedDate)
+	if err != nil {
+		_ = pu.userRepository.DecrementUserPinnedCount(ctx, post.UserId)
+		return domain.ErrInternalServerError
+	}
+	if !pinned {
+		_ = pu.userRepository.DecrementUserPinnedCount(ctx, post.UserId)
+		return domain.ErrPostPinConflict
+	}
+	return nil
+}
+
+func (pu *postUsecase) UnpinPost(ctx context.Context, unpinnedPostId string, tokenString string) error {
+	post, err := pu.findPinnablePost(ctx, unpinnedPostId, tokenString)
+	if err != nil {
+		return err
+	}
+	if post.PinnedDate == nil {
+		return domain.ErrPostNotPinned
+	}
+
+	unpinned, err := pu.releasePinnedSlot(ctx, post)
+	if err != nil {
+		return err
+	}
+	if !unpinned {
+		return domain.ErrPostNotPinned
+	}
+	return nil
+}
+
+func (pu *postUsecase) releasePinnedSlot(ctx context.Context, post *domain.Post) (bool, error) {
+	unpinned, err := pu.postRepository.UpdatePostPinnedDate(ctx, post.Id, nil)
+	if err != nil {
+		return false, domain.ErrInternalServerError
+	}
+	if !unpinned {
+		return false, nil
+	}
+	err = pu.userRepository.DecrementUserPinnedCount(ctx, post.UserId)
+	if err != nil {
+		return false, domain.ErrInternalServerError
+	}
+	return true, nil
+}
+
+func (pu *postUsecase) findPinnablePost(ctx context.Context, postId string, tokenString string) (*domain.Post, error) {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+
+	filter := bson.M{"_id": postId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrPostNotFound
+	}
+
+	post, err := pu.postRepository.FindOnePost(ctx, postId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if post.UserId != userId {
+		return nil, domain.ErrUnauthorizedPostPin
+	}
+	return post, nil
+}
+
+func (pu *postUsecase) DeletePost(ctx context.Context, deletedPostId string, tokenString string) error {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": deletedPostId, "deleted_at": nil}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrPostNotFound
+	}
+
+	post, err := pu.postRepository.FindOnePost(ctx, deletedPostId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if post.UserId != userId {
+		return domain.ErrUnauthorizedPostDelete
+	}
+
+	_, err = pu.releasePinnedSlot(ctx, post)
+	if err != nil {
+		return err
+	}
+	deletedAt := time.Now()
+	err = pu.postRepository.UpdatePostDeletedAt(ctx, deletedPostId, &deletedAt)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = pu.trashPostComments(ctx, deletedPostId, deletedAt)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if !post.IsPublic() {
+		return nil
+	}
+	return pu.uncountPost(ctx, post)
+}
+
+func (pu *postUsecase) RestoreDeletedPost(ctx context.Context, restoredPostId string, tokenString string) error {
+	userId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": restoredPostId}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrPostNotFound
+	}
+
+	post, err := pu.postRepository.FindOnePost(ctx, restoredPostId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if post.UserId != userId {
+		return domain.ErrUnauthorizedPostRestore
+	}
+	if post.DeletedAt == nil {
+		return domain.ErrPostNotDeleted
+	}
+	if time.Since(*post.DeletedAt) > domain.TrashRetention {
+		return domain.ErrPostNotFound
+	}
+
+	err = pu.postRepository.UpdatePostDeletedAt(ctx, restoredPostId, nil)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = pu.restorePostComments(ctx, restoredPostId, *post.DeletedAt)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	post.DeletedAt = nil
+	if !post.IsPublic() {
+		return nil
+	}
+	return pu.countPost(ctx, post, post.CreatedDate)
+}
+
+func (pu *postUsecase) FindDeletedPosts(ctx context.Context, userId string, page int, limit int, tokenString string) (*[]domain.Post, error) {
+	callerId, err := pu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if callerId != userId {
+		return nil, domain.ErrUnauthorizedTrashView
+	}
+	skip, pageLimit, err := domain.Paginate(page, limit)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"user_id": userId, "deleted_at": bson.M{"$gt": time.Now().Add(-domain.TrashRetention)}}
+	queryResult, err := pu.postRepository.FindPaginatedPosts(ctx, filter, skip, pageLimit)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return pu.buildPosts(ctx, queryResult, userId)
+}
+
+func (pu *postUsecase) PurgeDeletedPosts(ctx context.Context) error {
+	filter := bson.M{"deleted_at": bson.M{"$lte": time.Now().Add(-domain.TrashRetention)}}
+	queryResult, err := pu.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	for _, v := range *queryResult {
+		post, err := pu.postRepository.FindOnePost(ctx, fmt.Sprintf("%v", v["_id"]))
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		err = pu.likeRepository.DeleteLikes(ctx, bson.M{"resource_id": post.Id, "resource_type": "post"})
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		err = pu.saveRepository.DeleteSaves(ctx, bson.M{"post_id": post.Id})
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		err = pu.purgePostComments(ctx, post.Id)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		removedVisualMedias, err := pu.withoutHighlightedVisualMedias(ctx, post.VisualMedias)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		err = pu.postRepository.DeletePost(ctx, post.Id)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		domain.RemoveVisualMediaFiles(pu.fileOsHelper, removedVisualMedias)
+	}
+	return nil
+}
+
+// Trashed comments share the post's deletedAt, which tells them apart on restore.
+func (pu *postUsecase) trashPostComments(ctx context.Context, postId string, deletedAt time.Time) error {
+	filter := bson.M{"post_id": postId, "deleted_at": nil}
+	queryResult, err := pu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if len(*queryResult) == 0 {
+		return nil
+	}
+	err = pu.commentRepository.UpdateCommentsDeletedAt(ctx, filter, &deletedAt)
+	if err != nil {
+		return err
+	}
+	for _, v := range *queryResult {
+		err = pu.hashtagRepository.UpdateHashtagCommentCounts(ctx, decodeHashtags(v["hashtags"]), -1)
+		if err != nil {
+			return err
+		}
+		err = pu.mentionRepository.DeleteMentions(ctx, fmt.Sprintf("%v", v["_id"]), "comment")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pu *postUsecase) restorePostComments(ctx context.Context, postId string, deletedAt time.Time) error {
+	filter := bson.M{"post_id": postId, "deleted_at": deletedAt}
+	queryResult, err := pu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if len(*queryResult) == 0 {
+		return nil
+	}
+	err = pu.commentRepository.UpdateCommentsDeletedAt(ctx, filter, nil)
+	if err != nil {
+		return err
+	}
+	for _, v := range *queryResult {
+		err = pu.hashtagRepository.UpdateHashtagCommentCounts(ctx, decodeHashtags(v["hashtags"]), 1)
+		if err != nil {
+			return err
+		}
+		mentions, err := domain.DecodeMentions(v["mentions"])
+		if err != nil {
+			return err
+		}
+		createdDate := v["created_date"].(primitive.DateTime).Time()
+		userMentions := domain.NewUserMentions(mentions, fmt.Sprintf("%v", v["user_id"]), postId, fmt.Sprintf("%v", v["_id"]), "comment", createdDate)
+		err = pu.mentionRepository.InsertMentions(ctx, userMentions)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pu *postUsecase) purgePostComments(ctx context.Context, postId string) error {
+	filter := bson.M{"post_id": postId}
+	queryResult, err := pu.commentRepository.FindComments(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if len(*queryResult) == 0 {
+		return nil
+	}
+	var commentIds bson.A
+	for _, v := range *queryResult {
+		commentIds = append(commentIds, v["_id"])
+	}
+	err = pu.likeRepository.DeleteLikes(ctx, bson.M{"resource_id": bson.M{"$in": commentIds}, "resource_type": "comment"})
+	if err != nil {
+		return err
+	}
+	return pu.commentRepository.DeleteComments(ctx, filter)
+}
+
+// Files a highlight still references must outlive their post.
+func (pu *postUsecase) withoutHighlightedVisualMedias(ctx context.Context, visualMedias []domain.VisualMedia) ([]domain.VisualMedia, error) {
+	var unreferencedVisualMedias []domain.VisualMedia
+	for _, visualMedia := range visualMedias {
+		var urls bson.A
+		for _, variant := range visualMedia.Variants {
+			urls = append(urls, variant.Url)
+		}
+		filter := bson.M{"items.visual_media.variants.url": bson.M{"$in": urls}}
+		queryResult, err := pu.highlightRepository.FindHighlights(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(*queryResult) == 0 {
+			unreferencedVisualMedias = append(unreferencedVisualMedias, visualMedia)
+		}
+	}
+	return unreferencedVisualMedias, nil
+}
@@ -1,11 +1,16 @@
 package usecase_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"image"
 	"instagram-go/domain"
 	"instagram-go/domain/mocks"
 	"instagram-go/post/usecase"
+	"io"
 	"mime/multipart"
+	"os"
 	"testing"
 	"time"
 
@@ -22,15 +27,29 @@ func TestPostUsecaseSuite(t *testing.T) {
 
 type PostUsecaseSuite struct {
 	suite.Suite
-	mockPostRepository *mocks.PostRepository
-	mockLikeRepository *mocks.LikeRepository
-	mockFileOsHelper   *mocks.IFileOsHelper
-	mockHeaderHelper   *mocks.IHeaderHelper
+	mockPostRepository       *mocks.PostRepository
+	mockLikeRepository       *mocks.LikeRepository
+	mockCommentRepository    *mocks.CommentRepository
+	mockHashtagRepository    *mocks.HashtagRepository
+	mockUserRepository       *mocks.UserRepository
+	mockMentionRepository    *mocks.MentionRepository
+	mockSaveRepository       *mocks.SaveRepository
+	mockCollectionRepository *mocks.CollectionRepository
+	mockHighlightRepository  *mocks.HighlightRepository
+	mockFileOsHelper         *mocks.IFileOsHelper
+	mockHeaderHelper         *mocks.IHeaderHelper
 }
 
 func (pu *PostUsecaseSuite) SetupTest() {
 	pu.mockPostRepository = new(mocks.PostRepository)
 	pu.mockLikeRepository = new(mocks.LikeRepository)
+	pu.mockCommentRepository = new(mocks.CommentRepository)
+	pu.mockHashtagRepository = new(mocks.HashtagRepository)
+	pu.mockUserRepository = new(mocks.UserRepository)
+	pu.mockMentionRepository = new(mocks.MentionRepository)
+	pu.mockSaveRepository = new(mocks.SaveRepository)
+	pu.mockCollectionRepository = new(mocks.CollectionRepository)
+	pu.mockHighlightRepository = new(mocks.HighlightRepository)
 	pu.mockFileOsHelper = new(mocks.IFileOsHelper)
 	pu.mockHeaderHelper = new(mocks.IHeaderHelper)
 }
@@ -38,9 +57,9 @@ func (pu *PostUsecaseSuite) SetupTest() {
 func (pu *PostUsecaseSuite) TestInsertPostGetUserIdTokenError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	newPost := domain.NewPost("postid1", "userid1", []string{}, "caption1", 0, time.Now(), time.Now())
-	err := postUsecase.InsertPost(newPost, "accessToken", []*multipart.FileHeader{})
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
@@ -50,9 +69,9 @@ func (pu *PostUsecaseSuite) TestInsertPostMkDirAllError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
 	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(errors.New("MkDirAll return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	newPost := domain.NewPost("postid1", "userid1", []string{}, "caption1", 0, time.Now(), time.Now())
-	err := postUsecase.InsertPost(newPost, "accessToken", []*multipart.FileHeader{})
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
@@ -61,11 +80,11 @@ func (pu *PostUsecaseSuite) TestInsertPostMkDirAllError() {
 func (pu *PostUsecaseSuite) TestInsertPostInsertPostError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
 	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
-	pu.mockPostRepository.On("InsertPost", mock.AnythingOfType("*domain.Post")).Return(errors.New("InsertPost return error"))
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(errors.New("InsertPost return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	newPost := domain.NewPost("postid1", "userid1", []string{}, "caption1", 0, time.Now(), time.Now())
-	err := postUsecase.InsertPost(newPost, "accessToken", []*multipart.FileHeader{})
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
@@ -74,74 +93,647 @@ func (pu *PostUsecaseSuite) TestInsertPostInsertPostError() {
 func (pu *PostUsecaseSuite) TestInsertPostSuccessful() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
 	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
-	pu.mockPostRepository.On("InsertPost", mock.AnythingOfType("*domain.Post")).Return(nil)
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"golang", "gopher"}, 1).Return(nil)
+	pu.mockUserRepository.On("FindUser", mock.Anything, bson.M{"username": bson.M{"$in": []string{"username2", "ghost"}}}).Return(&[]bson.M{
+		{"_id": "userid2", "username": "username2"},
+	}, nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.AnythingOfType("[]domain.UserMention")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1 #GoLang #gopher a#b @username2 @ghost. me@mail.com", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
+
+	assert.NoErrorf(pu.T(), err, "should have not returned error but got %s", err)
+	assert.Equalf(pu.T(), []string{"golang", "gopher"}, newPost.Hashtags, "Should have set hashtags %v but got %v", []string{"golang", "gopher"}, newPost.Hashtags)
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"golang", "gopher"}, 1)
+	expectedMentions := []domain.Mention{*domain.NewMention("userid2", "username2", 29, 10)}
+	assert.Equalf(pu.T(), expectedMentions, newPost.Mentions, "Should have set mentions %v but got %v", expectedMentions, newPost.Mentions)
+	pu.mockMentionRepository.AssertCalled(pu.T(), "InsertMentions", mock.Anything, mock.MatchedBy(func(userMentions []domain.UserMention) bool {
+		return len(userMentions) == 1 && userMentions[0].UserId == "userid2" && userMentions[0].ResourceId == newPost.Id && userMentions[0].ResourceType == "post"
+	}))
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostResolveMentionsError() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockUserRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindUser return error"))
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1 @username2", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "InsertPost", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostUpdateHashtagPostCountsError() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, mock.Anything, 1).Return(errors.New("UpdateHashtagPostCounts return error"))
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1 #golang", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	newPost := domain.NewPost("postid1", "userid1", []string{}, "caption1", 0, time.Now(), time.Now())
-	err := postUsecase.InsertPost(newPost, "accessToken", []*multipart.FileHeader{})
+func (pu *PostUsecaseSuite) TestInsertPostFillsVisualMediaMetadata() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	writer.Close()
+	form, _ := multipart.NewReader(body, writer.Boundary()).ReadForm(10 << 20)
+	out, _ := os.CreateTemp("", "visual-media")
+	defer os.Remove(out.Name())
+
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockFileOsHelper.On("DecodeImageConfig", mock.Anything).Return(image.Config{Width: 640, Height: 480}, "jpeg", nil)
+	pu.mockFileOsHelper.On("Create", mock.AnythingOfType("string")).Return(out, nil)
+	pu.mockFileOsHelper.On("Copy", mock.Anything, mock.Anything).Return(int64(0), nil)
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, mock.Anything, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("", "", []domain.VisualMedia{{AltText: "a cat"}}, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", form.File["visual_medias"])
 
 	assert.NoErrorf(pu.T(), err, "should have not returned error but got %s", err)
+	assert.Equalf(pu.T(), 1, len(newPost.VisualMedias), "Should have 1 visual media but got %v", len(newPost.VisualMedias))
+	visualMedia := newPost.VisualMedias[0]
+	assert.Equalf(pu.T(), domain.VisualMediaTypeImage, visualMedia.Type, "Should have type %s but got %s", domain.VisualMediaTypeImage, visualMedia.Type)
+	assert.Equalf(pu.T(), "image/jpeg", visualMedia.MimeType, "Should have mime type %s but got %s", "image/jpeg", visualMedia.MimeType)
+	assert.Equalf(pu.T(), 640, visualMedia.Width, "Should have width %v but got %v", 640, visualMedia.Width)
+	assert.Equalf(pu.T(), 480, visualMedia.Height, "Should have height %v but got %v", 480, visualMedia.Height)
+	assert.Equalf(pu.T(), form.File["visual_medias"][0].Size, visualMedia.Size, "Should have size %v but got %v", form.File["visual_medias"][0].Size, visualMedia.Size)
+	assert.Equalf(pu.T(), "a cat", visualMedia.AltText, "Should have alt text %s but got %s", "a cat", visualMedia.AltText)
+	assert.Equalf(pu.T(), 1, len(visualMedia.Variants), "Should have 1 variant but got %v", len(visualMedia.Variants))
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostUserTagOutOfRange() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	visualMedias := []domain.VisualMedia{{}, {UserTags: []domain.UserTag{*domain.NewUserTag("userid2", 0.5, 0.5)}}}
+	newPost := domain.NewPost("postid1", "userid1", visualMedias, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{{Filename: "jpg.jpg"}})
+
+	expectedError := domain.ErrInvalidUserTags.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostInvalidUserTagPosition() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	visualMedias := []domain.VisualMedia{{UserTags: []domain.UserTag{*domain.NewUserTag("userid2", 1.5, 0.5)}}}
+	newPost := domain.NewPost("postid1", "userid1", visualMedias, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{{Filename: "jpg.jpg"}})
+
+	expectedError := domain.ErrInvalidUserTags.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	pu.mockUserRepository.AssertNotCalled(pu.T(), "FindUser", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostTaggedUserNotFound() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": bson.M{"$in": []string{"userid2", "userid3"}}}).Return(&[]bson.M{{"_id": "userid2"}}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	visualMedias := []domain.VisualMedia{{UserTags: []domain.UserTag{*domain.NewUserTag("userid2", 0.5, 0.5), *domain.NewUserTag("userid3", 0.1, 0.1)}}}
+	newPost := domain.NewPost("postid1", "userid1", visualMedias, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{{Filename: "jpg.jpg"}})
+
+	expectedError := domain.ErrTaggedUserNotFound.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "InsertPost", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostWithUserTagsSuccessful() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	writer.Close()
+	form, _ := multipart.NewReader(body, writer.Boundary()).ReadForm(10 << 20)
+	out, _ := os.CreateTemp("", "visual-media")
+	defer os.Remove(out.Name())
+
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockFileOsHelper.On("DecodeImageConfig", mock.Anything).Return(image.Config{Width: 640, Height: 480}, "jpeg", nil)
+	pu.mockFileOsHelper.On("Create", mock.AnythingOfType("string")).Return(out, nil)
+	pu.mockFileOsHelper.On("Copy", mock.Anything, mock.Anything).Return(int64(0), nil)
+	pu.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": bson.M{"$in": []string{"userid2"}}}).Return(&[]bson.M{{"_id": "userid2"}}, nil)
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, mock.Anything, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	userTags := []domain.UserTag{*domain.NewUserTag("userid2", 0.25, 0.75)}
+	newPost := domain.NewPost("", "", []domain.VisualMedia{{UserTags: userTags}}, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", form.File["visual_medias"])
+
+	assert.NoErrorf(pu.T(), err, "should have not returned error but got %s", err)
+	assert.Equalf(pu.T(), userTags, newPost.VisualMedias[0].UserTags, "Should have set user tags %v but got %v", userTags, newPost.VisualMedias[0].UserTags)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostInvalidLocation() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	newPost.Location = domain.NewLocation("", "Sydney Opera House", domain.NewGeoPoint(-95, 151.2))
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
+
+	expectedError := domain.ErrInvalidLocation.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "InsertPost", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostWithLocationSuccessful() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, mock.Anything, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	newPost.Location = domain.NewLocation("", " Sydney Opera House ", domain.NewGeoPoint(-33.8568, 151.2153))
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
+
+	assert.NoErrorf(pu.T(), err, "should have not returned error but got %s", err)
+	assert.Equalf(pu.T(), "Sydney Opera House", newPost.Location.Name, "Should have set location name %s but got %s", "Sydney Opera House", newPost.Location.Name)
+	expectedId := domain.NewLocationId("sydney opera house", domain.NewGeoPoint(-33.85681, 151.21534))
+	assert.Equalf(pu.T(), expectedId, newPost.Location.Id, "Should have set location id %s but got %s", expectedId, newPost.Location.Id)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostMissingLocationPoint() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockFileOsHelper.On("DecodeImageGPS", mock.Anything).Return(nil, errors.New("DecodeImageGPS return error"))
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	writer.Close()
+	form, _ := multipart.NewReader(body, writer.Boundary()).ReadForm(10 << 20)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	newPost.Location = domain.NewLocation("", "Sydney Opera House", nil)
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", form.File["visual_medias"])
+
+	expectedError := domain.ErrMissingLocationPoint.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostLocationPointFromExif() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_medias", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	writer.Close()
+	form, _ := multipart.NewReader(body, writer.Boundary()).ReadForm(10 << 20)
+	out, _ := os.CreateTemp("", "visual-media")
+	defer os.Remove(out.Name())
+
+	exifPoint := domain.NewGeoPoint(-33.875, 151.2)
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockFileOsHelper.On("DecodeImageGPS", mock.Anything).Return(exifPoint, nil)
+	pu.mockFileOsHelper.On("DecodeImageConfig", mock.Anything).Return(image.Config{Width: 640, Height: 480}, "jpeg", nil)
+	pu.mockFileOsHelper.On("Create", mock.AnythingOfType("string")).Return(out, nil)
+	pu.mockFileOsHelper.On("Copy", mock.Anything, mock.Anything).Return(int64(0), nil)
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, mock.Anything, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("", "", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	newPost.Location = domain.NewLocation("", "Sydney", nil)
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", form.File["visual_medias"])
+
+	assert.NoErrorf(pu.T(), err, "should have not returned error but got %s", err)
+	assert.Equalf(pu.T(), exifPoint, newPost.Location.Point, "Should have set location point %v but got %v", exifPoint, newPost.Location.Point)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostInvalidPostStatus() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	newPost.Status = domain.PostStatusScheduled
+	publishAt := time.Now().Add(-time.Hour)
+	newPost.PublishAt = &publishAt
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
+
+	expectedError := domain.ErrInvalidPostStatus.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "InsertPost", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostScheduledSuccessful() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1 #golang", 0, time.Now(), time.Now())
+	newPost.Status = domain.PostStatusScheduled
+	publishAt := time.Now().Add(time.Hour)
+	newPost.PublishAt = &publishAt
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
+
+	assert.NoErrorf(pu.T(), err, "should have not returned error but got %s", err)
+	assert.Equalf(pu.T(), &publishAt, newPost.PublishAt, "Should have kept publish at %v but got %v", publishAt, newPost.PublishAt)
+	pu.mockHashtagRepository.AssertNotCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, mock.Anything, mock.Anything)
+	pu.mockMentionRepository.AssertNotCalled(pu.T(), "InsertMentions", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestInsertPostDefaultsToPublished() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userId1", nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockPostRepository.On("InsertPost", mock.Anything, mock.AnythingOfType("*domain.Post")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, mock.Anything, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	newPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{}, "caption1", 0, time.Now(), time.Now())
+	err := postUsecase.InsertPost(context.TODO(), newPost, "accessToken", []*multipart.FileHeader{})
+
+	assert.NoErrorf(pu.T(), err, "should have not returned error but got %s", err)
+	assert.Equalf(pu.T(), domain.PostStatusPublished, newPost.Status, "Should have set status %s but got %s", domain.PostStatusPublished, newPost.Status)
+	assert.Equalf(pu.T(), newPost.CreatedDate, *newPost.PublishAt, "Should have set publish at %v but got %v", newPost.CreatedDate, *newPost.PublishAt)
 }
 
 func (pu *PostUsecaseSuite) TestFindPostFindPostsError() {
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	_, err := postUsecase.FindPosts()
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindPosts(context.TODO(), "accessToken")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "should have return error %s but got %s", expectedError, err)
 }
 
-func (pu *PostUsecaseSuite) TestFindPostFindLikesError() {
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+func (pu *PostUsecaseSuite) TestFindPostCountReactionsError() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
 	}, nil)
-	pu.mockLikeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(nil, errors.New("FindLikes return error"))
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, []string{"postid1"}).Return(nil, errors.New("CountReactions return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	_, err := postUsecase.FindPosts()
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindPosts(context.TODO(), "accessToken")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "should have return error %s but got %s", expectedError, err)
 }
 
 func (pu *PostUsecaseSuite) TestFindPostSuccessful() {
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption1 #golang",
+			"hashtags":      primitive.A{"golang"},
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
 		{"_id": "postid2",
-			"user_id":           "userid2",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "caption2",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now())},
+			"user_id":       "userid2",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption2",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, []string{"postid1", "postid2"}).Return(map[string]map[string]int{
+		"postid1": {domain.ReactionHeart: 1},
 	}, nil)
-	pu.mockLikeRepository.On("FindLikes", mock.AnythingOfType("M")).Return(&[]bson.M{
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
 	}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	result, err := postUsecase.FindPosts()
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindPosts(context.TODO(), "accessToken")
 
 	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
 	assert.Equal(pu.T(), len(*result), 2, "length of result should be 2")
+	assert.Equalf(pu.T(), 1, *(*result)[0].LikeCount, "Should have return like count %v but got %v", 1, *(*result)[0].LikeCount)
+	assert.Equalf(pu.T(), 0, *(*result)[1].LikeCount, "Should have return like count %v but got %v", 0, *(*result)[1].LikeCount)
+	pu.mockLikeRepository.AssertNumberOfCalls(pu.T(), "CountReactions", 1)
+	assert.Equalf(pu.T(), "image/jpeg", (*result)[0].VisualMedias[0].MimeType, "Should have decoded mime type %s but got %s", "image/jpeg", (*result)[0].VisualMedias[0].MimeType)
+	assert.Equalf(pu.T(), "jpg.jpg", (*result)[0].VisualMedias[0].Variants[0].Url, "Should have decoded url %s but got %s", "jpg.jpg", (*result)[0].VisualMedias[0].Variants[0].Url)
+	assert.Equalf(pu.T(), []string{"golang"}, (*result)[0].Hashtags, "Should have decoded hashtags %v but got %v", []string{"golang"}, (*result)[0].Hashtags)
+	expectedFilter := bson.M{"archived_date": nil, "deleted_at": nil, "$or": bson.A{
+		bson.M{"status": bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}}},
+		bson.M{"user_id": "userid1"},
+	}}
+	pu.mockPostRepository.AssertCalled(pu.T(), "FindPosts", mock.Anything, expectedFilter)
+}
+
+func (pu *PostUsecaseSuite) TestFindPostHiddenLikeCount() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":         "userid1",
+			"caption":         "caption1",
+			"hide_like_count": true,
+			"created_date":    primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":    primitive.NewDateTimeFromTime(time.Now())},
+		{"_id": "postid2",
+			"user_id":         "userid2",
+			"caption":         "caption2",
+			"hide_like_count": true,
+			"created_date":    primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":    primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindPosts(context.TODO(), "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	assert.NotNilf(pu.T(), (*result)[0].LikeCount, "Should have return the like count to the author of the post")
+	assert.Nilf(pu.T(), (*result)[1].LikeCount, "Should have hidden the like count from a non-author but got %v", (*result)[1].LikeCount)
+	assert.Truef(pu.T(), (*result)[1].HideLikeCount, "Should have decoded hide_like_count")
+}
+
+func (pu *PostUsecaseSuite) TestFindPostReactionCounts() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":      "userid2",
+			"caption":      "caption1",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, []string{"postid1"}).Return(map[string]map[string]int{
+		"postid1": {domain.ReactionHeart: 2, "laugh": 1},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
+	}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindPosts(context.TODO(), "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	expectedReactionCounts := map[string]int{domain.ReactionHeart: 2, "laugh": 1}
+	assert.Equalf(pu.T(), expectedReactionCounts, (*result)[0].ReactionCounts, "Should have return reaction counts %v but got %v", expectedReactionCounts, (*result)[0].ReactionCounts)
+	assert.Equalf(pu.T(), 2, *(*result)[0].LikeCount, "Should have counted the hearts as likes but got %v", *(*result)[0].LikeCount)
+}
+
+func (pu *PostUsecaseSuite) TestFindPostViewerState() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":      "userid2",
+			"caption":      "caption1",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date": primitive.NewDateTimeFromTime(time.Now())},
+		{"_id": "postid2",
+			"user_id":      "userid2",
+			"caption":      "caption2",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, []string{"postid1", "postid2"}).Return(map[string]map[string]int{
+		"postid1": {domain.ReactionHeart: 1},
+		"postid2": {"laugh": 1},
+	}, nil)
+	viewerLikesFilter := bson.M{"user_id": "userid1", "resource_type": domain.LikeResourcePost, "resource_id": bson.M{"$in": []string{"postid1", "postid2"}}}
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, viewerLikesFilter).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid1", "resource_id": "postid1", "resource_type": "post"},
+		{"_id": "likeid2", "user_id": "userid1", "resource_id": "postid2", "resource_type": "post", "reaction": "laugh"},
+	}, nil)
+	viewerSavesFilter := bson.M{"user_id": "userid1", "post_id": bson.M{"$in": []string{"postid1", "postid2"}}}
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, viewerSavesFilter).Return(&[]bson.M{
+		{"_id": "saveid1", "user_id": "userid1", "post_id": "postid2"},
+	}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindPosts(context.TODO(), "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	assert.Truef(pu.T(), *(*result)[0].ViewerHasLiked, "Should have return that the viewer liked the first post")
+	assert.Falsef(pu.T(), *(*result)[0].ViewerHasSaved, "Should have return that the viewer did not save the first post")
+	assert.Truef(pu.T(), *(*result)[1].ViewerHasLiked, "Should have return that the viewer reacted to the second post")
+	assert.Equalf(pu.T(), "laugh", (*result)[1].ViewerReaction, "Should have return the viewer reaction %s but got %s", "laugh", (*result)[1].ViewerReaction)
+	assert.Truef(pu.T(), *(*result)[1].ViewerHasSaved, "Should have return that the viewer saved the second post")
+	pu.mockLikeRepository.AssertNumberOfCalls(pu.T(), "FindLikes", 1)
+	pu.mockSaveRepository.AssertNumberOfCalls(pu.T(), "FindSaves", 1)
+}
+
+func (pu *PostUsecaseSuite) TestFindPostFindSavesError() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":      "userid2",
+			"caption":      "caption1",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindSaves return error"))
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindPosts(context.TODO(), "accessToken")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "should have return error %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestFindHashtagPostsInvalidPagination() {
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindHashtagPosts(context.TODO(), "golang", 0, 10, "accessToken")
+
+	expectedError := domain.ErrInvalidPagination.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestFindHashtagPostsFindPaginatedPostsError() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, mock.AnythingOfType("M"), int64(10), int64(10)).Return(nil, errors.New("FindPaginatedPosts return error"))
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindHashtagPosts(context.TODO(), "golang", 2, 10, "accessToken")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestFindHashtagPostsSuccessful() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, bson.M{"hashtags": "golang", "status": bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}}, "archived_date": nil, "deleted_at": nil}, int64(0), int64(domain.MaxPageLimit)).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "caption1 #golang",
+			"hashtags":      primitive.A{"golang"},
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindHashtagPosts(context.TODO(), "GoLang", 1, domain.MaxPageLimit+1, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	assert.Equal(pu.T(), 1, len(*result), "length of result should be 1")
+	assert.Equalf(pu.T(), "postid1", (*result)[0].Id, "Should have return post %s but got %s", "postid1", (*result)[0].Id)
+}
+
+func (pu *PostUsecaseSuite) TestFindHashtagPostsViewerState() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, mock.AnythingOfType("M"), int64(0), int64(10)).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":      "userid1",
+			"caption":      "caption1 #golang",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, []string{"postid1"}).Return(map[string]map[string]int{
+		"postid1": {"wow": 1},
+	}, nil)
+	viewerLikesFilter := bson.M{"user_id": "userid2", "resource_type": domain.LikeResourcePost, "resource_id": bson.M{"$in": []string{"postid1"}}}
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, viewerLikesFilter).Return(&[]bson.M{
+		{"_id": "likeid1", "user_id": "userid2", "resource_id": "postid1", "resource_type": "post", "reaction": "wow"},
+	}, nil)
+	viewerSavesFilter := bson.M{"user_id": "userid2", "post_id": bson.M{"$in": []string{"postid1"}}}
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, viewerSavesFilter).Return(&[]bson.M{
+		{"_id": "saveid1", "user_id": "userid2", "post_id": "postid1"},
+	}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindHashtagPosts(context.TODO(), "golang", 1, 10, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	assert.Truef(pu.T(), *(*result)[0].ViewerHasLiked, "Should have return that the viewer reacted to the post")
+	assert.Equalf(pu.T(), "wow", (*result)[0].ViewerReaction, "Should have return the viewer reaction %s but got %s", "wow", (*result)[0].ViewerReaction)
+	assert.Truef(pu.T(), *(*result)[0].ViewerHasSaved, "Should have return that the viewer saved the post")
+}
+
+func (pu *PostUsecaseSuite) TestFindUserTaggedPostsUserNotFound() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": "userid2"}).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindUserTaggedPosts(context.TODO(), "userid2", 1, 10, "accessToken")
+
+	expectedError := domain.ErrUserNotFound.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestFindUserTaggedPostsSuccessful() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": "userid2"}).Return(&[]bson.M{{"_id": "userid2"}}, nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, bson.M{"visual_medias.user_tags.user_id": "userid2", "status": bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}}, "archived_date": nil, "deleted_at": nil}, int64(10), int64(10)).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "user_tags": primitive.A{bson.M{"user_id": "userid2", "x": 0.5, "y": 0.5}}}},
+			"caption":       "caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindUserTaggedPosts(context.TODO(), "userid2", 2, 10, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	assert.Equal(pu.T(), 1, len(*result), "length of result should be 1")
+	expectedUserTags := []domain.UserTag{*domain.NewUserTag("userid2", 0.5, 0.5)}
+	assert.Equalf(pu.T(), expectedUserTags, (*result)[0].VisualMedias[0].UserTags, "Should have return user tags %v but got %v", expectedUserTags, (*result)[0].VisualMedias[0].UserTags)
+}
+
+func (pu *PostUsecaseSuite) TestFindLocationPostsSuccessful() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, bson.M{"location.id": "location-1", "status": bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}}, "archived_date": nil, "deleted_at": nil}, int64(0), int64(domain.DefaultPageLimit)).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":      "userid1",
+			"caption":      "caption1",
+			"location":     bson.M{"id": "location-1", "name": "Sydney", "point": bson.M{"type": "Point", "coordinates": primitive.A{151.2, -33.875}}},
+			"created_date": primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindLocationPosts(context.TODO(), "location-1", 1, domain.DefaultPageLimit, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	assert.Equal(pu.T(), 1, len(*result), "length of result should be 1")
+	expectedLocation := domain.NewLocation("location-1", "Sydney", domain.NewGeoPoint(-33.875, 151.2))
+	assert.Equalf(pu.T(), expectedLocation, (*result)[0].Location, "Should have return location %v but got %v", expectedLocation, (*result)[0].Location)
+}
+
+func (pu *PostUsecaseSuite) TestFindNearbyPostsInvalidNearbyQuery() {
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindNearbyPosts(context.TODO(), domain.NewGeoPoint(-33.875, 151.2), 0, 1, 10, "accessToken")
+
+	expectedError := domain.ErrInvalidNearbyQuery.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestFindNearbyPostsSuccessful() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, mock.AnythingOfType("M"), int64(10), int64(10)).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindNearbyPosts(context.TODO(), domain.NewGeoPoint(-33.875, 151.2), domain.MaxNearbyRadius*2, 2, 10, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	expectedFilter := bson.M{"location.point": bson.M{
+		"$geoWithin": bson.M{"$centerSphere": bson.A{[]float64{151.2, -33.875}, float64(domain.MaxNearbyRadius) / 6378100}},
+	}, "status": bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}}, "archived_date": nil, "deleted_at": nil}
+	pu.mockPostRepository.AssertCalled(pu.T(), "FindPaginatedPosts", mock.Anything, expectedFilter, int64(10), int64(10))
 }
 
 func (pu *PostUsecaseSuite) TestUpdatePostGetUserIdFromTokenError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromTokenError return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.UpdatePost("postid1", "updated caption 1", "accessToken")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePost(context.TODO(), "postid1", "updated caption 1", "accessToken")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err.Error())
@@ -149,10 +741,10 @@ func (pu *PostUsecaseSuite) TestUpdatePostGetUserIdFromTokenError() {
 
 func (pu *PostUsecaseSuite) TestUpdatePostFindPostsError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.UpdatePost("postid1", "updated caption 1", "accessToken")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePost(context.TODO(), "postid1", "updated caption 1", "accessToken")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err.Error())
@@ -160,10 +752,10 @@ func (pu *PostUsecaseSuite) TestUpdatePostFindPostsError() {
 
 func (pu *PostUsecaseSuite) TestUpdatePostPostNotFound() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.UpdatePost("postid1", "updated caption 1", "accessToken")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePost(context.TODO(), "postid1", "updated caption 1", "accessToken")
 
 	expectedError := domain.ErrPostNotFound.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err.Error())
@@ -171,19 +763,19 @@ func (pu *PostUsecaseSuite) TestUpdatePostPostNotFound() {
 
 func (pu *PostUsecaseSuite) TestUpdatePostFindOnePostError() {
 	foundPost := bson.M{
-		"_id":               "postid1",
-		"user_id":           "userid1",
-		"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-		"caption":           "a new caption1",
-		"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-		"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+		"_id":           "postid1",
+		"user_id":       "userid1",
+		"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+		"caption":       "a new caption1",
+		"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 	}
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{foundPost}, nil)
-	pu.mockPostRepository.On("FindOnePost", mock.AnythingOfType("string")).Return(nil, errors.New("FindOnePost return error"))
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{foundPost}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("FindOnePost return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.UpdatePost("postid1", "updated caption 1", "accessToken")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePost(context.TODO(), "postid1", "updated caption 1", "accessToken")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err.Error())
@@ -192,21 +784,21 @@ func (pu *PostUsecaseSuite) TestUpdatePostFindOnePostError() {
 func (pu *PostUsecaseSuite) TestUpdatePostUnauthorizedPostUpdate() {
 	foundPosts := []bson.M{
 		{
-			"_id":               "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "a new caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+			"_id":           "postid1",
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "a new caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 		},
 	}
-	foundPost := domain.NewPost("postid1", "userid1", []string{"jpg.jpg", "png.png"}, "caption1", 0, time.Now(), time.Now())
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1", 0, time.Now(), time.Now())
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&foundPosts, nil)
-	pu.mockPostRepository.On("FindOnePost", mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&foundPosts, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.UpdatePost("postid1", "updated caption 1", "accessToken")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePost(context.TODO(), "postid1", "updated caption 1", "accessToken")
 
 	expectedError := domain.ErrUnauthorizedPostUpdate.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err.Error())
@@ -215,22 +807,22 @@ func (pu *PostUsecaseSuite) TestUpdatePostUnauthorizedPostUpdate() {
 func (pu *PostUsecaseSuite) TestUpdatePostUpdatePostError() {
 	foundPosts := []bson.M{
 		{
-			"_id":               "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "a new caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+			"_id":           "postid1",
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "a new caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 		},
 	}
-	foundPost := domain.NewPost("postid1", "userid1", []string{"jpg.jpg", "png.png"}, "caption1", 0, time.Now(), time.Now())
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1", 0, time.Now(), time.Now())
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&foundPosts, nil)
-	pu.mockPostRepository.On("FindOnePost", mock.AnythingOfType("string")).Return(foundPost, nil)
-	pu.mockPostRepository.On("UpdatePost", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(errors.New("UpdatePost return error"))
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&foundPosts, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePost", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return(errors.New("UpdatePost return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.UpdatePost("postid1", "updated caption 1", "accessToken")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePost(context.TODO(), "postid1", "updated caption 1", "accessToken")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err.Error())
@@ -239,27 +831,463 @@ func (pu *PostUsecaseSuite) TestUpdatePostUpdatePostError() {
 func (pu *PostUsecaseSuite) TestUpdatePostSuccessful() {
 	foundPosts := []bson.M{
 		{
-			"_id":               "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "a new caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+			"_id":           "postid1",
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "a new caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 		},
 	}
-	foundPost := domain.NewPost("postid1", "userid1", []string{"jpg.jpg", "png.png"}, "caption1", 0, time.Now(), time.Now())
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1 #golang #mongodb", 0, time.Now(), time.Now())
+	foundPost.Hashtags = []string{"golang", "mongodb"}
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&foundPosts, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	expectedMentions := []domain.Mention{*domain.NewMention("userid2", "username2", 31, 10)}
+	pu.mockUserRepository.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "userid2", "username": "username2"}}, nil)
+	pu.mockPostRepository.On("UpdatePost", mock.Anything, "postid1", "a new caption1 #golang #gopher @username2", []string{"golang", "gopher"}, expectedMentions).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"gopher"}, 1).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"mongodb"}, -1).Return(nil)
+	pu.mockMentionRepository.On("DeleteMentions", mock.Anything, "postid1", "post").Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.AnythingOfType("[]domain.UserMention")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePost(context.TODO(), "postid1", "a new caption1 #golang #gopher @username2", "token1")
+
+	assert.NoErrorf(pu.T(), err, "should have not return error but got %s", err)
+	pu.mockMentionRepository.AssertCalled(pu.T(), "DeleteMentions", mock.Anything, "postid1", "post")
+	pu.mockMentionRepository.AssertCalled(pu.T(), "InsertMentions", mock.Anything, mock.MatchedBy(func(userMentions []domain.UserMention) bool {
+		return len(userMentions) == 1 && userMentions[0].UserId == "userid2" && userMentions[0].AuthorId == "userid1"
+	}))
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"gopher"}, 1)
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"mongodb"}, -1)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostVisualMediasPostNotFound() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostVisualMedias(context.TODO(), "postid1", []int{0}, nil, nil, "accessToken")
+
+	expectedError := domain.ErrPostNotFound.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostVisualMediasUnauthorizedPostUpdate() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostVisualMedias(context.TODO(), "postid1", []int{0}, nil, nil, "accessToken")
+
+	expectedError := domain.ErrUnauthorizedPostUpdate.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostVisualMediasInvalidVisualMediaOrder() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostVisualMedias(context.TODO(), "postid1", []int{0, 0}, nil, nil, "accessToken")
+
+	expectedError := domain.ErrInvalidVisualMediaOrder.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostVisualMediasMissingVisualMedias() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostVisualMedias(context.TODO(), "postid1", []int{}, nil, nil, "accessToken")
+
+	expectedError := domain.ErrMissingVisualMediasInput.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostVisualMediasSuccessful() {
+	firstVisualMedia := domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", []domain.VisualMediaVariant{*domain.NewVisualMediaVariant(domain.VisualMediaVariantOriginal, "./visual_medias/postid10.jpg")})
+	secondVisualMedia := domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/png", 1, 1, 0, 100, "", []domain.VisualMediaVariant{*domain.NewVisualMediaVariant(domain.VisualMediaVariantOriginal, "./visual_medias/postid11.png")})
+	thirdVisualMedia := domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/gif", 1, 1, 0, 100, "", []domain.VisualMediaVariant{*domain.NewVisualMediaVariant(domain.VisualMediaVariantOriginal, "./visual_medias/postid12.gif")})
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*firstVisualMedia, *secondVisualMedia, *thirdVisualMedia}, "caption1", 0, time.Now(), time.Now())
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&foundPosts, nil)
-	pu.mockPostRepository.On("FindOnePost", mock.AnythingOfType("string")).Return(foundPost, nil)
-	pu.mockPostRepository.On("UpdatePost", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	pu.mockPostRepository.On("UpdatePostVisualMedias", mock.Anything, "postid1", []domain.VisualMedia{*thirdVisualMedia, *firstVisualMedia}).Return(nil)
+	pu.mockHighlightRepository.On("FindHighlights", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockFileOsHelper.On("Remove", "./visual_medias/postid11.png").Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostVisualMedias(context.TODO(), "postid1", []int{2, 0}, nil, nil, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostVisualMedias", mock.Anything, "postid1", []domain.VisualMedia{*thirdVisualMedia, *firstVisualMedia})
+	pu.mockFileOsHelper.AssertCalled(pu.T(), "Remove", "./visual_medias/postid11.png")
+	pu.mockFileOsHelper.AssertNumberOfCalls(pu.T(), "Remove", 1)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostUserTagsUnauthorizedPostUpdate() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostUserTags(context.TODO(), "postid1", []domain.VisualMediaUserTag{}, "accessToken")
+
+	expectedError := domain.ErrUnauthorizedPostUpdate.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostUserTagsInvalidVisualMediaIndex() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	userTags := []domain.VisualMediaUserTag{{VisualMediaIndex: 1, UserId: "userid2", X: 0.5, Y: 0.5}}
+	err := postUsecase.UpdatePostUserTags(context.TODO(), "postid1", userTags, "accessToken")
+
+	expectedError := domain.ErrInvalidUserTags.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
 
+func (pu *PostUsecaseSuite) TestUpdatePostUserTagsSuccessful() {
+	firstVisualMedia := domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)
+	firstVisualMedia.UserTags = []domain.UserTag{*domain.NewUserTag("userid3", 0.5, 0.5)}
+	secondVisualMedia := domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/png", 1, 1, 0, 100, "", nil)
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*firstVisualMedia, *secondVisualMedia}, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": bson.M{"$in": []string{"userid2"}}}).Return(&[]bson.M{{"_id": "userid2"}}, nil)
+	pu.mockPostRepository.On("UpdatePostVisualMedias", mock.Anything, "postid1", mock.AnythingOfType("[]domain.VisualMedia")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	userTags := []domain.VisualMediaUserTag{{VisualMediaIndex: 1, UserId: "userid2", X: 0.2, Y: 0.8}}
+	err := postUsecase.UpdatePostUserTags(context.TODO(), "postid1", userTags, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	secondVisualMedia.UserTags = []domain.UserTag{*domain.NewUserTag("userid2", 0.2, 0.8)}
+	firstVisualMedia.UserTags = nil
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostVisualMedias", mock.Anything, "postid1", []domain.VisualMedia{*firstVisualMedia, *secondVisualMedia})
+}
+
+func (pu *PostUsecaseSuite) TestDeletePostUserTagUserTagNotFound() {
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePostUserTag(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrUserTagNotFound.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "UpdatePostVisualMedias", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestDeletePostUserTagSuccessful() {
+	visualMedia := domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)
+	visualMedia.UserTags = []domain.UserTag{*domain.NewUserTag("userid2", 0.5, 0.5), *domain.NewUserTag("userid3", 0.1, 0.1)}
+	foundPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*visualMedia}, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostVisualMedias", mock.Anything, "postid1", mock.AnythingOfType("[]domain.VisualMedia")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePostUserTag(context.TODO(), "postid1", "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	visualMedia.UserTags = []domain.UserTag{*domain.NewUserTag("userid3", 0.1, 0.1)}
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostVisualMedias", mock.Anything, "postid1", []domain.VisualMedia{*visualMedia})
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostStatusPublishedPost() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.Status = domain.PostStatusPublished
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostStatus(context.TODO(), "postid1", domain.PostStatusDraft, nil, "accessToken")
+
+	expectedError := domain.ErrInvalidPostStatus.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostStatusUnauthorizedPostUpdate() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.Status = domain.PostStatusDraft
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostStatus(context.TODO(), "postid1", domain.PostStatusPublished, nil, "accessToken")
+
+	expectedError := domain.ErrUnauthorizedPostUpdate.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostStatusScheduleSuccessful() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1 #golang", 0, time.Now(), time.Now())
+	foundPost.Status = domain.PostStatusDraft
+	publishAt := time.Now().Add(time.Hour)
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostStatus", mock.Anything, "postid1", domain.PostStatusDraft, domain.PostStatusScheduled, &publishAt).Return(true, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostStatus(context.TODO(), "postid1", domain.PostStatusScheduled, &publishAt, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostStatus", mock.Anything, "postid1", domain.PostStatusDraft, domain.PostStatusScheduled, &publishAt)
+	pu.mockHashtagRepository.AssertNotCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostStatusPublishSuccessful() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1 #golang", 0, time.Now(), time.Now())
+	foundPost.Status = domain.PostStatusDraft
+	foundPost.Hashtags = []string{"golang"}
+	foundPost.Mentions = []domain.Mention{*domain.NewMention("userid2", "username2", 0, 10)}
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostStatus", mock.Anything, "postid1", domain.PostStatusDraft, domain.PostStatusPublished, mock.AnythingOfType("*time.Time")).Return(true, nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.AnythingOfType("[]domain.UserMention")).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostStatus(context.TODO(), "postid1", domain.PostStatusPublished, nil, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, 1)
+	pu.mockMentionRepository.AssertCalled(pu.T(), "InsertMentions", mock.Anything, mock.MatchedBy(func(userMentions []domain.UserMention) bool {
+		return len(userMentions) == 1 && userMentions[0].UserId == "userid2"
+	}))
+}
+
+func (pu *PostUsecaseSuite) TestPublishScheduledPostsFindPostsError() {
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PublishScheduledPosts(context.TODO())
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestPublishScheduledPostsSuccessful() {
+	firstPost := domain.NewPost("postid1", "userid1", nil, "caption1 #golang", 0, time.Now(), time.Now())
+	firstPost.Status = domain.PostStatusScheduled
+	firstPost.Hashtags = []string{"golang"}
+	secondPost := domain.NewPost("postid2", "userid1", nil, "caption2 #gopher", 0, time.Now(), time.Now())
+	secondPost.Status = domain.PostStatusScheduled
+	secondPost.Hashtags = []string{"gopher"}
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.MatchedBy(func(filter bson.M) bool {
+		return filter["status"] == domain.PostStatusScheduled
+	})).Return(&[]bson.M{{"_id": "postid1"}, {"_id": "postid2"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(firstPost, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid2").Return(secondPost, nil)
+	pu.mockPostRepository.On("UpdatePostStatus", mock.Anything, "postid1", domain.PostStatusScheduled, domain.PostStatusPublished, mock.AnythingOfType("*time.Time")).Return(true, nil)
+	pu.mockPostRepository.On("UpdatePostStatus", mock.Anything, "postid2", domain.PostStatusScheduled, domain.PostStatusPublished, mock.AnythingOfType("*time.Time")).Return(false, nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PublishScheduledPosts(context.TODO())
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, 1)
+	pu.mockHashtagRepository.AssertNotCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"gopher"}, 1)
+}
+
+func (pu *PostUsecaseSuite) TestDeletePostDraftSuccessful() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1 #golang", 0, time.Now(), time.Now())
+	foundPost.Status = domain.PostStatusDraft
+	foundPost.Hashtags = []string{"golang"}
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", (*time.Time)(nil)).Return(false, nil)
+	pu.mockPostRepository.On("UpdatePostDeletedAt", mock.Anything, "postid1", mock.AnythingOfType("*time.Time")).Return(nil)
+	pu.mockCommentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePost(context.TODO(), "postid1", "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockHashtagRepository.AssertNotCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestArchivePostUnauthorizedPostArchive() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.ArchivePost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrUnauthorizedPostArchive.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestArchivePostUnpublishedPostArchive() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.Status = domain.PostStatusDraft
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.ArchivePost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrUnpublishedPostArchive.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestArchivePostArchiveConflict() {
+	archivedDate := time.Now()
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.ArchivedDate = &archivedDate
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.ArchivePost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrPostArchiveConflict.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestArchivePostSuccessful() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1 #golang", 0, time.Now(), time.Now())
+	foundPost.Hashtags = []string{"golang"}
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", (*time.Time)(nil)).Return(false, nil)
+	pu.mockPostRepository.On("UpdatePostArchivedDate", mock.Anything, "postid1", mock.AnythingOfType("*time.Time")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, -1).Return(nil)
+	pu.mockMentionRepository.On("DeleteMentions", mock.Anything, "postid1", "post").Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.ArchivePost(context.TODO(), "postid1", "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostArchivedDate", mock.Anything, "postid1", mock.AnythingOfType("*time.Time"))
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, -1)
+	pu.mockMentionRepository.AssertCalled(pu.T(), "DeleteMentions", mock.Anything, "postid1", "post")
+}
+
+func (pu *PostUsecaseSuite) TestArchivePostReleasesPinnedSlot() {
+	pinnedDate := time.Now()
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.PinnedDate = &pinnedDate
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostArchivedDate", mock.Anything, "postid1", mock.AnythingOfType("*time.Time")).Return(nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", (*time.Time)(nil)).Return(true, nil)
+	pu.mockUserRepository.On("DecrementUserPinnedCount", mock.Anything, "userid1").Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, mock.Anything, -1).Return(nil)
+	pu.mockMentionRepository.On("DeleteMentions", mock.Anything, "postid1", "post").Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.ArchivePost(context.TODO(), "postid1", "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockUserRepository.AssertCalled(pu.T(), "DecrementUserPinnedCount", mock.Anything, "userid1")
+}
+
+func (pu *PostUsecaseSuite) TestRestorePostNotArchived() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.RestorePost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrPostNotArchived.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestRestorePostSuccessful() {
+	archivedDate := time.Now()
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1 #golang", 0, time.Now(), time.Now())
+	foundPost.Hashtags = []string{"golang"}
+	foundPost.ArchivedDate = &archivedDate
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostArchivedDate", mock.Anything, "postid1", (*time.Time)(nil)).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.RestorePost(context.TODO(), "postid1", "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostArchivedDate", mock.Anything, "postid1", (*time.Time)(nil))
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, 1)
+}
+
+func (pu *PostUsecaseSuite) TestFindArchivedPostsUnauthorizedArchiveView() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindArchivedPosts(context.TODO(), "userid1", 1, 10, "accessToken")
+
+	expectedError := domain.ErrUnauthorizedArchiveView.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "FindPaginatedPosts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestFindArchivedPostsSuccessful() {
+	archivedDate := time.Now()
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, bson.M{"user_id": "userid1", "archived_date": bson.M{"$ne": nil}, "deleted_at": nil}, int64(0), int64(10)).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":       "userid1",
+			"caption":       "caption1",
+			"archived_date": primitive.NewDateTimeFromTime(archivedDate),
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindArchivedPosts(context.TODO(), "userid1", 1, 10, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	assert.Equal(pu.T(), 1, len(*result), "length of result should be 1")
+	assert.NotNilf(pu.T(), (*result)[0].ArchivedDate, "Should have decoded the archived date")
 }
 
 func (pu *PostUsecaseSuite) TestDeletePostGetUserIdFromTokenError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("", errors.New("GetUserIdFromToken return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.DeletePost("postid1", "token1")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePost(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -267,10 +1295,10 @@ func (pu *PostUsecaseSuite) TestDeletePostGetUserIdFromTokenError() {
 
 func (pu *PostUsecaseSuite) TestDeletePostFindPostsError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindPosts return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.DeletePost("postid1", "token1")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePost(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -278,10 +1306,10 @@ func (pu *PostUsecaseSuite) TestDeletePostFindPostsError() {
 
 func (pu *PostUsecaseSuite) TestDeletePostPostNotFound() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.DeletePost("postid1", "token1")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePost(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrPostNotFound.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -289,20 +1317,20 @@ func (pu *PostUsecaseSuite) TestDeletePostPostNotFound() {
 
 func (pu *PostUsecaseSuite) TestDeletePostFindOnePostError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
-			"_id":               "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "a new caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+			"_id":           "postid1",
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "a new caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 		},
 	}, nil)
-	pu.mockPostRepository.On("FindOnePost", mock.AnythingOfType("string")).Return(nil, errors.New("FindOnePost return error"))
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("FindOnePost return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.DeletePost("postid1", "token1")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePost(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -310,44 +1338,45 @@ func (pu *PostUsecaseSuite) TestDeletePostFindOnePostError() {
 
 func (pu *PostUsecaseSuite) TestDeletePostUnauthorizedPostDelete() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
-			"_id":               "postid1",
-			"user_id":           "userid2",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "a new caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+			"_id":           "postid1",
+			"user_id":       "userid2",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "a new caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 		},
 	}, nil)
-	pu.mockPostRepository.On("FindOnePost", mock.AnythingOfType("string")).Return(domain.NewPost(
-		"postid1", "userid2", []string{"jpg.jpg", "png.png"}, "a new caption1", 0, time.Now(), time.Now()), nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewPost(
+		"postid1", "userid2", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "a new caption1", 0, time.Now(), time.Now()), nil)
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.DeletePost("postid1", "token1")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePost(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrUnauthorizedPostDelete.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
-func (pu *PostUsecaseSuite) TestDeletePostDeletePostError() {
+func (pu *PostUsecaseSuite) TestDeletePostUpdatePostDeletedAtError() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
-			"_id":               "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "a new caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+			"_id":           "postid1",
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "a new caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 		},
 	}, nil)
-	pu.mockPostRepository.On("FindOnePost", mock.AnythingOfType("string")).Return(domain.NewPost(
-		"postid1", "userid1", []string{"jpg.jpg", "png.png"}, "a new caption1", 0, time.Now(), time.Now()), nil)
-	pu.mockPostRepository.On("DeletePost", mock.AnythingOfType("string")).Return(errors.New("DeletePost return error"))
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(domain.NewPost(
+		"postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "a new caption1", 0, time.Now(), time.Now()), nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", (*time.Time)(nil)).Return(false, nil)
+	pu.mockPostRepository.On("UpdatePostDeletedAt", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("*time.Time")).Return(errors.New("UpdatePostDeletedAt return error"))
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.DeletePost("postid1", "token1")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePost(context.TODO(), "postid1", "token1")
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(pu.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -355,22 +1384,499 @@ func (pu *PostUsecaseSuite) TestDeletePostDeletePostError() {
 
 func (pu *PostUsecaseSuite) TestDeletePostSuccessful() {
 	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	pu.mockPostRepository.On("FindPosts", mock.AnythingOfType("M")).Return(&[]bson.M{
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
-			"_id":               "postid1",
-			"user_id":           "userid1",
-			"visual_media_urls": []primitive.A{{"jpg.jpg"}, {"png.png"}},
-			"caption":           "a new caption1",
-			"created_date":      primitive.NewDateTimeFromTime(time.Now()),
-			"updated_date":      primitive.NewDateTimeFromTime(time.Now()),
+			"_id":           "postid1",
+			"user_id":       "userid1",
+			"visual_medias": primitive.A{bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "jpg.jpg"}}}},
+			"caption":       "a new caption1",
+			"created_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date":  primitive.NewDateTimeFromTime(time.Now()),
 		},
 	}, nil)
-	pu.mockPostRepository.On("FindOnePost", mock.AnythingOfType("string")).Return(domain.NewPost(
-		"postid1", "userid1", []string{"jpg.jpg", "png.png"}, "a new caption1", 0, time.Now(), time.Now()), nil)
-	pu.mockPostRepository.On("DeletePost", mock.AnythingOfType("string")).Return(nil)
+	deletedPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", nil)}, "a new caption1 #golang", 0, time.Now(), time.Now())
+	deletedPost.Hashtags = []string{"golang"}
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(deletedPost, nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", (*time.Time)(nil)).Return(false, nil)
+	pu.mockPostRepository.On("UpdatePostDeletedAt", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("*time.Time")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, -1).Return(nil)
+	pu.mockMentionRepository.On("DeleteMentions", mock.Anything, "postid1", "post").Return(nil)
+	pu.mockCommentRepository.On("FindComments", mock.Anything, bson.M{"post_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{
+		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid2", "hashtags": primitive.A{"gopher"}},
+	}, nil)
+	pu.mockCommentRepository.On("UpdateCommentsDeletedAt", mock.Anything, bson.M{"post_id": "postid1", "deleted_at": nil}, mock.AnythingOfType("*time.Time")).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, []string{"gopher"}, -1).Return(nil)
+	pu.mockMentionRepository.On("DeleteMentions", mock.Anything, "commentid1", "comment").Return(nil)
 
-	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
-	err := postUsecase.DeletePost("postid1", "token1")
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.DeletePost(context.TODO(), "postid1", "token1")
 
 	assert.NoErrorf(pu.T(), err, "should have not return error but got %s", err)
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, -1)
+	pu.mockUserRepository.AssertNotCalled(pu.T(), "DecrementUserPinnedCount", mock.Anything, mock.Anything)
+	pu.mockMentionRepository.AssertCalled(pu.T(), "DeleteMentions", mock.Anything, "postid1", "post")
+	pu.mockCommentRepository.AssertCalled(pu.T(), "UpdateCommentsDeletedAt", mock.Anything, bson.M{"post_id": "postid1", "deleted_at": nil}, mock.AnythingOfType("*time.Time"))
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagCommentCounts", mock.Anything, []string{"gopher"}, -1)
+	pu.mockMentionRepository.AssertCalled(pu.T(), "DeleteMentions", mock.Anything, "commentid1", "comment")
+}
+
+func (pu *PostUsecaseSuite) TestRestoreDeletedPostUnauthorizedPostRestore() {
+	deletedAt := time.Now()
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.DeletedAt = &deletedAt
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.RestoreDeletedPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrUnauthorizedPostRestore.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestRestoreDeletedPostNotDeleted() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.RestoreDeletedPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrPostNotDeleted.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestRestoreDeletedPostRetentionExpired() {
+	deletedAt := time.Now().Add(-domain.TrashRetention - time.Hour)
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.DeletedAt = &deletedAt
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.RestoreDeletedPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrPostNotFound.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "UpdatePostDeletedAt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestRestoreDeletedPostSuccessful() {
+	deletedAt := time.Now().Add(-24 * time.Hour)
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1 #golang", 0, time.Now(), time.Now())
+	foundPost.Hashtags = []string{"golang"}
+	foundPost.DeletedAt = &deletedAt
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, mock.AnythingOfType("string")).Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostDeletedAt", mock.Anything, "postid1", (*time.Time)(nil)).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, 1).Return(nil)
+	pu.mockMentionRepository.On("InsertMentions", mock.Anything, mock.Anything).Return(nil)
+	pu.mockCommentRepository.On("FindComments", mock.Anything, bson.M{"post_id": "postid1", "deleted_at": deletedAt}).Return(&[]bson.M{
+		{"_id": "commentid1", "post_id": "postid1", "user_id": "userid2", "hashtags": primitive.A{"gopher"},
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockCommentRepository.On("UpdateCommentsDeletedAt", mock.Anything, bson.M{"post_id": "postid1", "deleted_at": deletedAt}, (*time.Time)(nil)).Return(nil)
+	pu.mockHashtagRepository.On("UpdateHashtagCommentCounts", mock.Anything, []string{"gopher"}, 1).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.RestoreDeletedPost(context.TODO(), "postid1", "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostDeletedAt", mock.Anything, "postid1", (*time.Time)(nil))
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagPostCounts", mock.Anything, []string{"golang"}, 1)
+	pu.mockCommentRepository.AssertCalled(pu.T(), "UpdateCommentsDeletedAt", mock.Anything, bson.M{"post_id": "postid1", "deleted_at": deletedAt}, (*time.Time)(nil))
+	pu.mockHashtagRepository.AssertCalled(pu.T(), "UpdateHashtagCommentCounts", mock.Anything, []string{"gopher"}, 1)
+}
+
+func (pu *PostUsecaseSuite) TestFindDeletedPostsUnauthorizedTrashView() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindDeletedPosts(context.TODO(), "userid1", 1, 10, "accessToken")
+
+	expectedError := domain.ErrUnauthorizedTrashView.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "FindPaginatedPosts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestFindDeletedPostsSuccessful() {
+	deletedAt := time.Now().Add(-time.Hour)
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, mock.AnythingOfType("M"), int64(0), int64(10)).Return(&[]bson.M{
+		{"_id": "postid1",
+			"user_id":      "userid1",
+			"caption":      "caption1",
+			"deleted_at":   primitive.NewDateTimeFromTime(deletedAt),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()),
+			"updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindDeletedPosts(context.TODO(), "userid1", 1, 10, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	assert.Equal(pu.T(), 1, len(*result), "length of result should be 1")
+	assert.NotNilf(pu.T(), (*result)[0].DeletedAt, "Should have decoded the deleted date")
+	pu.mockPostRepository.AssertCalled(pu.T(), "FindPaginatedPosts", mock.Anything, mock.MatchedBy(func(filter bson.M) bool {
+		deletedAtFilter, ok := filter["deleted_at"].(bson.M)
+		return ok && filter["user_id"] == "userid1" && deletedAtFilter["$gt"] != nil
+	}), int64(0), int64(10))
+}
+
+func (pu *PostUsecaseSuite) TestPurgeDeletedPostsSuccessful() {
+	deletedAt := time.Now().Add(-domain.TrashRetention - time.Hour)
+	purgedPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", []domain.VisualMediaVariant{{Type: domain.VisualMediaVariantOriginal, Url: "jpg.jpg"}})}, "caption1", 0, time.Now(), time.Now())
+	purgedPost.DeletedAt = &deletedAt
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(purgedPost, nil)
+	pu.mockLikeRepository.On("DeleteLikes", mock.Anything, bson.M{"resource_id": "postid1", "resource_type": "post"}).Return(nil)
+	pu.mockSaveRepository.On("DeleteSaves", mock.Anything, bson.M{"post_id": "postid1"}).Return(nil)
+	pu.mockHighlightRepository.On("FindHighlights", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockPostRepository.On("DeletePost", mock.Anything, "postid1").Return(nil)
+	pu.mockFileOsHelper.On("Remove", "jpg.jpg").Return(nil)
+	pu.mockCommentRepository.On("FindComments", mock.Anything, bson.M{"post_id": "postid1"}).Return(&[]bson.M{{"_id": "commentid1"}, {"_id": "commentid2"}}, nil)
+	commentLikesFilter := bson.M{"resource_id": bson.M{"$in": bson.A{"commentid1", "commentid2"}}, "resource_type": "comment"}
+	pu.mockLikeRepository.On("DeleteLikes", mock.Anything, commentLikesFilter).Return(nil)
+	pu.mockCommentRepository.On("DeleteComments", mock.Anything, bson.M{"post_id": "postid1"}).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PurgeDeletedPosts(context.TODO())
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockLikeRepository.AssertCalled(pu.T(), "DeleteLikes", mock.Anything, bson.M{"resource_id": "postid1", "resource_type": "post"})
+	pu.mockSaveRepository.AssertCalled(pu.T(), "DeleteSaves", mock.Anything, bson.M{"post_id": "postid1"})
+	pu.mockPostRepository.AssertCalled(pu.T(), "DeletePost", mock.Anything, "postid1")
+	pu.mockFileOsHelper.AssertCalled(pu.T(), "Remove", "jpg.jpg")
+	pu.mockLikeRepository.AssertCalled(pu.T(), "DeleteLikes", mock.Anything, commentLikesFilter)
+	pu.mockCommentRepository.AssertCalled(pu.T(), "DeleteComments", mock.Anything, bson.M{"post_id": "postid1"})
+}
+
+func (pu *PostUsecaseSuite) TestPurgeDeletedPostsKeepsHighlightedVisualMedias() {
+	deletedAt := time.Now().Add(-domain.TrashRetention - time.Hour)
+	purgedPost := domain.NewPost("postid1", "userid1", []domain.VisualMedia{*domain.NewVisualMedia(domain.VisualMediaTypeImage, "image/jpeg", 1, 1, 0, 100, "", []domain.VisualMediaVariant{{Type: domain.VisualMediaVariantOriginal, Url: "jpg.jpg"}})}, "caption1", 0, time.Now(), time.Now())
+	purgedPost.DeletedAt = &deletedAt
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(purgedPost, nil)
+	pu.mockLikeRepository.On("DeleteLikes", mock.Anything, mock.Anything).Return(nil)
+	pu.mockSaveRepository.On("DeleteSaves", mock.Anything, mock.Anything).Return(nil)
+	pu.mockHighlightRepository.On("FindHighlights", mock.Anything, bson.M{"items.visual_media.variants.url": bson.M{"$in": bson.A{"jpg.jpg"}}}).Return(&[]bson.M{{"_id": "highlightid1"}}, nil)
+	pu.mockPostRepository.On("DeletePost", mock.Anything, "postid1").Return(nil)
+	pu.mockCommentRepository.On("FindComments", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PurgeDeletedPosts(context.TODO())
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "DeletePost", mock.Anything, "postid1")
+	pu.mockFileOsHelper.AssertNotCalled(pu.T(), "Remove", mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestPurgeDeletedPostsDeleteLikesError() {
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now()), nil)
+	pu.mockLikeRepository.On("DeleteLikes", mock.Anything, mock.Anything).Return(errors.New("DeleteLikes return error"))
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PurgeDeletedPosts(context.TODO())
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "DeletePost", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestFindSavedPostsUnauthorizedCollectionView() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockCollectionRepository.On("FindCollections", mock.Anything, bson.M{"_id": "collectionid1"}).Return(&[]bson.M{{"_id": "collectionid1"}}, nil)
+	pu.mockCollectionRepository.On("FindOneCollection", mock.Anything, "collectionid1").Return(domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()), nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindSavedPosts(context.TODO(), "collectionid1", 1, 10, "accessToken")
+
+	expectedError := domain.ErrUnauthorizedCollectionView.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockSaveRepository.AssertNotCalled(pu.T(), "FindSavedPosts", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestFindSavedPostsCollectionNotFound() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockCollectionRepository.On("FindCollections", mock.Anything, bson.M{"_id": "collectionid1"}).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindSavedPosts(context.TODO(), "collectionid1", 1, 10, "accessToken")
+
+	expectedError := domain.ErrCollectionNotFound.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestFindSavedPostsJoinsPublicPosts() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockCollectionRepository.On("FindCollections", mock.Anything, bson.M{"_id": "collectionid1"}).Return(&[]bson.M{{"_id": "collectionid1"}}, nil)
+	pu.mockCollectionRepository.On("FindOneCollection", mock.Anything, "collectionid1").Return(domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()), nil)
+	expectedPostFilter := bson.M{
+		"status":        bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}},
+		"archived_date": nil,
+		"deleted_at":    nil,
+	}
+	pu.mockSaveRepository.On("FindSavedPosts", mock.Anything, bson.M{"user_id": "userid1", "collection_id": "collectionid1"}, expectedPostFilter, int64(0), int64(10)).Return(&[]bson.M{
+		{"_id": "postid3", "user_id": "userid2", "caption": "caption3",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+		{"_id": "postid1", "user_id": "userid2", "caption": "caption1",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		{"_id": "saveid3", "user_id": "userid1", "post_id": "postid3"},
+		{"_id": "saveid1", "user_id": "userid1", "post_id": "postid1"},
+	}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindSavedPosts(context.TODO(), "collectionid1", 1, 10, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	assert.Equal(pu.T(), 2, len(*result), "length of result should be 2")
+	assert.Equalf(pu.T(), "postid3", (*result)[0].Id, "Should have listed the most recently saved post first but got %s", (*result)[0].Id)
+	assert.Truef(pu.T(), *(*result)[0].ViewerHasSaved, "Should have marked the saved post as saved by the viewer")
+}
+
+func (pu *PostUsecaseSuite) TestFindSavedPostsPaginates() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockSaveRepository.On("FindSavedPosts", mock.Anything, bson.M{"user_id": "userid1"}, mock.AnythingOfType("M"), int64(1), int64(1)).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindSavedPosts(context.TODO(), "", 2, 1, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockSaveRepository.AssertCalled(pu.T(), "FindSavedPosts", mock.Anything, bson.M{"user_id": "userid1"}, mock.AnythingOfType("M"), int64(1), int64(1))
+	pu.mockCollectionRepository.AssertNotCalled(pu.T(), "FindCollections", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestFindUserPostsPinnedFirst() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pinnedPosts := &[]bson.M{
+		{"_id": "postid1", "user_id": "userid1", "caption": "caption1",
+			"pinned_date":  primitive.NewDateTimeFromTime(time.Now().Add(-time.Hour)),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+		{"_id": "postid2", "user_id": "userid1", "caption": "caption2",
+			"pinned_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}
+	otherPosts := &[]bson.M{
+		{"_id": "postid3", "user_id": "userid1", "caption": "caption3",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}
+	pu.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": "userid1"}).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"user_id": "userid1", "pinned_date": bson.M{"$ne": nil}, "status": bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}}, "archived_date": nil, "deleted_at": nil}).Return(pinnedPosts, nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, bson.M{"user_id": "userid1", "pinned_date": nil, "status": bson.M{"$nin": bson.A{domain.PostStatusDraft, domain.PostStatusScheduled}}, "archived_date": nil, "deleted_at": nil}, int64(0), int64(1)).Return(otherPosts, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	result, err := postUsecase.FindUserPosts(context.TODO(), "userid1", 1, 3, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	var resultIds []string
+	for _, post := range *result {
+		resultIds = append(resultIds, post.Id)
+	}
+	expectedIds := []string{"postid2", "postid1", "postid3"}
+	assert.Equalf(pu.T(), expectedIds, resultIds, "Should have return posts %v but got %v", expectedIds, resultIds)
+	assert.NotNilf(pu.T(), (*result)[0].PinnedDate, "Should have return the pinned date of a pinned post")
+}
+
+func (pu *PostUsecaseSuite) TestFindUserPostsPaginatedAfterPinned() {
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pinnedPosts := &[]bson.M{
+		{"_id": "postid1", "user_id": "userid1", "caption": "caption1",
+			"pinned_date":  primitive.NewDateTimeFromTime(time.Now()),
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}
+	pu.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": "userid1"}).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(pinnedPosts, nil)
+	pu.mockPostRepository.On("FindPaginatedPosts", mock.Anything, mock.AnythingOfType("M"), int64(1), int64(2)).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("FindLikes", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	pu.mockLikeRepository.On("CountReactions", mock.Anything, domain.LikeResourcePost, mock.Anything).Return(map[string]map[string]int{}, nil)
+	pu.mockSaveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	_, err := postUsecase.FindUserPosts(context.TODO(), "userid1", 2, 2, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not return error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "FindPaginatedPosts", mock.Anything, mock.AnythingOfType("M"), int64(1), int64(2))
+}
+
+func (pu *PostUsecaseSuite) TestPinPostUnauthorizedPostPin() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PinPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrUnauthorizedPostPin.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestPinPostUnpublishedPostPin() {
+	archivedDate := time.Now()
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.ArchivedDate = &archivedDate
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PinPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrUnpublishedPostPin.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestPinPostPinnedPostLimit() {
+	foundPost := domain.NewPost("postid4", "userid1", nil, "caption4", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid4", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid4"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid4").Return(foundPost, nil)
+	pu.mockUserRepository.On("IncrementUserPinnedCount", mock.Anything, "userid1", domain.MaxPinnedPosts).Return(false, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PinPost(context.TODO(), "postid4", "accessToken")
+
+	expectedError := domain.ErrPinnedPostLimit.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "UpdatePostPinnedDate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestPinPostSuccessful() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+	pu.mockUserRepository.On("IncrementUserPinnedCount", mock.Anything, "userid1", domain.MaxPinnedPosts).Return(true, nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", mock.AnythingOfType("*time.Time")).Return(true, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PinPost(context.TODO(), "postid1", "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostPinnedDate", mock.Anything, "postid1", mock.AnythingOfType("*time.Time"))
+	pu.mockUserRepository.AssertNotCalled(pu.T(), "DecrementUserPinnedCount", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestPinPostUpdatePostPinnedDateError() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+	pu.mockUserRepository.On("IncrementUserPinnedCount", mock.Anything, "userid1", domain.MaxPinnedPosts).Return(true, nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", mock.AnythingOfType("*time.Time")).Return(false, errors.New("UpdatePostPinnedDate error"))
+	pu.mockUserRepository.On("DecrementUserPinnedCount", mock.Anything, "userid1").Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PinPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockUserRepository.AssertCalled(pu.T(), "DecrementUserPinnedCount", mock.Anything, "userid1")
+}
+
+func (pu *PostUsecaseSuite) TestPinPostPinnedConcurrently() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+	pu.mockUserRepository.On("IncrementUserPinnedCount", mock.Anything, "userid1", domain.MaxPinnedPosts).Return(true, nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", mock.AnythingOfType("*time.Time")).Return(false, nil)
+	pu.mockUserRepository.On("DecrementUserPinnedCount", mock.Anything, "userid1").Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.PinPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrPostPinConflict.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockUserRepository.AssertCalled(pu.T(), "DecrementUserPinnedCount", mock.Anything, "userid1")
+}
+
+func (pu *PostUsecaseSuite) TestUnpinPostNotPinned() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UnpinPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrPostNotPinned.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+}
+
+func (pu *PostUsecaseSuite) TestUnpinPostUnpinnedConcurrently() {
+	pinnedDate := time.Now()
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.PinnedDate = &pinnedDate
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", (*time.Time)(nil)).Return(false, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UnpinPost(context.TODO(), "postid1", "accessToken")
+
+	expectedError := domain.ErrPostNotPinned.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockUserRepository.AssertNotCalled(pu.T(), "DecrementUserPinnedCount", mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestUnpinPostSuccessful() {
+	pinnedDate := time.Now()
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	foundPost.PinnedDate = &pinnedDate
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostPinnedDate", mock.Anything, "postid1", (*time.Time)(nil)).Return(true, nil)
+	pu.mockUserRepository.On("DecrementUserPinnedCount", mock.Anything, "userid1").Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UnpinPost(context.TODO(), "postid1", "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostPinnedDate", mock.Anything, "postid1", (*time.Time)(nil))
+	pu.mockUserRepository.AssertCalled(pu.T(), "DecrementUserPinnedCount", mock.Anything, "userid1")
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostSettingsUnauthorizedPostUpdate() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostSettings(context.TODO(), "postid1", true, true, "accessToken")
+
+	expectedError := domain.ErrUnauthorizedPostUpdate.Error()
+	assert.EqualErrorf(pu.T(), err, expectedError, "Should have returned %s but got %s", expectedError, err)
+	pu.mockPostRepository.AssertNotCalled(pu.T(), "UpdatePostSettings", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (pu *PostUsecaseSuite) TestUpdatePostSettingsSuccessful() {
+	foundPost := domain.NewPost("postid1", "userid1", nil, "caption1", 0, time.Now(), time.Now())
+	pu.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	pu.mockPostRepository.On("FindPosts", mock.Anything, bson.M{"_id": "postid1", "deleted_at": nil}).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	pu.mockPostRepository.On("FindOnePost", mock.Anything, "postid1").Return(foundPost, nil)
+	pu.mockPostRepository.On("UpdatePostSettings", mock.Anything, "postid1", true, false).Return(nil)
+
+	postUsecase := usecase.NewPostUseCase(pu.mockPostRepository, pu.mockLikeRepository, pu.mockCommentRepository, pu.mockHashtagRepository, pu.mockUserRepository, pu.mockMentionRepository, pu.mockSaveRepository, pu.mockCollectionRepository, pu.mockHighlightRepository, pu.mockHeaderHelper, pu.mockFileOsHelper)
+	err := postUsecase.UpdatePostSettings(context.TODO(), "postid1", true, false, "accessToken")
+
+	assert.NoErrorf(pu.T(), err, "Should have not returned error but got %s", err)
+	pu.mockPostRepository.AssertCalled(pu.T(), "UpdatePostSettings", mock.Anything, "postid1", true, false)
 }
@@ -0,0 +1,353 @@
+package http
+
+import (
+	"encoding/json"
+	"instagram-go/domain"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+type SaveHandler struct {
+	saveUsecase domain.SaveUsecase
+}
+
+func NewSaveHandler(saveUsecase domain.SaveUsecase) domain.SaveHandler {
+	return &SaveHandler{
+		saveUsecase: saveUsecase,
+	}
+}
+
+func (sh *SaveHandler) PostSaves(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		sh.postSave(w, r)
+		return
+	case "PUT":
+		sh.putSave(w, r)
+		return
+	case "DELETE":
+		sh.deleteSave(w, r)
+		return
+	}
+}
+
+func (sh *SaveHandler) Collections(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		sh.getCollections(w, r)
+		return
+	case "POST":
+		sh.postCollection(w, r)
+		return
+	}
+}
+
+func (sh *SaveHandler) Collection(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		sh.putCollection(w, r)
+		return
+	case "DELETE":
+		sh.deleteCollection(w, r)
+		return
+	}
+}
+
+func (sh *SaveHandler) postSave(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	var save domain.Save
+	if len(bodyBytes) > 0 {
+		err = json.Unmarshal(bodyBytes, &save)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+	}
+
+	err = sh.saveUsecase.InsertSave(r.Context(), postId, save.CollectionId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Post successfully Saved")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	w.Write(responseBytes)
+}
+
+func (sh *SaveHandler) putSave(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	var save domain.Save
+	err = json.Unmarshal(bodyBytes, &save)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	err = sh.saveUsecase.UpdateSaveCollection(r.Context(), postId, save.CollectionId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Save successfully Moved")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (sh *SaveHandler) deleteSave(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	urlParts := strings.Split(r.URL.String(), "/")
+	postId := urlParts[2]
+	err := sh.saveUsecase.DeleteSave(r.Context(), postId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Save successfully Deleted")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (sh *SaveHandler) getCollections(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	collections, err := sh.saveUsecase.FindCollections(r.Context(), tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataCollections := domain.NewDataCollections(*collections)
+	response := domain.NewDataResponseCollections(*dataCollections)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (sh *SaveHandler) postCollection(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	var collection domain.Collection
+	err = json.Unmarshal(bodyBytes, &collection)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	collection.Name = strings.TrimSpace(collection.Name)
+	if collection.Name == "" {
+		response := domain.NewMessage(domain.ErrMissingCollectionNameInput.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(domain.ErrMissingCollectionNameInput))
+		w.Write(responseBytes)
+		return
+	}
+
+	err = sh.saveUsecase.InsertCollection(r.Context(), &collection, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Collection successfully Created")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	w.Write(responseBytes)
+}
+
+func (sh *SaveHandler) putCollection(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	urlParts := strings.Split(r.URL.String(), "/")
+	collectionId := urlParts[2]
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	var collection domain.Collection
+	err = json.Unmarshal(bodyBytes, &collection)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	collection.Id = collectionId
+	collection.Name = strings.TrimSpace(collection.Name)
+	if collection.Name == "" {
+		response := domain.NewMessage(domain.ErrMissingCollectionNameInput.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(domain.ErrMissingCollectionNameInput))
+		w.Write(responseBytes)
+		return
+	}
+
+	err = sh.saveUsecase.UpdateCollection(r.Context(), &collection, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Collection successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (sh *SaveHandler) deleteCollection(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	urlParts := strings.Split(r.URL.String(), "/")
+	collectionId := urlParts[2]
+	err := sh.saveUsecase.DeleteCollection(r.Context(), collectionId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(saveGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Collection successfully Deleted")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func saveGetStatusCode(err error) int {
+	switch err {
+	case domain.ErrInternalServerError:
+		return http.StatusInternalServerError
+	case domain.ErrPostNotFound, domain.ErrSaveNotFound, domain.ErrCollectionNotFound:
+		return http.StatusNotFound
+	case domain.ErrPostSaveConflict:
+		return http.StatusConflict
+	case domain.ErrUnauthorizedCollectionUpdate, domain.ErrUnauthorizedCollectionDelete:
+		return http.StatusUnauthorized
+	case domain.ErrMissingCollectionNameInput:
+		return http.StatusBadRequest
+	}
+	return http.StatusOK
+}
@@ -0,0 +1,159 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	saveHttp "instagram-go/save/delivery/http"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSaveHandlerSuite(t *testing.T) {
+	suite.Run(t, new(SaveHandlerSuite))
+}
+
+type SaveHandlerSuite struct {
+	suite.Suite
+	saveUsecase *mocks.SaveUsecase
+}
+
+func (sh *SaveHandlerSuite) SetupTest() {
+	sh.saveUsecase = new(mocks.SaveUsecase)
+}
+
+func (sh *SaveHandlerSuite) TestPostSaveWithoutBodySuccessful() {
+	sh.saveUsecase.On("InsertSave", mock.Anything, "postid1", "", mock.AnythingOfType("string")).Return(nil)
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("POST", "/posts/postid1/saves", bytes.NewBuffer([]byte{}))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.PostSaves)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
+	expectedBody := `{"message":"Post successfully Saved"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *SaveHandlerSuite) TestPostSavePostSaveConflict() {
+	sh.saveUsecase.On("InsertSave", mock.Anything, "postid1", "collectionid1", mock.AnythingOfType("string")).Return(domain.ErrPostSaveConflict)
+	requestBody, _ := json.Marshal(map[string]string{
+		"collection_id": "collectionid1",
+	})
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("POST", "/posts/postid1/saves", bytes.NewBuffer(requestBody))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.PostSaves)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusConflict, rr.Code, "Should have responded with http status code %v but got %v", http.StatusConflict, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrPostSaveConflict.Error() + `"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *SaveHandlerSuite) TestPutSaveSuccessful() {
+	sh.saveUsecase.On("UpdateSaveCollection", mock.Anything, "postid1", "collectionid2", mock.AnythingOfType("string")).Return(nil)
+	requestBody, _ := json.Marshal(map[string]string{
+		"collection_id": "collectionid2",
+	})
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("PUT", "/posts/postid1/saves", bytes.NewBuffer(requestBody))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.PostSaves)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Save successfully Moved"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *SaveHandlerSuite) TestDeleteSaveSaveNotFound() {
+	sh.saveUsecase.On("DeleteSave", mock.Anything, "postid1", mock.AnythingOfType("string")).Return(domain.ErrSaveNotFound)
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("DELETE", "/posts/postid1/saves", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.PostSaves)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrSaveNotFound.Error() + `"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *SaveHandlerSuite) TestGetCollectionsSuccessful() {
+	sh.saveUsecase.On("FindCollections", mock.Anything, mock.AnythingOfType("string")).Return(&[]domain.Collection{}, nil)
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("GET", "/collections", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.Collections)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"collections":[]}}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *SaveHandlerSuite) TestPostCollectionNameNotProvided() {
+	requestBody, _ := json.Marshal(map[string]string{
+		"name": " ",
+	})
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("POST", "/collections", bytes.NewBuffer(requestBody))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.Collections)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrMissingCollectionNameInput.Error() + `"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *SaveHandlerSuite) TestPostCollectionSuccessful() {
+	sh.saveUsecase.On("InsertCollection", mock.Anything, mock.AnythingOfType("*domain.Collection"), mock.AnythingOfType("string")).Return(nil)
+	requestBody, _ := json.Marshal(map[string]string{
+		"name": "recipes",
+	})
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("POST", "/collections", bytes.NewBuffer(requestBody))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.Collections)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
+	expectedBody := `{"message":"Collection successfully Created"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *SaveHandlerSuite) TestPutCollectionUnauthorizedCollectionUpdate() {
+	sh.saveUsecase.On("UpdateCollection", mock.Anything, mock.AnythingOfType("*domain.Collection"), mock.AnythingOfType("string")).Return(domain.ErrUnauthorizedCollectionUpdate)
+	requestBody, _ := json.Marshal(map[string]string{
+		"name": "dinner",
+	})
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("PUT", "/collections/collectionid1", bytes.NewBuffer(requestBody))
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.Collection)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	sh.saveUsecase.AssertCalled(sh.T(), "UpdateCollection", mock.Anything, &domain.Collection{Id: "collectionid1", Name: "dinner"}, mock.AnythingOfType("string"))
+}
+
+func (sh *SaveHandlerSuite) TestDeleteCollectionSuccessful() {
+	sh.saveUsecase.On("DeleteCollection", mock.Anything, "collectionid1", mock.AnythingOfType("string")).Return(nil)
+	saveHandler := saveHttp.NewSaveHandler(sh.saveUsecase)
+	req, _ := http.NewRequest("DELETE", "/collections/collectionid1", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(saveHandler.Collection)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Collection successfully Deleted"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
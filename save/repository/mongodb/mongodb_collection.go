@@ -0,0 +1,86 @@
+package mongodb
+
+import (
+	"context"
+	"instagram-go/domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodbCollectionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongodbCollectionRepository(collection *mongo.Collection) domain.CollectionRepository {
+	return &mongodbCollectionRepository{
+		collection: collection,
+	}
+}
+
+func (mcr *mongodbCollectionRepository) CreateIndexes(ctx context.Context) error {
+	userIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "created_date", Value: 1},
+		},
+	}
+	_, err := mcr.collection.Indexes().CreateOne(ctx, userIndex)
+	return err
+}
+
+func (mcr *mongodbCollectionRepository) InsertCollection(ctx context.Context, collection *domain.Collection) error {
+	newCollection := bson.D{
+		primitive.E{Key: "_id", Value: collection.Id},
+		primitive.E{Key: "user_id", Value: collection.UserId},
+		primitive.E{Key: "name", Value: collection.Name},
+		primitive.E{Key: "created_date", Value: collection.CreatedDate},
+		primitive.E{Key: "updated_date", Value: collection.UpdatedDate},
+	}
+	_, err := mcr.collection.InsertOne(ctx, newCollection)
+	return err
+}
+
+func (mcr *mongodbCollectionRepository) FindCollections(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "created_date", Value: 1}})
+	cursor, err := mcr.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (mcr *mongodbCollectionRepository) FindOneCollection(ctx context.Context, collectionId string) (*domain.Collection, error) {
+	var collection domain.Collection
+	filter := bson.M{"_id": collectionId}
+	err := mcr.collection.FindOne(ctx, filter).Decode(&collection)
+	return &collection, err
+}
+
+func (mcr *mongodbCollectionRepository) UpdateCollection(ctx context.Context, collectionId string, name string, updatedDate time.Time) error {
+	filter := bson.M{"_id": collectionId}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "name",
+			Value: name}, primitive.E{
+			Key:   "updated_date",
+			Value: updatedDate},
+		},
+	}}
+	_, err := mcr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (mcr *mongodbCollectionRepository) DeleteCollection(ctx context.Context, collectionId string) error {
+	filter := bson.M{"_id": collectionId}
+	_, err := mcr.collection.DeleteOne(ctx, filter)
+	return err
+}
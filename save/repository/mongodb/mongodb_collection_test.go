@@ -0,0 +1,54 @@
+package mongodb_test
+
+import (
+	"context"
+	"instagram-go/domain"
+	"instagram-go/save/repository/mongodb"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestCollectionRepoSuite(t *testing.T) {
+	suite.Run(t, new(CollectionRepoSuite))
+}
+
+type CollectionRepoSuite struct {
+	suite.Suite
+	collection *mongo.Collection
+}
+
+func (cr *CollectionRepoSuite) SetupSuite() {
+	client, _ := mongo.Connect(context.TODO(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	cr.collection = client.Database("instagram_test").Collection("collections")
+}
+
+func (cr *CollectionRepoSuite) AfterTest(suiteName, testName string) {
+	cr.collection.Drop(context.TODO())
+}
+
+func (cr *CollectionRepoSuite) TestUpdateCollectionSuccessful() {
+	collectionRepo := mongodb.NewMongodbCollectionRepository(cr.collection)
+	_ = collectionRepo.InsertCollection(context.TODO(), domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()))
+
+	err := collectionRepo.UpdateCollection(context.TODO(), "collectionid1", "dinner", time.Now())
+	collection, _ := collectionRepo.FindOneCollection(context.TODO(), "collectionid1")
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(cr.T(), "dinner", collection.Name, "Should have return collection name %s but got %s", "dinner", collection.Name)
+}
+
+func (cr *CollectionRepoSuite) TestDeleteCollectionSuccessful() {
+	collectionRepo := mongodb.NewMongodbCollectionRepository(cr.collection)
+	_ = collectionRepo.InsertCollection(context.TODO(), domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()))
+
+	err := collectionRepo.DeleteCollection(context.TODO(), "collectionid1")
+	_, findErr := collectionRepo.FindOneCollection(context.TODO(), "collectionid1")
+
+	assert.NoErrorf(cr.T(), err, "Should have not return error but got %s", err)
+	assert.Errorf(cr.T(), findErr, "Should have return an error but didn't")
+}
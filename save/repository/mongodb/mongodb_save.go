@@ -0,0 +1,147 @@
+package mongodb
+
+import (
+	"context"
+	"instagram-go/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodbSaveRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongodbSaveRepository(collection *mongo.Collection) domain.SaveRepository {
+	return &mongodbSaveRepository{
+		collection: collection,
+	}
+}
+
+func (msr *mongodbSaveRepository) CreateIndexes(ctx context.Context) error {
+	userPostIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "post_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+	userCollectionIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "collection_id", Value: 1},
+			primitive.E{Key: "created_date", Value: -1},
+		},
+	}
+	postIndex := mongo.IndexModel{
+		Keys: bson.D{primitive.E{Key: "post_id", Value: 1}},
+	}
+	_, err := msr.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{userPostIndex, userCollectionIndex, postIndex})
+	return err
+}
+
+func (msr *mongodbSaveRepository) InsertSave(ctx context.Context, save *domain.Save) error {
+	newSave := bson.D{
+		primitive.E{Key: "_id", Value: save.Id},
+		primitive.E{Key: "user_id", Value: save.UserId},
+		primitive.E{Key: "post_id", Value: save.PostId},
+		primitive.E{Key: "collection_id", Value: save.CollectionId},
+		primitive.E{Key: "created_date", Value: save.CreatedDate},
+	}
+	_, err := msr.collection.InsertOne(ctx, newSave)
+	if mongo.IsDuplicateKeyError(err) {
+		return domain.ErrPostSaveConflict
+	}
+	return err
+}
+
+func (msr *mongodbSaveRepository) FindSaves(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "created_date", Value: -1}})
+	cursor, err := msr.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+// Posts are joined from the posts collection before paging, so saves of hidden posts don't leave short pages.
+func (msr *mongodbSaveRepository) FindSavedPosts(ctx context.Context, saveFilter interface{}, postFilter interface{}, skip int64, limit int64) (*[]bson.M, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{primitive.E{Key: "$match", Value: saveFilter}},
+		bson.D{primitive.E{Key: "$sort", Value: bson.D{primitive.E{Key: "created_date", Value: -1}}}},
+		bson.D{primitive.E{Key: "$lookup", Value: bson.M{
+			"from": "posts",
+			"let":  bson.M{"post_id": "$post_id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$_id", "$$post_id"}}}},
+				bson.M{"$match": postFilter},
+			},
+			"as": "post",
+		}}},
+		bson.D{primitive.E{Key: "$unwind", Value: "$post"}},
+		bson.D{primitive.E{Key: "$skip", Value: skip}},
+		bson.D{primitive.E{Key: "$limit", Value: limit}},
+		bson.D{primitive.E{Key: "$replaceRoot", Value: bson.M{"newRoot": "$post"}}},
+	}
+	cursor, err := msr.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (msr *mongodbSaveRepository) FindOneSave(ctx context.Context, saveId string) (*domain.Save, error) {
+	var save domain.Save
+	filter := bson.M{"_id": saveId}
+	err := msr.collection.FindOne(ctx, filter).Decode(&save)
+	return &save, err
+}
+
+func (msr *mongodbSaveRepository) UpdateSaveCollection(ctx context.Context, saveId string, collectionId string) error {
+	filter := bson.M{"_id": saveId}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "collection_id",
+			Value: collectionId,
+		},
+		},
+	}}
+	_, err := msr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (msr *mongodbSaveRepository) UnsetSavesCollection(ctx context.Context, collectionId string) error {
+	filter := bson.M{"collection_id": collectionId}
+	update := bson.D{primitive.E{
+		Key: "$set",
+		Value: bson.D{primitive.E{
+			Key:   "collection_id",
+			Value: "",
+		},
+		},
+	}}
+	_, err := msr.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+func (msr *mongodbSaveRepository) DeleteSave(ctx context.Context, saveId string) error {
+	filter := bson.M{"_id": saveId}
+	_, err := msr.collection.DeleteOne(ctx, filter)
+	return err
+}
+
+func (msr *mongodbSaveRepository) DeleteSaves(ctx context.Context, filter interface{}) error {
+	_, err := msr.collection.DeleteMany(ctx, filter)
+	return err
+}
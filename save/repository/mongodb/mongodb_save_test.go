@@ -0,0 +1,99 @@
+package mongodb_test
+
+import (
+	"context"
+	"instagram-go/domain"
+	"instagram-go/save/repository/mongodb"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestSaveRepoSuite(t *testing.T) {
+	suite.Run(t, new(SaveRepoSuite))
+}
+
+type SaveRepoSuite struct {
+	suite.Suite
+	collection *mongo.Collection
+}
+
+func (sr *SaveRepoSuite) SetupSuite() {
+	client, _ := mongo.Connect(context.TODO(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	sr.collection = client.Database("instagram_test").Collection("saves")
+}
+
+func (sr *SaveRepoSuite) AfterTest(suiteName, testName string) {
+	sr.collection.Drop(context.TODO())
+}
+
+func (sr *SaveRepoSuite) TestInsertDuplicateUserPostSave() {
+	saveRepo := mongodb.NewMongodbSaveRepository(sr.collection)
+	_ = saveRepo.CreateIndexes(context.TODO())
+
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid1", "userid1", "postid1", "", time.Now()))
+	err := saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid2", "userid1", "postid1", "", time.Now()))
+
+	expectedError := domain.ErrPostSaveConflict.Error()
+	assert.EqualErrorf(sr.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (sr *SaveRepoSuite) TestFindSavesSortedByNewest() {
+	saveRepo := mongodb.NewMongodbSaveRepository(sr.collection)
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid1", "userid1", "postid1", "", time.Now().Add(-time.Hour)))
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid2", "userid1", "postid2", "", time.Now()))
+
+	saves, err := saveRepo.FindSaves(context.TODO(), bson.M{"user_id": "userid1"})
+
+	assert.NoErrorf(sr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(sr.T(), "saveid2", (*saves)[0]["_id"], "Should have return %s first but got %s", "saveid2", (*saves)[0]["_id"])
+}
+
+func (sr *SaveRepoSuite) TestFindSavedPostsSkipsHiddenPostsBeforePaging() {
+	postsCollection := sr.collection.Database().Collection("posts")
+	defer postsCollection.Drop(context.TODO())
+	_, _ = postsCollection.InsertMany(context.TODO(), []interface{}{
+		bson.M{"_id": "postid1", "deleted_at": nil},
+		bson.M{"_id": "postid2", "deleted_at": time.Now()},
+		bson.M{"_id": "postid3", "deleted_at": nil},
+	})
+	saveRepo := mongodb.NewMongodbSaveRepository(sr.collection)
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid1", "userid1", "postid1", "", time.Now().Add(-2*time.Hour)))
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid2", "userid1", "postid2", "", time.Now().Add(-time.Hour)))
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid3", "userid1", "postid3", "", time.Now()))
+
+	posts, err := saveRepo.FindSavedPosts(context.TODO(), bson.M{"user_id": "userid1"}, bson.M{"deleted_at": nil}, 1, 1)
+
+	assert.NoErrorf(sr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(sr.T(), 1, len(*posts), "Should have return %d posts but got %d", 1, len(*posts))
+	assert.Equalf(sr.T(), "postid1", (*posts)[0]["_id"], "Should have return %s but got %s", "postid1", (*posts)[0]["_id"])
+}
+
+func (sr *SaveRepoSuite) TestUnsetSavesCollectionSuccessful() {
+	saveRepo := mongodb.NewMongodbSaveRepository(sr.collection)
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid1", "userid1", "postid1", "collectionid1", time.Now()))
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid2", "userid1", "postid2", "collectionid1", time.Now()))
+
+	err := saveRepo.UnsetSavesCollection(context.TODO(), "collectionid1")
+	saves, _ := saveRepo.FindSaves(context.TODO(), bson.M{"collection_id": "collectionid1"})
+
+	assert.NoErrorf(sr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(sr.T(), 0, len(*saves), "Should have return %d saves but got %d", 0, len(*saves))
+}
+
+func (sr *SaveRepoSuite) TestDeleteSavesSuccessful() {
+	saveRepo := mongodb.NewMongodbSaveRepository(sr.collection)
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid1", "userid1", "postid1", "", time.Now()))
+	_ = saveRepo.InsertSave(context.TODO(), domain.NewSave("saveid2", "userid2", "postid1", "", time.Now()))
+
+	err := saveRepo.DeleteSaves(context.TODO(), bson.M{"post_id": "postid1"})
+	saves, _ := saveRepo.FindSaves(context.TODO(), bson.M{})
+
+	assert.NoErrorf(sr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(sr.T(), 0, len(*saves), "Should have return %d saves but got %d", 0, len(*saves))
+}
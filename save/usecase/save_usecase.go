@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"instagram-go/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type saveUsecase struct {
+	saveRepository       domain.SaveRepository
+	collectionRepository domain.CollectionRepository
+	postRepository       domain.PostRepository
+	headerHelper         domain.IHeaderHelper
+}
+
+func NewSaveUsecase(saveRepository domain.SaveRepository, collectionRepository domain.CollectionRepository, postRepository domain.PostRepository, headerHelper domain.IHeaderHelper) domain.SaveUsecase {
+	return &saveUsecase{
+		saveRepository:       saveRepository,
+		collectionRepository: collectionRepository,
+		postRepository:       postRepository,
+		headerHelper:         headerHelper,
+	}
+}
+
+func (su *saveUsecase) InsertSave(ctx context.Context, postId string, collectionId string, tokenString string) error {
+	userId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	filter := domain.VisiblePostFilter(bson.M{"_id": postId}, userId)
+	queryResult, err := su.postRepository.FindPosts(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrPostNotFound
+	}
+	if collectionId != "" {
+		err = su.findOwnedCollection(ctx, collectionId, userId, domain.ErrUnauthorizedCollectionUpdate)
+		if err != nil {
+			return err
+		}
+	}
+
+	save := domain.NewSave("save-"+uuid.NewString(), userId, postId, collectionId, time.Now())
+	err = su.saveRepository.InsertSave(ctx, save)
+	if err == domain.ErrPostSaveConflict {
+		return err
+	}
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (su *saveUsecase) UpdateSaveCollection(ctx context.Context, postId string, collectionId string, tokenString string) error {
+	userId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	saveId, err := su.findSaveId(ctx, userId, postId)
+	if err != nil {
+		return err
+	}
+	if collectionId != "" {
+		err = su.findOwnedCollection(ctx, collectionId, userId, domain.ErrUnauthorizedCollectionUpdate)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = su.saveRepository.UpdateSaveCollection(ctx, saveId, collectionId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (su *saveUsecase) DeleteSave(ctx context.Context, postId string, tokenString string) error {
+	userId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	saveId, err := su.findSaveId(ctx, userId, postId)
+	if err != nil {
+		return err
+	}
+
+	err = su.saveRepository.DeleteSave(ctx, saveId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (su *saveUsecase) findSaveId(ctx context.Context, userId string, postId string) (string, error) {
+	filter := bson.M{"user_id": userId, "post_id": postId}
+	queryResult, err := su.saveRepository.FindSaves(ctx, filter)
+	if err != nil {
+		return "", domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return "", domain.ErrSaveNotFound
+	}
+	return fmt.Sprintf("%v", (*queryResult)[0]["_id"]), nil
+}
+
+// Collections are private, so any other user gets unauthorizedErr.
+func (su *saveUsecase) findOwnedCollection(ctx context.Context, collectionId string, userId string, unauthorizedErr error) error {
+	filter := bson.M{"_id": collectionId}
+	queryResult, err := su.collectionRepository.FindCollections(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrCollectionNotFound
+	}
+
+	collection, err := su.collectionRepository.FindOneCollection(ctx, collectionId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if collection.UserId != userId {
+		return unauthorizedErr
+	}
+	return nil
+}
+
+func (su *saveUsecase) FindCollections(ctx context.Context, tokenString string) (*[]domain.Collection, error) {
+	userId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"user_id": userId}
+	queryResult, err := su.collectionRepository.FindCollections(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	var collections []domain.Collection
+	for _, v := range *queryResult {
+		id := fmt.Sprintf("%v", v["_id"])
+		name := fmt.Sprintf("%v", v["name"])
+		createdDate := v["created_date"].(primitive.DateTime).Time()
+		updatedDate := v["updated_date"].(primitive.DateTime).Time()
+		collection := domain.NewCollection(id, userId, name, createdDate, updatedDate)
+		collections = append(collections, *collection)
+	}
+	return &collections, nil
+}
+
+func (su *saveUsecase) InsertCollection(ctx context.Context, collection *domain.Collection, tokenString string) error {
+	userId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	collection.Id = "collection-" + uuid.NewString()
+	collection.UserId = userId
+	collection.CreatedDate = time.Now()
+	collection.UpdatedDate = collection.CreatedDate
+
+	err = su.collectionRepository.InsertCollection(ctx, collection)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (su *saveUsecase) UpdateCollection(ctx context.Context, collection *domain.Collection, tokenString string) error {
+	userId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = su.findOwnedCollection(ctx, collection.Id, userId, domain.ErrUnauthorizedCollectionUpdate)
+	if err != nil {
+		return err
+	}
+
+	err = su.collectionRepository.UpdateCollection(ctx, collection.Id, collection.Name, time.Now())
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (su *saveUsecase) DeleteCollection(ctx context.Context, collectionId string, tokenString string) error {
+	userId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = su.findOwnedCollection(ctx, collectionId, userId, domain.ErrUnauthorizedCollectionDelete)
+	if err != nil {
+		return err
+	}
+
+	err = su.saveRepository.UnsetSavesCollection(ctx, collectionId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	err = su.collectionRepository.DeleteCollection(ctx, collectionId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
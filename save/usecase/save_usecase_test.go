@@ -0,0 +1,214 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	"instagram-go/save/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSaveUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(SaveUsecaseSuite))
+}
+
+type SaveUsecaseSuite struct {
+	suite.Suite
+	saveRepository       *mocks.SaveRepository
+	collectionRepository *mocks.CollectionRepository
+	postRepository       *mocks.PostRepository
+	headerHelper         *mocks.IHeaderHelper
+}
+
+func (su *SaveUsecaseSuite) SetupTest() {
+	su.saveRepository = new(mocks.SaveRepository)
+	su.collectionRepository = new(mocks.CollectionRepository)
+	su.postRepository = new(mocks.PostRepository)
+	su.headerHelper = new(mocks.IHeaderHelper)
+}
+
+func (su *SaveUsecaseSuite) TestInsertSavePostNotFound() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.postRepository.On("FindPosts", mock.Anything, domain.VisiblePostFilter(bson.M{"_id": "postid1"}, "userid1")).Return(&[]bson.M{}, nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.InsertSave(context.TODO(), "postid1", "", "token1")
+
+	expectedError := domain.ErrPostNotFound.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (su *SaveUsecaseSuite) TestInsertSaveUnauthorizedCollectionUpdate() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	su.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	su.collectionRepository.On("FindCollections", mock.Anything, bson.M{"_id": "collectionid1"}).Return(&[]bson.M{{"_id": "collectionid1"}}, nil)
+	su.collectionRepository.On("FindOneCollection", mock.Anything, "collectionid1").Return(domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()), nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.InsertSave(context.TODO(), "postid1", "collectionid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedCollectionUpdate.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	su.saveRepository.AssertNotCalled(su.T(), "InsertSave", mock.Anything, mock.Anything)
+}
+
+func (su *SaveUsecaseSuite) TestInsertSavePostSaveConflict() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	su.saveRepository.On("InsertSave", mock.Anything, mock.AnythingOfType("*domain.Save")).Return(domain.ErrPostSaveConflict)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.InsertSave(context.TODO(), "postid1", "", "token1")
+
+	expectedError := domain.ErrPostSaveConflict.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (su *SaveUsecaseSuite) TestInsertSaveSuccessful() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.postRepository.On("FindPosts", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "postid1"}}, nil)
+	su.collectionRepository.On("FindCollections", mock.Anything, bson.M{"_id": "collectionid1"}).Return(&[]bson.M{{"_id": "collectionid1"}}, nil)
+	su.collectionRepository.On("FindOneCollection", mock.Anything, "collectionid1").Return(domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()), nil)
+	su.saveRepository.On("InsertSave", mock.Anything, mock.AnythingOfType("*domain.Save")).Return(nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.InsertSave(context.TODO(), "postid1", "collectionid1", "token1")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	su.saveRepository.AssertCalled(su.T(), "InsertSave", mock.Anything, mock.MatchedBy(func(save *domain.Save) bool {
+		return save.UserId == "userid1" && save.PostId == "postid1" && save.CollectionId == "collectionid1"
+	}))
+}
+
+func (su *SaveUsecaseSuite) TestUpdateSaveCollectionSaveNotFound() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.saveRepository.On("FindSaves", mock.Anything, bson.M{"user_id": "userid1", "post_id": "postid1"}).Return(&[]bson.M{}, nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.UpdateSaveCollection(context.TODO(), "postid1", "collectionid1", "token1")
+
+	expectedError := domain.ErrSaveNotFound.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (su *SaveUsecaseSuite) TestUpdateSaveCollectionSuccessful() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.saveRepository.On("FindSaves", mock.Anything, bson.M{"user_id": "userid1", "post_id": "postid1"}).Return(&[]bson.M{{"_id": "saveid1"}}, nil)
+	su.saveRepository.On("UpdateSaveCollection", mock.Anything, "saveid1", "").Return(nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.UpdateSaveCollection(context.TODO(), "postid1", "", "token1")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	su.saveRepository.AssertCalled(su.T(), "UpdateSaveCollection", mock.Anything, "saveid1", "")
+	su.collectionRepository.AssertNotCalled(su.T(), "FindCollections", mock.Anything, mock.Anything)
+}
+
+func (su *SaveUsecaseSuite) TestDeleteSaveDeleteSaveError() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.saveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "saveid1"}}, nil)
+	su.saveRepository.On("DeleteSave", mock.Anything, "saveid1").Return(errors.New("DeleteSave return error"))
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.DeleteSave(context.TODO(), "postid1", "token1")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (su *SaveUsecaseSuite) TestDeleteSaveSuccessful() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.saveRepository.On("FindSaves", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "saveid1"}}, nil)
+	su.saveRepository.On("DeleteSave", mock.Anything, "saveid1").Return(nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.DeleteSave(context.TODO(), "postid1", "token1")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+}
+
+func (su *SaveUsecaseSuite) TestFindCollectionsSuccessful() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.collectionRepository.On("FindCollections", mock.Anything, bson.M{"user_id": "userid1"}).Return(&[]bson.M{
+		{"_id": "collectionid1", "user_id": "userid1", "name": "recipes",
+			"created_date": primitive.NewDateTimeFromTime(time.Now()), "updated_date": primitive.NewDateTimeFromTime(time.Now())},
+	}, nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	collections, err := saveUsecase.FindCollections(context.TODO(), "token1")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(su.T(), 1, len(*collections), "Should have return %d collection but got %d", 1, len(*collections))
+	assert.Equalf(su.T(), "recipes", (*collections)[0].Name, "Should have return collection name %s but got %s", "recipes", (*collections)[0].Name)
+}
+
+func (su *SaveUsecaseSuite) TestInsertCollectionSuccessful() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.collectionRepository.On("InsertCollection", mock.Anything, mock.AnythingOfType("*domain.Collection")).Return(nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	collection := domain.Collection{Name: "recipes"}
+	err := saveUsecase.InsertCollection(context.TODO(), &collection, "token1")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(su.T(), "userid1", collection.UserId, "Should have set user id %s but got %s", "userid1", collection.UserId)
+	assert.NotEmptyf(su.T(), collection.Id, "Should have set the collection id")
+}
+
+func (su *SaveUsecaseSuite) TestUpdateCollectionUnauthorizedCollectionUpdate() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	su.collectionRepository.On("FindCollections", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "collectionid1"}}, nil)
+	su.collectionRepository.On("FindOneCollection", mock.Anything, "collectionid1").Return(domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()), nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.UpdateCollection(context.TODO(), &domain.Collection{Id: "collectionid1", Name: "dinner"}, "token1")
+
+	expectedError := domain.ErrUnauthorizedCollectionUpdate.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (su *SaveUsecaseSuite) TestUpdateCollectionSuccessful() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.collectionRepository.On("FindCollections", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "collectionid1"}}, nil)
+	su.collectionRepository.On("FindOneCollection", mock.Anything, "collectionid1").Return(domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()), nil)
+	su.collectionRepository.On("UpdateCollection", mock.Anything, "collectionid1", "dinner", mock.AnythingOfType("time.Time")).Return(nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.UpdateCollection(context.TODO(), &domain.Collection{Id: "collectionid1", Name: "dinner"}, "token1")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+}
+
+func (su *SaveUsecaseSuite) TestDeleteCollectionCollectionNotFound() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.collectionRepository.On("FindCollections", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.DeleteCollection(context.TODO(), "collectionid1", "token1")
+
+	expectedError := domain.ErrCollectionNotFound.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (su *SaveUsecaseSuite) TestDeleteCollectionSuccessful() {
+	su.headerHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.collectionRepository.On("FindCollections", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "collectionid1"}}, nil)
+	su.collectionRepository.On("FindOneCollection", mock.Anything, "collectionid1").Return(domain.NewCollection("collectionid1", "userid1", "recipes", time.Now(), time.Now()), nil)
+	su.saveRepository.On("UnsetSavesCollection", mock.Anything, "collectionid1").Return(nil)
+	su.collectionRepository.On("DeleteCollection", mock.Anything, "collectionid1").Return(nil)
+
+	saveUsecase := usecase.NewSaveUsecase(su.saveRepository, su.collectionRepository, su.postRepository, su.headerHelper)
+	err := saveUsecase.DeleteCollection(context.TODO(), "collectionid1", "token1")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	su.saveRepository.AssertCalled(su.T(), "UnsetSavesCollection", mock.Anything, "collectionid1")
+	su.collectionRepository.AssertCalled(su.T(), "DeleteCollection", mock.Anything, "collectionid1")
+}
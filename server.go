@@ -7,16 +7,32 @@ import (
 	commentRepo "instagram-go/comment/repository/mongodb"
 	commentUsecase "instagram-go/comment/usecase"
 	"instagram-go/domain"
+	hashtagHttp "instagram-go/hashtag/delivery/http"
+	hashtagRepo "instagram-go/hashtag/repository/mongodb"
+	hashtagUsecase "instagram-go/hashtag/usecase"
+	highlightHttp "instagram-go/highlight/delivery/http"
+	highlightRepo "instagram-go/highlight/repository/mongodb"
+	highlightUsecase "instagram-go/highlight/usecase"
 	likeHttp "instagram-go/like/delivery/http"
 	likeRepo "instagram-go/like/repository/mongodb"
 	likeUsecase "instagram-go/like/usecase"
+	mentionHttp "instagram-go/mention/delivery/http"
+	mentionRepo "instagram-go/mention/repository/mongodb"
+	mentionUsecase "instagram-go/mention/usecase"
 	"instagram-go/middlewares"
 	postHttp "instagram-go/post/delivery/http"
 	postRepo "instagram-go/post/repository/mongodb"
 	postUsecase "instagram-go/post/usecase"
+	saveHttp "instagram-go/save/delivery/http"
+	saveRepo "instagram-go/save/repository/mongodb"
+	saveUsecase "instagram-go/save/usecase"
+	storyHttp "instagram-go/story/delivery/http"
+	storyRepo "instagram-go/story/repository/mongodb"
+	storyUsecase "instagram-go/story/usecase"
 	userHttp "instagram-go/user/delivery/http"
 	userRepo "instagram-go/user/repository/mongodb"
 	userUsecase "instagram-go/user/usecase"
+	"log"
 	"net/http"
 	"strings"
 
@@ -36,40 +52,153 @@ func main() {
 	postsCollection := client.Database("instagram").Collection("posts")
 	likesCollection := client.Database("instagram").Collection("likes")
 	commentsCollection := client.Database("instagram").Collection("comments")
+	hashtagsCollection := client.Database("instagram").Collection("hashtags")
+	mentionsCollection := client.Database("instagram").Collection("mentions")
+	savesCollection := client.Database("instagram").Collection("saves")
+	collectionsCollection := client.Database("instagram").Collection("collections")
+	storiesCollection := client.Database("instagram").Collection("stories")
+	highlightsCollection := client.Database("instagram").Collection("highlights")
 
 	userRepository := userRepo.NewMongodbUserRepository(usersCollection)
 	postRepository := postRepo.NewMongodbPostRepository(postsCollection)
 	likeRepository := likeRepo.NewMongodbLikeRepository(likesCollection)
 	commentRepository := commentRepo.NewMongodbCommentRepository(commentsCollection)
+	hashtagRepository := hashtagRepo.NewMongodbHashtagRepository(hashtagsCollection)
+	mentionRepository := mentionRepo.NewMongodbMentionRepository(mentionsCollection)
+	saveRepository := saveRepo.NewMongodbSaveRepository(savesCollection)
+	collectionRepository := saveRepo.NewMongodbCollectionRepository(collectionsCollection)
+	storyRepository := storyRepo.NewMongodbStoryRepository(storiesCollection)
+	highlightRepository := highlightRepo.NewMongodbHighlightRepository(highlightsCollection)
+
+	if err := userRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := likeRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := postRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := mentionRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := saveRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := collectionRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := storyRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := highlightRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := commentRepository.CreateIndexes(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := postRepository.MigrateVisualMediaUrls(context.TODO()); err != nil {
+		panic(err)
+	}
+	if err := likeRepository.MigrateReactions(context.TODO()); err != nil {
+		panic(err)
+	}
 
 	authenticationHelper := domain.NewAuthenticationHelper()
 	fileOsHelper := domain.NewFileOsHelper()
 	headerHelper := domain.NewHeaderHelper()
+	contentModerator := domain.NewKeywordModerator(domain.DefaultOffensiveTerms)
 
 	userUseCase := userUsecase.NewUserUsecase(userRepository, authenticationHelper, headerHelper, fileOsHelper)
-	postUsecase := postUsecase.NewPostUseCase(postRepository, likeRepository, headerHelper, fileOsHelper)
-	likeUsecase := likeUsecase.NewLikeUsecase(likeRepository, postRepository, commentRepository, headerHelper)
-	commentUsecase := commentUsecase.NewCommentUsecase(commentRepository, postRepository, likeRepository, headerHelper)
+	postUsecase := postUsecase.NewPostUseCase(postRepository, likeRepository, commentRepository, hashtagRepository, userRepository, mentionRepository, saveRepository, collectionRepository, highlightRepository, headerHelper, fileOsHelper)
+	likeUsecase := likeUsecase.NewLikeUsecase(likeRepository, postRepository, commentRepository, userRepository, domain.DefaultReactions, headerHelper)
+	commentUsecase := commentUsecase.NewCommentUsecase(commentRepository, postRepository, likeRepository, hashtagRepository, userRepository, mentionRepository, contentModerator, headerHelper)
+	go func() {
+		if err := commentUsecase.MigrateCommentScores(context.Background()); err != nil {
+			log.Printf("migrating comment scores failed: %v", err)
+		}
+	}()
+	hashtagUsecase := hashtagUsecase.NewHashtagUsecase(hashtagRepository)
+	mentionUsecase := mentionUsecase.NewMentionUsecase(mentionRepository, userRepository, headerHelper)
+	saveUsecase := saveUsecase.NewSaveUsecase(saveRepository, collectionRepository, postRepository, headerHelper)
+	storyUsecase := storyUsecase.NewStoryUsecase(storyRepository, userRepository, headerHelper, fileOsHelper)
+	highlightUsecase := highlightUsecase.NewHighlightUsecase(highlightRepository, postRepository, userRepository, headerHelper, fileOsHelper)
 
 	postHandler := postHttp.NewPostHandler(postUsecase)
+	go domain.NewPeriodicJob("purging deleted posts", postUsecase.PurgeDeletedPosts, domain.TrashPurgeInterval).Run(context.Background())
+	go domain.NewPeriodicJob("publishing scheduled posts", postUsecase.PublishScheduledPosts, domain.PostSchedulerInterval).Run(context.Background())
 	userHandler := userHttp.NewUserHandler(userUseCase)
 	likeHandler := likeHttp.NewLikeHandler(likeUsecase)
 	commentHandler := commentHttp.NewCommentHandler(commentUsecase)
+	go domain.NewPeriodicJob("purging deleted comments", commentUsecase.PurgeDeletedComments, domain.TrashPurgeInterval).Run(context.Background())
+	hashtagHandler := hashtagHttp.NewHashtagHandler(hashtagUsecase)
+	mentionHandler := mentionHttp.NewMentionHandler(mentionUsecase)
+	saveHandler := saveHttp.NewSaveHandler(saveUsecase)
+	storyHandler := storyHttp.NewStoryHandler(storyUsecase)
+	go domain.NewPeriodicJob("purging expired stories", storyUsecase.PurgeExpiredStories, domain.StoryPurgeInterval).Run(context.Background())
+	highlightHandler := highlightHttp.NewHighlightHandler(highlightUsecase)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/users", userHandler.PostUser)
 	mux.HandleFunc("/authentications", userHandler.AuthenticateUser)
-	mux.HandleFunc("/users/", userHandler.PutUser)
+	mux.HandleFunc("/users/", func(w http.ResponseWriter, r *http.Request) {
+		urlParts := strings.Split(r.URL.Path, "/")
+		if len(urlParts) == 3 {
+			userHandler.PutUser(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "comment-filter" {
+			userHandler.UserCommentFilter(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "posts" {
+			postHandler.UserPosts(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "stories" {
+			storyHandler.UserStories(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "highlights" {
+			highlightHandler.UserHighlights(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "mentions" {
+			mentionHandler.UserMentions(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "tagged" {
+			postHandler.UserTaggedPosts(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "archive" {
+			postHandler.UserArchivedPosts(w, r)
+		} else if len(urlParts) == 5 && urlParts[3] == "recently-deleted" && urlParts[4] == "posts" {
+			postHandler.UserDeletedPosts(w, r)
+		} else if len(urlParts) == 5 && urlParts[3] == "recently-deleted" && urlParts[4] == "comments" {
+			commentHandler.UserDeletedComments(w, r)
+		}
+	})
 	mux.HandleFunc("/posts", postHandler.Posts)
 	mux.HandleFunc("/posts/", func(w http.ResponseWriter, r *http.Request) {
-		urlParts := strings.Split(r.URL.String(), "/")
-		if len(urlParts) == 3 {
+		urlParts := strings.Split(r.URL.Path, "/")
+		if len(urlParts) == 3 && urlParts[2] == "nearby" {
+			postHandler.NearbyPosts(w, r)
+		} else if len(urlParts) == 3 {
 			postHandler.Post(w, r)
 		} else if len(urlParts) == 4 {
 			if urlParts[3] == "likes" && r.Method == "POST" {
 				likeHandler.PostLikePost(w, r)
+			} else if urlParts[3] == "likes" && r.Method == "GET" {
+				likeHandler.GetPostLikes(w, r)
+			} else if urlParts[3] == "likes" && r.Method == "DELETE" {
+				likeHandler.UnlikePost(w, r)
+			} else if urlParts[3] == "reactions" {
+				likeHandler.PostReactions(w, r)
 			} else if urlParts[3] == "comments" {
 				commentHandler.Comments(w, r)
+			} else if urlParts[3] == "media" {
+				postHandler.PostVisualMedias(w, r)
+			} else if urlParts[3] == "tags" {
+				postHandler.PostUserTags(w, r)
+			} else if urlParts[3] == "status" {
+				postHandler.PostStatus(w, r)
+			} else if urlParts[3] == "archive" {
+				postHandler.PostArchive(w, r)
+			} else if urlParts[3] == "restore" {
+				postHandler.PostRestore(w, r)
+			} else if urlParts[3] == "saves" {
+				saveHandler.PostSaves(w, r)
+			} else if urlParts[3] == "pin" {
+				postHandler.PostPin(w, r)
+			} else if urlParts[3] == "settings" {
+				postHandler.PostSettings(w, r)
 			}
 		} else if len(urlParts) == 5 {
 			if urlParts[3] == "likes" && r.Method == "DELETE" {
@@ -77,12 +206,67 @@ func main() {
 			} else if urlParts[3] == "comments" {
 				commentHandler.Comment(w, r)
 			}
+		} else if len(urlParts) == 6 && urlParts[3] == "comments" && urlParts[5] == "restore" {
+			commentHandler.CommentRestore(w, r)
+		} else if len(urlParts) == 6 && urlParts[3] == "comments" && urlParts[5] == "replies" {
+			commentHandler.CommentReplies(w, r)
+		} else if len(urlParts) == 6 && urlParts[3] == "comments" && urlParts[5] == "revisions" {
+			commentHandler.CommentRevisions(w, r)
+		} else if len(urlParts) == 6 && urlParts[3] == "comments" && urlParts[5] == "pin" {
+			commentHandler.CommentPin(w, r)
+		} else if len(urlParts) == 6 && urlParts[3] == "comments" && urlParts[5] == "hide" {
+			commentHandler.CommentHide(w, r)
+		} else if len(urlParts) == 6 && urlParts[3] == "comments" && urlParts[5] == "reactions" {
+			likeHandler.CommentReactions(w, r)
+		} else if len(urlParts) == 6 && r.Method == "GET" && urlParts[3] == "comments" && urlParts[5] == "likes" {
+			likeHandler.GetCommentLikes(w, r)
+		} else if len(urlParts) == 6 && r.Method == "DELETE" && urlParts[3] == "comments" && urlParts[5] == "likes" {
+			likeHandler.UnlikeComment(w, r)
 		} else if len(urlParts) == 6 && r.Method == "POST" && urlParts[3] == "comments" {
 			likeHandler.PostCommentLike(w, r)
 		} else if len(urlParts) == 7 && r.Method == "DELETE" {
 			likeHandler.DeleteCommentLike(w, r)
 		}
 	})
+	mux.HandleFunc("/hashtags/", func(w http.ResponseWriter, r *http.Request) {
+		urlParts := strings.Split(r.URL.Path, "/")
+		if len(urlParts) == 3 {
+			hashtagHandler.Hashtag(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "posts" {
+			postHandler.HashtagPosts(w, r)
+		}
+	})
+	mux.HandleFunc("/locations/", func(w http.ResponseWriter, r *http.Request) {
+		urlParts := strings.Split(r.URL.Path, "/")
+		if len(urlParts) == 4 && urlParts[3] == "posts" {
+			postHandler.LocationPosts(w, r)
+		}
+	})
+
+	mux.HandleFunc("/saves", postHandler.SavedPosts)
+	mux.HandleFunc("/collections", saveHandler.Collections)
+	mux.HandleFunc("/collections/", func(w http.ResponseWriter, r *http.Request) {
+		urlParts := strings.Split(r.URL.Path, "/")
+		if len(urlParts) == 3 {
+			saveHandler.Collection(w, r)
+		} else if len(urlParts) == 4 && urlParts[3] == "posts" {
+			postHandler.CollectionPosts(w, r)
+		}
+	})
+	mux.HandleFunc("/stories", storyHandler.Stories)
+	mux.HandleFunc("/stories/", func(w http.ResponseWriter, r *http.Request) {
+		urlParts := strings.Split(r.URL.Path, "/")
+		if len(urlParts) == 4 && urlParts[3] == "views" {
+			storyHandler.StoryViews(w, r)
+		}
+	})
+	mux.HandleFunc("/highlights", highlightHandler.Highlights)
+	mux.HandleFunc("/highlights/", func(w http.ResponseWriter, r *http.Request) {
+		urlParts := strings.Split(r.URL.Path, "/")
+		if len(urlParts) == 3 {
+			highlightHandler.Highlight(w, r)
+		}
+	})
 
 	wrappedMux := middlewares.NewAuthenticateMiddleware(mux)
 	err := http.ListenAndServe(":8000", wrappedMux)
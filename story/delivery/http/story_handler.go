@@ -0,0 +1,189 @@
+package http
+
+import (
+	"encoding/json"
+	"instagram-go/domain"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+type StoryHandler struct {
+	storyUsecase domain.StoryUsecase
+}
+
+func NewStoryHandler(storyUsecase domain.StoryUsecase) domain.StoryHandler {
+	return &StoryHandler{
+		storyUsecase: storyUsecase,
+	}
+}
+
+func (sh *StoryHandler) Stories(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		sh.getStoryTrays(w, r)
+		return
+	case "POST":
+		sh.postStory(w, r)
+		return
+	}
+}
+
+func (sh *StoryHandler) UserStories(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		sh.getUserStories(w, r)
+		return
+	}
+}
+
+func (sh *StoryHandler) StoryViews(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		sh.postStoryView(w, r)
+		return
+	}
+}
+
+func (sh *StoryHandler) postStory(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+
+	r.ParseMultipartForm(10 << 20)
+	var visualMedias []*multipart.FileHeader
+	if r.MultipartForm != nil {
+		visualMedias = r.MultipartForm.File["visual_media"]
+	}
+	if len(visualMedias) != 1 || !domain.IsSupportedVisualMedia(visualMedias[0]) {
+		response := domain.NewMessage(domain.ErrMissingStoryVisualMediaInput.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(storyGetStatusCode(domain.ErrMissingStoryVisualMediaInput))
+		w.Write(responseBytes)
+		return
+	}
+
+	err := sh.storyUsecase.InsertStory(r.Context(), visualMedias[0], tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(storyGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Story successfully Created")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	w.Write(responseBytes)
+}
+
+func (sh *StoryHandler) getStoryTrays(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.Header.Get("Authorization")
+	trays, err := sh.storyUsecase.FindStoryTrays(r.Context(), tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(storyGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataStoryTrays := domain.NewDataStoryTrays(*trays)
+	response := domain.NewDataResponseStoryTrays(*dataStoryTrays)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (sh *StoryHandler) getUserStories(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	stories, err := sh.storyUsecase.FindUserStories(r.Context(), userId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(storyGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	dataStories := domain.NewDataStories(*stories)
+	response := domain.NewDataResponseStories(*dataStories)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (sh *StoryHandler) postStoryView(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.String(), "/")
+	storyId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err := sh.storyUsecase.ViewStory(r.Context(), storyId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(storyGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+	response := domain.NewMessage("Story successfully Viewed")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func storyGetStatusCode(err error) int {
+	switch err {
+	case domain.ErrMissingStoryVisualMediaInput:
+		return http.StatusBadRequest
+	case domain.ErrInternalServerError:
+		return http.StatusInternalServerError
+	case domain.ErrUserNotFound, domain.ErrStoryNotFound:
+		return http.StatusNotFound
+	}
+	return http.StatusOK
+}
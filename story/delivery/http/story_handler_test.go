@@ -0,0 +1,107 @@
+package http_test
+
+import (
+	"bytes"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	storyHttp "instagram-go/story/delivery/http"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestStoryHandlerSuite(t *testing.T) {
+	suite.Run(t, new(StoryHandlerSuite))
+}
+
+type StoryHandlerSuite struct {
+	suite.Suite
+	storyUsecase *mocks.StoryUsecase
+}
+
+func (sh *StoryHandlerSuite) SetupTest() {
+	sh.storyUsecase = new(mocks.StoryUsecase)
+}
+
+func (sh *StoryHandlerSuite) TestPostStoryMultipleVisualMediasProvided() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_media", "jpg1.jpg")
+	fw.Write([]byte("jpg1"))
+	fw, _ = writer.CreateFormFile("visual_media", "jpg2.jpg")
+	fw.Write([]byte("jpg2"))
+	writer.Close()
+	storyHandler := storyHttp.NewStoryHandler(sh.storyUsecase)
+	req, _ := http.NewRequest("POST", "/stories", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(storyHandler.Stories)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrMissingStoryVisualMediaInput.Error() + `"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *StoryHandlerSuite) TestPostStorySuccessful() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_media", "mp4.mp4")
+	fw.Write([]byte("mp4"))
+	writer.Close()
+	sh.storyUsecase.On("InsertStory", mock.Anything, mock.AnythingOfType("*multipart.FileHeader"), mock.AnythingOfType("string")).Return(nil)
+	storyHandler := storyHttp.NewStoryHandler(sh.storyUsecase)
+	req, _ := http.NewRequest("POST", "/stories", bytes.NewReader(body.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(storyHandler.Stories)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusCreated, rr.Code, "Should have responded with http status code %v but got %v", http.StatusCreated, rr.Code)
+	expectedBody := `{"message":"Story successfully Created"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *StoryHandlerSuite) TestGetStoryTraysSuccessful() {
+	sh.storyUsecase.On("FindStoryTrays", mock.Anything, mock.AnythingOfType("string")).Return(&[]domain.StoryTray{}, nil)
+	storyHandler := storyHttp.NewStoryHandler(sh.storyUsecase)
+	req, _ := http.NewRequest("GET", "/stories", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(storyHandler.Stories)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"trays":[]}}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *StoryHandlerSuite) TestGetUserStoriesUserNotFound() {
+	sh.storyUsecase.On("FindUserStories", mock.Anything, "userid2", mock.AnythingOfType("string")).Return(nil, domain.ErrUserNotFound)
+	storyHandler := storyHttp.NewStoryHandler(sh.storyUsecase)
+	req, _ := http.NewRequest("GET", "/users/userid2/stories", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(storyHandler.UserStories)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusNotFound, rr.Code, "Should have responded with http status code %v but got %v", http.StatusNotFound, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUserNotFound.Error() + `"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (sh *StoryHandlerSuite) TestPostStoryViewSuccessful() {
+	sh.storyUsecase.On("ViewStory", mock.Anything, "storyid1", mock.AnythingOfType("string")).Return(nil)
+	storyHandler := storyHttp.NewStoryHandler(sh.storyUsecase)
+	req, _ := http.NewRequest("POST", "/stories/storyid1/views", nil)
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(storyHandler.StoryViews)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(sh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Story successfully Viewed"}`
+	assert.Equalf(sh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
@@ -0,0 +1,90 @@
+package mongodb
+
+import (
+	"context"
+	"instagram-go/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodbStoryRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongodbStoryRepository(collection *mongo.Collection) domain.StoryRepository {
+	return &mongodbStoryRepository{
+		collection: collection,
+	}
+}
+
+func (msr *mongodbStoryRepository) CreateIndexes(ctx context.Context) error {
+	userIndex := mongo.IndexModel{
+		Keys: bson.D{
+			primitive.E{Key: "user_id", Value: 1},
+			primitive.E{Key: "expires_at", Value: 1},
+		},
+	}
+	expiryIndex := mongo.IndexModel{
+		Keys:    bson.D{primitive.E{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(domain.StoryExpiryGrace.Seconds())),
+	}
+	_, err := msr.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{userIndex, expiryIndex})
+	return err
+}
+
+func (msr *mongodbStoryRepository) InsertStory(ctx context.Context, story *domain.Story) error {
+	newStory := bson.D{
+		primitive.E{Key: "_id", Value: story.Id},
+		primitive.E{Key: "user_id", Value: story.UserId},
+		primitive.E{Key: "visual_media", Value: story.VisualMedia},
+		primitive.E{Key: "viewers", Value: bson.A{}},
+		primitive.E{Key: "created_date", Value: story.CreatedDate},
+		primitive.E{Key: "expires_at", Value: story.ExpiresAt},
+	}
+	_, err := msr.collection.InsertOne(ctx, newStory)
+	return err
+}
+
+func (msr *mongodbStoryRepository) FindStories(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "created_date", Value: 1}})
+	cursor, err := msr.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	var queryResult []bson.M
+	if err = cursor.All(ctx, &queryResult); err != nil {
+		return nil, err
+	}
+	return &queryResult, nil
+}
+
+func (msr *mongodbStoryRepository) FindOneStory(ctx context.Context, storyId string) (*domain.Story, error) {
+	var story domain.Story
+	filter := bson.M{"_id": storyId}
+	err := msr.collection.FindOne(ctx, filter).Decode(&story)
+	return &story, err
+}
+
+// Later views by the same user are ignored.
+func (msr *mongodbStoryRepository) InsertStoryViewer(ctx context.Context, storyId string, viewer *domain.StoryViewer) error {
+	filter := bson.M{"_id": storyId, "viewers.user_id": bson.M{"$ne": viewer.UserId}}
+	update := bson.D{primitive.E{
+		Key: "$push",
+		Value: bson.D{primitive.E{
+			Key:   "viewers",
+			Value: viewer,
+		},
+		},
+	}}
+	_, err := msr.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (msr *mongodbStoryRepository) DeleteStory(ctx context.Context, storyId string) error {
+	filter := bson.M{"_id": storyId}
+	_, err := msr.collection.DeleteOne(ctx, filter)
+	return err
+}
@@ -0,0 +1,57 @@
+package mongodb_test
+
+import (
+	"context"
+	"instagram-go/domain"
+	"instagram-go/story/repository/mongodb"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestStoryRepoSuite(t *testing.T) {
+	suite.Run(t, new(StoryRepoSuite))
+}
+
+type StoryRepoSuite struct {
+	suite.Suite
+	collection *mongo.Collection
+}
+
+func (sr *StoryRepoSuite) SetupSuite() {
+	client, _ := mongo.Connect(context.TODO(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	sr.collection = client.Database("instagram_test").Collection("stories")
+}
+
+func (sr *StoryRepoSuite) AfterTest(suiteName, testName string) {
+	sr.collection.Drop(context.TODO())
+}
+
+func (sr *StoryRepoSuite) TestInsertStoryViewerIgnoresRepeatedViews() {
+	storyRepo := mongodb.NewMongodbStoryRepository(sr.collection)
+	now := time.Now()
+	_ = storyRepo.InsertStory(context.TODO(), domain.NewStory("storyid1", "userid1", domain.VisualMedia{}, now, now.Add(domain.StoryLifetime)))
+
+	_ = storyRepo.InsertStoryViewer(context.TODO(), "storyid1", domain.NewStoryViewer("userid2", now))
+	err := storyRepo.InsertStoryViewer(context.TODO(), "storyid1", domain.NewStoryViewer("userid2", now.Add(time.Minute)))
+	story, _ := storyRepo.FindOneStory(context.TODO(), "storyid1")
+
+	assert.NoErrorf(sr.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(sr.T(), 1, len(story.Viewers), "Should have return %d viewers but got %d", 1, len(story.Viewers))
+}
+
+func (sr *StoryRepoSuite) TestDeleteStorySuccessful() {
+	storyRepo := mongodb.NewMongodbStoryRepository(sr.collection)
+	now := time.Now()
+	_ = storyRepo.InsertStory(context.TODO(), domain.NewStory("storyid1", "userid1", domain.VisualMedia{}, now, now.Add(domain.StoryLifetime)))
+
+	err := storyRepo.DeleteStory(context.TODO(), "storyid1")
+	_, findErr := storyRepo.FindOneStory(context.TODO(), "storyid1")
+
+	assert.NoErrorf(sr.T(), err, "Should have not return error but got %s", err)
+	assert.EqualErrorf(sr.T(), findErr, mongo.ErrNoDocuments.Error(), "Should have return %s but got %s", mongo.ErrNoDocuments, findErr)
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"instagram-go/domain"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type storyUsecase struct {
+	storyRepository domain.StoryRepository
+	userRepository  domain.UserRepository
+	headerHelper    domain.IHeaderHelper
+	fileOsHelper    domain.IFileOsHelper
+}
+
+func NewStoryUsecase(storyRepository domain.StoryRepository, userRepository domain.UserRepository, headerHelper domain.IHeaderHelper, fileOsHelper domain.IFileOsHelper) domain.StoryUsecase {
+	return &storyUsecase{
+		storyRepository: storyRepository,
+		userRepository:  userRepository,
+		headerHelper:    headerHelper,
+		fileOsHelper:    fileOsHelper,
+	}
+}
+
+func (su *storyUsecase) InsertStory(ctx context.Context, visualMedia *multipart.FileHeader, tokenString string) error {
+	userId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	storyId := "story-" + uuid.NewString()
+	err = su.fileOsHelper.MkDirAll(filepath.Join(".", "stories"), os.ModePerm)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	fileNameParts := strings.Split(visualMedia.Filename, ".")
+	extension := fileNameParts[len(fileNameParts)-1]
+	savedVisualMedia, err := domain.SaveVisualMedia(su.fileOsHelper, visualMedia, "./stories/"+storyId+"."+extension, "")
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+
+	now := time.Now()
+	story := domain.NewStory(storyId, userId, *savedVisualMedia, now, now.Add(domain.StoryLifetime))
+	err = su.storyRepository.InsertStory(ctx, story)
+	if err != nil {
+		domain.RemoveVisualMediaFiles(su.fileOsHelper, []domain.VisualMedia{*savedVisualMedia})
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (su *storyUsecase) FindStoryTrays(ctx context.Context, tokenString string) (*[]domain.StoryTray, error) {
+	viewerId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"expires_at": bson.M{"$gt": time.Now()}}
+	queryResult, err := su.storyRepository.FindStories(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	stories, err := decodeStories(queryResult, viewerId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+
+	var trays []domain.StoryTray
+	trayIndexes := make(map[string]int)
+	for _, story := range stories {
+		index, ok := trayIndexes[story.UserId]
+		if !ok {
+			index = len(trays)
+			trayIndexes[story.UserId] = index
+			trays = append(trays, *domain.NewStoryTray(story.UserId, nil))
+		}
+		trays[index].Stories = append(trays[index].Stories, story)
+	}
+	sort.SliceStable(trays, func(i, j int) bool {
+		if trays[i].UserId == viewerId || trays[j].UserId == viewerId {
+			return trays[i].UserId == viewerId
+		}
+		latestI := trays[i].Stories[len(trays[i].Stories)-1].CreatedDate
+		latestJ := trays[j].Stories[len(trays[j].Stories)-1].CreatedDate
+		return latestI.After(latestJ)
+	})
+	return &trays, nil
+}
+
+func (su *storyUsecase) FindUserStories(ctx context.Context, userId string, tokenString string) (*[]domain.Story, error) {
+	viewerId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": userId}
+	queryResult, err := su.userRepository.FindUser(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrUserNotFound
+	}
+
+	filter = bson.M{"user_id": userId, "expires_at": bson.M{"$gt": time.Now()}}
+	queryResult, err = su.storyRepository.FindStories(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	stories, err := decodeStories(queryResult, viewerId)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return &stories, nil
+}
+
+// Authors viewing their own stories are not recorded.
+func (su *storyUsecase) ViewStory(ctx context.Context, storyId string, tokenString string) error {
+	viewerId, err := su.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": storyId, "expires_at": bson.M{"$gt": time.Now()}}
+	queryResult, err := su.storyRepository.FindStories(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return domain.ErrStoryNotFound
+	}
+	story, err := su.storyRepository.FindOneStory(ctx, storyId)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	if story.UserId == viewerId {
+		return nil
+	}
+
+	err = su.storyRepository.InsertStoryViewer(ctx, storyId, domain.NewStoryViewer(viewerId, time.Now()))
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (su *storyUsecase) PurgeExpiredStories(ctx context.Context) error {
+	filter := bson.M{"expires_at": bson.M{"$lte": time.Now()}}
+	queryResult, err := su.storyRepository.FindStories(ctx, filter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	stories, err := decodeStories(queryResult, "")
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	for _, story := range stories {
+		err = su.storyRepository.DeleteStory(ctx, story.Id)
+		if err != nil {
+			return domain.ErrInternalServerError
+		}
+		domain.RemoveVisualMediaFiles(su.fileOsHelper, []domain.VisualMedia{story.VisualMedia})
+	}
+	return nil
+}
+
+func decodeStories(queryResult *[]bson.M, viewerId string) ([]domain.Story, error) {
+	var stories []domain.Story
+	for _, v := range *queryResult {
+		storyBytes, err := bson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var story domain.Story
+		if err = bson.Unmarshal(storyBytes, &story); err != nil {
+			return nil, err
+		}
+		if story.UserId != viewerId {
+			story.Viewers = nil
+		}
+		stories = append(stories, story)
+	}
+	return stories, nil
+}
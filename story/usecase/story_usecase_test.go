@@ -0,0 +1,214 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"instagram-go/domain"
+	"instagram-go/domain/mocks"
+	"instagram-go/story/usecase"
+	"io"
+	"mime/multipart"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestStoryUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(StoryUsecaseSuite))
+}
+
+type StoryUsecaseSuite struct {
+	suite.Suite
+	mockStoryRepository *mocks.StoryRepository
+	mockUserRepository  *mocks.UserRepository
+	mockHeaderHelper    *mocks.IHeaderHelper
+	mockFileOsHelper    *mocks.IFileOsHelper
+}
+
+func (su *StoryUsecaseSuite) SetupTest() {
+	su.mockStoryRepository = new(mocks.StoryRepository)
+	su.mockUserRepository = new(mocks.UserRepository)
+	su.mockHeaderHelper = new(mocks.IHeaderHelper)
+	su.mockFileOsHelper = new(mocks.IFileOsHelper)
+}
+
+func newStoryDocument(id string, userId string, createdDate time.Time, viewers primitive.A) bson.M {
+	return bson.M{
+		"_id":          id,
+		"user_id":      userId,
+		"visual_media": bson.M{"type": "image", "mime_type": "image/jpeg", "variants": primitive.A{bson.M{"type": "original", "url": "./stories/" + id + ".jpg"}}},
+		"viewers":      viewers,
+		"created_date": primitive.NewDateTimeFromTime(createdDate),
+		"expires_at":   primitive.NewDateTimeFromTime(createdDate.Add(domain.StoryLifetime)),
+	}
+}
+
+func (su *StoryUsecaseSuite) TestInsertStorySuccessful() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_media", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	writer.Close()
+	form, _ := multipart.NewReader(body, writer.Boundary()).ReadForm(10 << 20)
+	out, _ := os.CreateTemp("", "story")
+	defer os.Remove(out.Name())
+
+	su.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	su.mockFileOsHelper.On("DecodeImageConfig", mock.Anything).Return(image.Config{Width: 1080, Height: 1920}, "jpeg", nil)
+	su.mockFileOsHelper.On("Create", mock.AnythingOfType("string")).Return(out, nil)
+	su.mockFileOsHelper.On("Copy", mock.Anything, mock.Anything).Return(int64(0), nil)
+	su.mockStoryRepository.On("InsertStory", mock.Anything, mock.AnythingOfType("*domain.Story")).Return(nil)
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	err := storyUsecase.InsertStory(context.TODO(), form.File["visual_media"][0], "accessToken")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	su.mockStoryRepository.AssertCalled(su.T(), "InsertStory", mock.Anything, mock.MatchedBy(func(story *domain.Story) bool {
+		return story.UserId == "userid1" && story.VisualMedia.Width == 1080 &&
+			story.ExpiresAt.Sub(story.CreatedDate) == domain.StoryLifetime
+	}))
+}
+
+func (su *StoryUsecaseSuite) TestInsertStoryInsertStoryErrorRemovesFile() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, _ := writer.CreateFormFile("visual_media", "jpg.jpg")
+	file, _ := os.Open("./test_visual_medias/jpg.jpg")
+	_, _ = io.Copy(fw, file)
+	writer.Close()
+	form, _ := multipart.NewReader(body, writer.Boundary()).ReadForm(10 << 20)
+	out, _ := os.CreateTemp("", "story")
+	defer os.Remove(out.Name())
+
+	su.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
+	su.mockFileOsHelper.On("DecodeImageConfig", mock.Anything).Return(image.Config{}, "", errors.New("DecodeImageConfig return error"))
+	su.mockFileOsHelper.On("Create", mock.AnythingOfType("string")).Return(out, nil)
+	su.mockFileOsHelper.On("Copy", mock.Anything, mock.Anything).Return(int64(0), nil)
+	su.mockFileOsHelper.On("Remove", mock.AnythingOfType("string")).Return(nil)
+	su.mockStoryRepository.On("InsertStory", mock.Anything, mock.AnythingOfType("*domain.Story")).Return(errors.New("InsertStory return error"))
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	err := storyUsecase.InsertStory(context.TODO(), form.File["visual_media"][0], "accessToken")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+	su.mockFileOsHelper.AssertNumberOfCalls(su.T(), "Remove", 1)
+}
+
+func (su *StoryUsecaseSuite) TestFindStoryTraysGroupedByAuthor() {
+	now := time.Now()
+	su.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.mockStoryRepository.On("FindStories", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		newStoryDocument("storyid1", "userid2", now.Add(-3*time.Hour), primitive.A{bson.M{"user_id": "userid1", "viewed_date": primitive.NewDateTimeFromTime(now)}}),
+		newStoryDocument("storyid2", "userid1", now.Add(-2*time.Hour), primitive.A{bson.M{"user_id": "userid2", "viewed_date": primitive.NewDateTimeFromTime(now)}}),
+		newStoryDocument("storyid3", "userid3", now.Add(-time.Hour), primitive.A{}),
+		newStoryDocument("storyid4", "userid2", now.Add(-time.Minute), primitive.A{}),
+	}, nil)
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	trays, err := storyUsecase.FindStoryTrays(context.TODO(), "accessToken")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	var trayUserIds []string
+	for _, tray := range *trays {
+		trayUserIds = append(trayUserIds, tray.UserId)
+	}
+	expectedUserIds := []string{"userid1", "userid2", "userid3"}
+	assert.Equalf(su.T(), expectedUserIds, trayUserIds, "Should have return trays of %v but got %v", expectedUserIds, trayUserIds)
+	assert.Equalf(su.T(), 2, len((*trays)[1].Stories), "Should have grouped %d stories but got %d", 2, len((*trays)[1].Stories))
+	assert.Equalf(su.T(), 1, len((*trays)[0].Stories[0].Viewers), "Should have listed the viewers of the own story of the caller")
+	assert.Nilf(su.T(), (*trays)[1].Stories[0].Viewers, "Should have hidden the viewers of the story of another user")
+}
+
+func (su *StoryUsecaseSuite) TestFindUserStoriesUserNotFound() {
+	su.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": "userid2"}).Return(&[]bson.M{}, nil)
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	_, err := storyUsecase.FindUserStories(context.TODO(), "userid2", "accessToken")
+
+	expectedError := domain.ErrUserNotFound.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (su *StoryUsecaseSuite) TestFindUserStoriesSuccessful() {
+	now := time.Now()
+	su.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	su.mockUserRepository.On("FindUser", mock.Anything, bson.M{"_id": "userid2"}).Return(&[]bson.M{{"_id": "userid2"}}, nil)
+	su.mockStoryRepository.On("FindStories", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		newStoryDocument("storyid1", "userid2", now, primitive.A{bson.M{"user_id": "userid1", "viewed_date": primitive.NewDateTimeFromTime(now)}}),
+	}, nil)
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	stories, err := storyUsecase.FindUserStories(context.TODO(), "userid2", "accessToken")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	assert.Equalf(su.T(), 1, len(*stories), "Should have return %d story but got %d", 1, len(*stories))
+	assert.Equalf(su.T(), 1, len((*stories)[0].Viewers), "Should have return %d viewer but got %d", 1, len((*stories)[0].Viewers))
+	assert.Equalf(su.T(), "userid1", (*stories)[0].Viewers[0].UserId, "Should have return viewer %s but got %s", "userid1", (*stories)[0].Viewers[0].UserId)
+}
+
+func (su *StoryUsecaseSuite) TestViewStoryStoryNotFound() {
+	su.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	su.mockStoryRepository.On("FindStories", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	err := storyUsecase.ViewStory(context.TODO(), "storyid1", "accessToken")
+
+	expectedError := domain.ErrStoryNotFound.Error()
+	assert.EqualErrorf(su.T(), err, expectedError, "Should have return error %s but got %s", expectedError, err)
+}
+
+func (su *StoryUsecaseSuite) TestViewStoryOwnStoryNotRecorded() {
+	su.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	su.mockStoryRepository.On("FindStories", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "storyid1"}}, nil)
+	su.mockStoryRepository.On("FindOneStory", mock.Anything, "storyid1").Return(domain.NewStory("storyid1", "userid1", domain.VisualMedia{}, time.Now(), time.Now().Add(domain.StoryLifetime)), nil)
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	err := storyUsecase.ViewStory(context.TODO(), "storyid1", "accessToken")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	su.mockStoryRepository.AssertNotCalled(su.T(), "InsertStoryViewer", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (su *StoryUsecaseSuite) TestViewStorySuccessful() {
+	su.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	su.mockStoryRepository.On("FindStories", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{"_id": "storyid1"}}, nil)
+	su.mockStoryRepository.On("FindOneStory", mock.Anything, "storyid1").Return(domain.NewStory("storyid1", "userid1", domain.VisualMedia{}, time.Now(), time.Now().Add(domain.StoryLifetime)), nil)
+	su.mockStoryRepository.On("InsertStoryViewer", mock.Anything, "storyid1", mock.AnythingOfType("*domain.StoryViewer")).Return(nil)
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	err := storyUsecase.ViewStory(context.TODO(), "storyid1", "accessToken")
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	su.mockStoryRepository.AssertCalled(su.T(), "InsertStoryViewer", mock.Anything, "storyid1", mock.MatchedBy(func(viewer *domain.StoryViewer) bool {
+		return viewer.UserId == "userid2"
+	}))
+}
+
+func (su *StoryUsecaseSuite) TestPurgeExpiredStoriesSuccessful() {
+	createdDate := time.Now().Add(-domain.StoryLifetime - time.Minute)
+	su.mockStoryRepository.On("FindStories", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
+		newStoryDocument("storyid1", "userid1", createdDate, primitive.A{}),
+	}, nil)
+	su.mockStoryRepository.On("DeleteStory", mock.Anything, "storyid1").Return(nil)
+	su.mockFileOsHelper.On("Remove", "./stories/storyid1.jpg").Return(nil)
+
+	storyUsecase := usecase.NewStoryUsecase(su.mockStoryRepository, su.mockUserRepository, su.mockHeaderHelper, su.mockFileOsHelper)
+	err := storyUsecase.PurgeExpiredStories(context.TODO())
+
+	assert.NoErrorf(su.T(), err, "Should have not return error but got %s", err)
+	su.mockStoryRepository.AssertCalled(su.T(), "DeleteStory", mock.Anything, "storyid1")
+	su.mockFileOsHelper.AssertCalled(su.T(), "Remove", "./stories/storyid1.jpg")
+}
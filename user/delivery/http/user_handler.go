@@ -98,7 +98,7 @@ func (uh *UserHandler) PostUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = uh.userUsecase.InsertUser(&user)
+	err = uh.userUsecase.InsertUser(r.Context(), &user)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -180,7 +180,7 @@ func (uh *UserHandler) PutUser(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err := uh.userUsecase.UpdateUser(&updatedUser, tokenString, profilePictureFile)
+	err := uh.userUsecase.UpdateUser(r.Context(), &updatedUser, tokenString, profilePictureFile)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -258,7 +258,7 @@ func (uh *UserHandler) AuthenticateUser(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	accessToken, err := uh.userUsecase.VerifyCredential(user.Username, user.Password)
+	accessToken, err := uh.userUsecase.VerifyCredential(r.Context(), user.Username, user.Password)
 	if err != nil {
 		response := domain.NewMessage(err.Error())
 		responseBytes, errMarshal := json.Marshal(response)
@@ -283,17 +283,119 @@ func (uh *UserHandler) AuthenticateUser(w http.ResponseWriter, r *http.Request)
 	w.Write(responseBytes)
 }
 
+func (uh *UserHandler) UserCommentFilter(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		uh.getCommentFilter(w, r)
+		return
+	case "PUT":
+		uh.putCommentFilter(w, r)
+		return
+	}
+}
+
+func (uh *UserHandler) getCommentFilter(w http.ResponseWriter, r *http.Request) {
+	urlParts := strings.Split(r.URL.Path, "/")
+	userId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	commentFilter, err := uh.userUsecase.FindCommentFilter(r.Context(), userId, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(userGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	data := domain.NewDataCommentFilter(commentFilter)
+	response := domain.NewDataResponseCommentFilter(data)
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
+func (uh *UserHandler) putCommentFilter(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInternalServerError.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(userGetStatusCode(domain.ErrInternalServerError))
+		w.Write(responseBytes)
+		return
+	}
+	var commentFilter domain.CommentFilter
+	err = json.Unmarshal(bodyBytes, &commentFilter)
+	if err != nil {
+		response := domain.NewMessage(domain.ErrInvalidCommentFilter.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(userGetStatusCode(domain.ErrInvalidCommentFilter))
+		w.Write(responseBytes)
+		return
+	}
+
+	urlParts := strings.Split(r.URL.String(), "/")
+	userId := urlParts[2]
+	tokenString := r.Header.Get("Authorization")
+
+	err = uh.userUsecase.UpdateCommentFilter(r.Context(), userId, &commentFilter, tokenString)
+	if err != nil {
+		response := domain.NewMessage(err.Error())
+		responseBytes, errMarshal := json.Marshal(response)
+		if errMarshal != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(errMarshal.Error()))
+			return
+		}
+		w.WriteHeader(userGetStatusCode(err))
+		w.Write(responseBytes)
+		return
+	}
+
+	response := domain.NewMessage("Comment filter successfully Updated")
+	responseBytes, errMarshal := json.Marshal(response)
+	if errMarshal != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(errMarshal.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBytes)
+}
+
 func userGetStatusCode(err error) int {
 	switch err {
 	case domain.ErrInternalServerError:
 		return http.StatusInternalServerError
-	case domain.ErrMissingEmailInput, domain.ErrMissingFullNameInput, domain.ErrMissingUsernameInput, domain.ErrMissingPasswordInput, domain.ErrInvalidProfilePicture:
+	case domain.ErrMissingEmailInput, domain.ErrMissingFullNameInput, domain.ErrMissingUsernameInput, domain.ErrMissingPasswordInput, domain.ErrInvalidProfilePicture,
+		domain.ErrInvalidCommentFilter:
 		return http.StatusBadRequest
 	case domain.ErrUsernameConflict:
 		return http.StatusConflict
 	case domain.ErrUserNotFound:
 		return http.StatusNotFound
-	case domain.ErrUnauthorizedUserUpdate:
+	case domain.ErrUnauthorizedUserUpdate, domain.ErrUnauthorizedCommentFilter:
 		return http.StatusUnauthorized
 	case domain.ErrPasswordWrong:
 		return http.StatusForbidden
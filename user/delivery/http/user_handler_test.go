@@ -112,7 +112,7 @@ func (uh *UserHandlerSuite) TestPostUserInsertUserError() {
 		"username": "jordyf15",
 		"password": "jordyjordy",
 	})
-	uh.userUsecase.On("InsertUser", mock.AnythingOfType("*domain.User")).Return(domain.ErrInternalServerError)
+	uh.userUsecase.On("InsertUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(domain.ErrInternalServerError)
 	req, _ := http.NewRequest("POST", "/users", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
 	userHandler := userHttp.NewUserHandler(uh.userUsecase)
@@ -131,7 +131,7 @@ func (uh *UserHandlerSuite) TestPostUserSuccessful() {
 		"username": "jordyf15",
 		"password": "jordyjordy",
 	})
-	uh.userUsecase.On("InsertUser", mock.AnythingOfType("*domain.User")).Return(nil)
+	uh.userUsecase.On("InsertUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil)
 	req, _ := http.NewRequest("POST", "/users", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
 	userHandler := userHttp.NewUserHandler(uh.userUsecase)
@@ -182,7 +182,7 @@ func (uh *UserHandlerSuite) TestUpdateUserError() {
 	fw, _ = writer.CreateFormField("email")
 	_, _ = io.Copy(fw, strings.NewReader("jordyjordy@gmail.com"))
 	writer.Close()
-	uh.userUsecase.On("UpdateUser", mock.AnythingOfType("*domain.User"), mock.AnythingOfType("string"), mock.Anything).Return(domain.ErrInternalServerError)
+	uh.userUsecase.On("UpdateUser", mock.Anything, mock.AnythingOfType("*domain.User"), mock.AnythingOfType("string"), mock.Anything).Return(domain.ErrInternalServerError)
 	req, _ := http.NewRequest("PUT", "/users/userid1", bytes.NewReader(body.Bytes()))
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	rr := httptest.NewRecorder()
@@ -207,7 +207,7 @@ func (uh *UserHandlerSuite) TestPutUserSuccessful() {
 	fw, _ = writer.CreateFormField("email")
 	_, _ = io.Copy(fw, strings.NewReader("jordyjordy@gmail.com"))
 	writer.Close()
-	uh.userUsecase.On("UpdateUser", mock.AnythingOfType("*domain.User"), mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	uh.userUsecase.On("UpdateUser", mock.Anything, mock.AnythingOfType("*domain.User"), mock.AnythingOfType("string"), mock.Anything).Return(nil)
 	req, _ := http.NewRequest("PUT", "/users/userid1", bytes.NewReader(body.Bytes()))
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	rr := httptest.NewRecorder()
@@ -257,7 +257,7 @@ func (uh *UserHandlerSuite) TestAuthenticateUserVerifyCredentialError() {
 		"username": "jordyf15",
 		"password": "jordyjordy",
 	})
-	uh.userUsecase.On("VerifyCredential", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", domain.ErrInternalServerError)
+	uh.userUsecase.On("VerifyCredential", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", domain.ErrInternalServerError)
 	req, _ := http.NewRequest("POST", "/authentications", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
 	userHandler := userHttp.NewUserHandler(uh.userUsecase)
@@ -274,7 +274,7 @@ func (uh *UserHandlerSuite) TestAuthenticateUserSuccessful() {
 		"username": "jordyf15",
 		"password": "jordyjordy",
 	})
-	uh.userUsecase.On("VerifyCredential", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("token", nil)
+	uh.userUsecase.On("VerifyCredential", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("token", nil)
 	req, _ := http.NewRequest("POST", "/authentications", bytes.NewBuffer(requestBody))
 	rr := httptest.NewRecorder()
 	userHandler := userHttp.NewUserHandler(uh.userUsecase)
@@ -285,3 +285,55 @@ func (uh *UserHandlerSuite) TestAuthenticateUserSuccessful() {
 	expectedBody := `{"message":"User successfully authenticated","data":{"access_token":"token"}}`
 	assert.Equalf(uh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
 }
+
+func (uh *UserHandlerSuite) TestGetCommentFilterError() {
+	uh.userUsecase.On("FindCommentFilter", mock.Anything, "userid1", mock.AnythingOfType("string")).Return(nil, domain.ErrUnauthorizedCommentFilter)
+	req, _ := http.NewRequest("GET", "/users/userid1/comment-filter", nil)
+	rr := httptest.NewRecorder()
+	userHandler := userHttp.NewUserHandler(uh.userUsecase)
+	handler := http.HandlerFunc(userHandler.UserCommentFilter)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(uh.T(), http.StatusUnauthorized, rr.Code, "Should have responded with http status code %v but got %v", http.StatusUnauthorized, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrUnauthorizedCommentFilter.Error() + `"}`
+	assert.Equalf(uh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (uh *UserHandlerSuite) TestGetCommentFilterSuccessful() {
+	uh.userUsecase.On("FindCommentFilter", mock.Anything, "userid1", mock.AnythingOfType("string")).Return(domain.NewCommentFilter([]string{"spoiler"}, true, domain.CommentFilterActionHide), nil)
+	req, _ := http.NewRequest("GET", "/users/userid1/comment-filter", nil)
+	rr := httptest.NewRecorder()
+	userHandler := userHttp.NewUserHandler(uh.userUsecase)
+	handler := http.HandlerFunc(userHandler.UserCommentFilter)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(uh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"data":{"comment_filter":{"blocked_words":["spoiler"],"filter_offensive_terms":true,"action":"hide"}}}`
+	assert.Equalf(uh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (uh *UserHandlerSuite) TestPutCommentFilterInvalidBody() {
+	req, _ := http.NewRequest("PUT", "/users/userid1/comment-filter", strings.NewReader("blocked_words"))
+	rr := httptest.NewRecorder()
+	userHandler := userHttp.NewUserHandler(uh.userUsecase)
+	handler := http.HandlerFunc(userHandler.UserCommentFilter)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(uh.T(), http.StatusBadRequest, rr.Code, "Should have responded with http status code %v but got %v", http.StatusBadRequest, rr.Code)
+	expectedBody := `{"message":"` + domain.ErrInvalidCommentFilter.Error() + `"}`
+	assert.Equalf(uh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
+
+func (uh *UserHandlerSuite) TestPutCommentFilterSuccessful() {
+	requestBody := `{"blocked_words":["spoiler"],"filter_offensive_terms":false,"action":"reject"}`
+	uh.userUsecase.On("UpdateCommentFilter", mock.Anything, "userid1", domain.NewCommentFilter([]string{"spoiler"}, false, domain.CommentFilterActionReject), mock.AnythingOfType("string")).Return(nil)
+	req, _ := http.NewRequest("PUT", "/users/userid1/comment-filter", strings.NewReader(requestBody))
+	rr := httptest.NewRecorder()
+	userHandler := userHttp.NewUserHandler(uh.userUsecase)
+	handler := http.HandlerFunc(userHandler.UserCommentFilter)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equalf(uh.T(), http.StatusOK, rr.Code, "Should have responded with http status code %v but got %v", http.StatusOK, rr.Code)
+	expectedBody := `{"message":"Comment filter successfully Updated"}`
+	assert.Equalf(uh.T(), expectedBody, rr.Body.String(), "Should have responded with body %s but got %s", expectedBody, rr.Body.String())
+}
@@ -7,6 +7,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type mongodbUserRepository struct {
@@ -19,7 +20,16 @@ func NewMongodbUserRepository(collection *mongo.Collection) domain.UserRepositor
 	}
 }
 
-func (mur *mongodbUserRepository) InsertUser(user *domain.User) error {
+func (mur *mongodbUserRepository) CreateIndexes(ctx context.Context) error {
+	usernameIndex := mongo.IndexModel{
+		Keys:    bson.D{primitive.E{Key: "username", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err := mur.collection.Indexes().CreateOne(ctx, usernameIndex)
+	return err
+}
+
+func (mur *mongodbUserRepository) InsertUser(ctx context.Context, user *domain.User) error {
 	newUser := bson.D{
 		primitive.E{Key: "_id", Value: user.Id},
 		primitive.E{Key: "username", Value: user.Username},
@@ -27,15 +37,19 @@ func (mur *mongodbUserRepository) InsertUser(user *domain.User) error {
 		primitive.E{Key: "password", Value: user.Password},
 		primitive.E{Key: "email", Value: user.Email},
 		primitive.E{Key: "profile_pictures", Value: nil},
+		primitive.E{Key: "pinned_count", Value: 0},
+	}
+	_, err := mur.collection.InsertOne(ctx, newUser)
+	if mongo.IsDuplicateKeyError(err) {
+		return domain.ErrUsernameConflict
 	}
-	_, err := mur.collection.InsertOne(context.TODO(), newUser)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (mur *mongodbUserRepository) UpdateUser(newUserData *domain.User) error {
+func (mur *mongodbUserRepository) UpdateUser(ctx context.Context, newUserData *domain.User) error {
 	filter := bson.M{"_id": newUserData.Id}
 	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
 		primitive.E{Key: "username", Value: newUserData.Username},
@@ -46,27 +60,66 @@ func (mur *mongodbUserRepository) UpdateUser(newUserData *domain.User) error {
 	},
 	},
 	}
-	_, err := mur.collection.UpdateOne(context.TODO(), filter, update)
+	_, err := mur.collection.UpdateOne(ctx, filter, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return domain.ErrUsernameConflict
+	}
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (mur *mongodbUserRepository) FindUser(filter interface{}) (*[]bson.M, error) {
-	cursor, err := mur.collection.Find(context.TODO(), filter)
+func (mur *mongodbUserRepository) FindUser(ctx context.Context, filter interface{}) (*[]bson.M, error) {
+	cursor, err := mur.collection.Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 	var queryResult []bson.M
-	if err = cursor.All(context.TODO(), &queryResult); err != nil {
+	if err = cursor.All(ctx, &queryResult); err != nil {
 		return nil, err
 	}
 	return &queryResult, nil
 }
 
-func (mur *mongodbUserRepository) FindOneUser(filter interface{}) (*domain.User, error) {
+func (mur *mongodbUserRepository) FindOneUser(ctx context.Context, filter interface{}) (*domain.User, error) {
 	var user domain.User
-	err := mur.collection.FindOne(context.TODO(), filter).Decode(&user)
+	err := mur.collection.FindOne(ctx, filter).Decode(&user)
 	return &user, err
 }
+
+func (mur *mongodbUserRepository) UpdateUserCommentFilter(ctx context.Context, userId string, commentFilter *domain.CommentFilter) error {
+	filter := bson.M{"_id": userId}
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "comment_filter", Value: commentFilter},
+	},
+	},
+	}
+	_, err := mur.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (mur *mongodbUserRepository) IncrementUserPinnedCount(ctx context.Context, userId string, maxPinnedCount int) (bool, error) {
+	filter := bson.M{"_id": userId, "pinned_count": bson.M{"$not": bson.M{"$gte": maxPinnedCount}}}
+	update := bson.D{primitive.E{Key: "$inc", Value: bson.D{
+		primitive.E{Key: "pinned_count", Value: 1},
+	},
+	},
+	}
+	result, err := mur.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (mur *mongodbUserRepository) DecrementUserPinnedCount(ctx context.Context, userId string) error {
+	filter := bson.M{"_id": userId, "pinned_count": bson.M{"$gt": 0}}
+	update := bson.D{primitive.E{Key: "$inc", Value: bson.D{
+		primitive.E{Key: "pinned_count", Value: -1},
+	},
+	},
+	}
+	_, err := mur.collection.UpdateOne(ctx, filter, update)
+	return err
+}
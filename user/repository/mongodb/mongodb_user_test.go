@@ -35,16 +35,27 @@ func (ur *UserRepoSuite) TestInsertDuplicateUser() {
 	newUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
 
-	_ = userRepo.InsertUser(newUser)
-	err := userRepo.InsertUser(newUser)
+	_ = userRepo.InsertUser(context.TODO(), newUser)
+	err := userRepo.InsertUser(context.TODO(), newUser)
 
 	assert.Error(ur.T(), err, "Should have return error but didn't")
 }
 
+func (ur *UserRepoSuite) TestInsertDuplicateUsername() {
+	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
+	_ = userRepo.CreateIndexes(context.TODO())
+
+	_ = userRepo.InsertUser(context.TODO(), domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil))
+	err := userRepo.InsertUser(context.TODO(), domain.NewUser("userid2", "username1", "fullname2", "password2", "email2@gmail.com", nil))
+
+	expectedError := domain.ErrUsernameConflict.Error()
+	assert.EqualErrorf(ur.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
 func (ur *UserRepoSuite) TestInsertUserSuccessful() {
 	newUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
-	err := userRepo.InsertUser(newUser)
+	err := userRepo.InsertUser(context.TODO(), newUser)
 
 	var insertedUser domain.User
 	ur.collection.FindOne(context.TODO(), bson.M{"_id": "userid1"}).Decode(&insertedUser)
@@ -69,7 +80,7 @@ func (ur *UserRepoSuite) TestUpdateNotExistUser() {
 
 	newUserData := domain.NewUser("notExistUser", "newusername1", "newfullname1", "newpassword1", "email1@gmail.com", nil)
 	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
-	err := userRepo.UpdateUser(newUserData)
+	err := userRepo.UpdateUser(context.TODO(), newUserData)
 
 	var notUpdatedUser domain.User
 	ur.collection.FindOne(context.TODO(), bson.M{"_id": "userid1"}).Decode(&notUpdatedUser)
@@ -94,7 +105,7 @@ func (ur *UserRepoSuite) TestUpdateUserSuccessful() {
 
 	newUserData := domain.NewUser("userid1", "new username 1", "new fullname 1", "new password 1", "email1@gmail.com", nil)
 	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
-	err := userRepo.UpdateUser(newUserData)
+	err := userRepo.UpdateUser(context.TODO(), newUserData)
 
 	var updatedUser domain.User
 	ur.collection.FindOne(context.TODO(), bson.M{"_id": "userid1"}).Decode(&updatedUser)
@@ -119,7 +130,7 @@ func (ur *UserRepoSuite) TestFindNotExistUser() {
 
 	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
 	filter := bson.M{"_id": "nonExistUserId"}
-	queryResult, err := userRepo.FindUser(filter)
+	queryResult, err := userRepo.FindUser(context.TODO(), filter)
 
 	assert.Equalf(ur.T(), 0, len(*queryResult), "Should have return the correct amount of user: %v but got %v", 0, len(*queryResult))
 	assert.NoErrorf(ur.T(), err, "Should have not return error but got %s", err)
@@ -138,7 +149,7 @@ func (ur *UserRepoSuite) TestFindUserSuccessful() {
 
 	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
 	filter := bson.M{"_id": "userid1"}
-	queryResult, err := userRepo.FindUser(filter)
+	queryResult, err := userRepo.FindUser(context.TODO(), filter)
 
 	assert.Equalf(ur.T(), 1, len(*queryResult), "Should have return the correct amount of user: %v but got %v", 1, len(*queryResult))
 	assert.Equalf(ur.T(), "userid1", (*queryResult)[0]["_id"], "Should have received the right id of user: %s but got %s", "userid1", (*queryResult)[0]["_id"])
@@ -148,7 +159,7 @@ func (ur *UserRepoSuite) TestFindUserSuccessful() {
 func (ur *UserRepoSuite) TestFindOneNotExistUser() {
 	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
 	filter := bson.M{"_id": "notExistUserId"}
-	_, err := userRepo.FindOneUser(filter)
+	_, err := userRepo.FindOneUser(context.TODO(), filter)
 	assert.Error(ur.T(), err, "Should have return error but didn't")
 }
 
@@ -165,7 +176,7 @@ func (ur *UserRepoSuite) TestFindOneUserSuccessful() {
 
 	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
 	filter := bson.M{"_id": "userid1"}
-	foundUser, err := userRepo.FindOneUser(filter)
+	foundUser, err := userRepo.FindOneUser(context.TODO(), filter)
 	assert.Equalf(ur.T(), "userid1", foundUser.Id, "Should have return the correct user id: %s but got %s", "userid1", foundUser.Id)
 	assert.Equalf(ur.T(), "username1", foundUser.Username, "Should have return the correct user id: %s but got %s", "username1", foundUser.Username)
 	assert.Equalf(ur.T(), "fullname1", foundUser.Fullname, "Should have return the correct user id: %s but got %s", "fullname1", foundUser.Fullname)
@@ -173,3 +184,42 @@ func (ur *UserRepoSuite) TestFindOneUserSuccessful() {
 	assert.Equalf(ur.T(), "email1", foundUser.Email, "Should have return the correct user id: %s but got %s", "email1", foundUser.Email)
 	assert.NoErrorf(ur.T(), err, "Should have not return error but got %s", err)
 }
+
+func (ur *UserRepoSuite) TestIncrementUserPinnedCountLimitReached() {
+	_, _ = ur.collection.InsertOne(context.TODO(), bson.M{"_id": "userid1", "pinned_count": 3})
+
+	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
+	incremented, err := userRepo.IncrementUserPinnedCount(context.TODO(), "userid1", 3)
+
+	var user bson.M
+	ur.collection.FindOne(context.TODO(), bson.M{"_id": "userid1"}).Decode(&user)
+	assert.Falsef(ur.T(), incremented, "Should have not incremented the pinned count but did")
+	assert.EqualValuesf(ur.T(), 3, user["pinned_count"], "Should have kept the pinned count at %v but got %v", 3, user["pinned_count"])
+	assert.NoErrorf(ur.T(), err, "Should have not return error but got %s", err)
+}
+
+func (ur *UserRepoSuite) TestIncrementUserPinnedCountSuccessful() {
+	_, _ = ur.collection.InsertOne(context.TODO(), bson.M{"_id": "userid1"})
+
+	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
+	incremented, err := userRepo.IncrementUserPinnedCount(context.TODO(), "userid1", 3)
+
+	var user bson.M
+	ur.collection.FindOne(context.TODO(), bson.M{"_id": "userid1"}).Decode(&user)
+	assert.Truef(ur.T(), incremented, "Should have incremented the pinned count but didn't")
+	assert.EqualValuesf(ur.T(), 1, user["pinned_count"], "Should have set the pinned count to %v but got %v", 1, user["pinned_count"])
+	assert.NoErrorf(ur.T(), err, "Should have not return error but got %s", err)
+}
+
+func (ur *UserRepoSuite) TestDecrementUserPinnedCountSuccessful() {
+	_, _ = ur.collection.InsertOne(context.TODO(), bson.M{"_id": "userid1", "pinned_count": 1})
+
+	userRepo := mongodb.NewMongodbUserRepository(ur.collection)
+	err := userRepo.DecrementUserPinnedCount(context.TODO(), "userid1")
+	_ = userRepo.DecrementUserPinnedCount(context.TODO(), "userid1")
+
+	var user bson.M
+	ur.collection.FindOne(context.TODO(), bson.M{"_id": "userid1"}).Decode(&user)
+	assert.EqualValuesf(ur.T(), 0, user["pinned_count"], "Should have set the pinned count to %v but got %v", 0, user["pinned_count"])
+	assert.NoErrorf(ur.T(), err, "Should have not return error but got %s", err)
+}
@@ -1,12 +1,13 @@
 package usecase
 
 import (
+	"context"
 	"instagram-go/domain"
 	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
-	"sync"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -16,8 +17,7 @@ import (
 )
 
 type userUsecase struct {
-	userRepository domain.UserRepository
-	sync.Mutex
+	userRepository       domain.UserRepository
 	fileOsHelper         domain.IFileOsHelper
 	headerHelper         domain.IHeaderHelper
 	authenticationHelper domain.IAuthenticationHelper
@@ -32,11 +32,9 @@ func NewUserUsecase(userRepository domain.UserRepository, authenticationHelper d
 	}
 }
 
-func (uu *userUsecase) InsertUser(user *domain.User) error {
+func (uu *userUsecase) InsertUser(ctx context.Context, user *domain.User) error {
 	filter := bson.M{"username": user.Username}
-	uu.Lock()
-	findUserQueryResult, err := uu.userRepository.FindUser(filter)
-	uu.Unlock()
+	findUserQueryResult, err := uu.userRepository.FindUser(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
@@ -50,16 +48,17 @@ func (uu *userUsecase) InsertUser(user *domain.User) error {
 	}
 	user.Password = string(hashedPassword[:])
 
-	uu.Lock()
-	err = uu.userRepository.InsertUser(user)
-	uu.Unlock()
+	err = uu.userRepository.InsertUser(ctx, user)
+	if err == domain.ErrUsernameConflict {
+		return err
+	}
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	return nil
 }
 
-func (uu *userUsecase) UpdateUser(user *domain.User, tokenString string, profilePictureFile multipart.File) error {
+func (uu *userUsecase) UpdateUser(ctx context.Context, user *domain.User, tokenString string, profilePictureFile multipart.File) error {
 	var updatedUser *domain.User
 	newpath := filepath.Join(".", "profile_pictures")
 	err := uu.fileOsHelper.MkDirAll(newpath, os.ModePerm)
@@ -72,9 +71,7 @@ func (uu *userUsecase) UpdateUser(user *domain.User, tokenString string, profile
 	}
 	filter := bson.M{"_id": user.Id}
 
-	uu.Lock()
-	findUserQueryResult, err := uu.userRepository.FindUser(filter)
-	uu.Unlock()
+	findUserQueryResult, err := uu.userRepository.FindUser(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
@@ -123,9 +120,7 @@ func (uu *userUsecase) UpdateUser(user *domain.User, tokenString string, profile
 	}
 
 	filter = bson.M{"_id": user.Id}
-	uu.Lock()
-	oldUser, err := uu.userRepository.FindOneUser(filter)
-	uu.Unlock()
+	oldUser, err := uu.userRepository.FindOneUser(ctx, filter)
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
@@ -152,20 +147,19 @@ func (uu *userUsecase) UpdateUser(user *domain.User, tokenString string, profile
 		updatedUser.ProfilePictures = oldUser.ProfilePictures
 	}
 
-	uu.Lock()
-	err = uu.userRepository.UpdateUser(updatedUser)
-	uu.Unlock()
+	err = uu.userRepository.UpdateUser(ctx, updatedUser)
+	if err == domain.ErrUsernameConflict {
+		return err
+	}
 	if err != nil {
 		return domain.ErrInternalServerError
 	}
 	return nil
 }
 
-func (uu *userUsecase) VerifyCredential(username string, password string) (string, error) {
+func (uu *userUsecase) VerifyCredential(ctx context.Context, username string, password string) (string, error) {
 	filter := bson.M{"username": username}
-	uu.Lock()
-	findUserQueryResult, err := uu.userRepository.FindUser(filter)
-	uu.Unlock()
+	findUserQueryResult, err := uu.userRepository.FindUser(ctx, filter)
 	if err != nil {
 		return "", domain.ErrInternalServerError
 	}
@@ -173,9 +167,7 @@ func (uu *userUsecase) VerifyCredential(username string, password string) (strin
 		return "", domain.ErrUserNotFound
 	}
 
-	uu.Lock()
-	user, err := uu.userRepository.FindOneUser(filter)
-	uu.Unlock()
+	user, err := uu.userRepository.FindOneUser(ctx, filter)
 	if err != nil {
 		return "", domain.ErrInternalServerError
 	}
@@ -196,3 +188,69 @@ func (uu *userUsecase) VerifyCredential(username string, password string) (strin
 	}
 	return token, nil
 }
+
+func (uu *userUsecase) FindCommentFilter(ctx context.Context, userId string, tokenString string) (*domain.CommentFilter, error) {
+	user, err := uu.findCommentFilterOwner(ctx, userId, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if user.CommentFilter == nil {
+		return domain.DefaultCommentFilter(), nil
+	}
+	if user.CommentFilter.BlockedWords == nil {
+		user.CommentFilter.BlockedWords = []string{}
+	}
+	return user.CommentFilter, nil
+}
+
+func (uu *userUsecase) UpdateCommentFilter(ctx context.Context, userId string, commentFilter *domain.CommentFilter, tokenString string) error {
+	if commentFilter.Action != domain.CommentFilterActionHide && commentFilter.Action != domain.CommentFilterActionReject {
+		return domain.ErrInvalidCommentFilter
+	}
+	_, err := uu.findCommentFilterOwner(ctx, userId, tokenString)
+	if err != nil {
+		return err
+	}
+
+	blockedWords := []string{}
+	seen := map[string]bool{}
+	for _, blockedWord := range commentFilter.BlockedWords {
+		blockedWord = strings.TrimSpace(blockedWord)
+		if blockedWord == "" || seen[strings.ToLower(blockedWord)] {
+			continue
+		}
+		seen[strings.ToLower(blockedWord)] = true
+		blockedWords = append(blockedWords, blockedWord)
+	}
+	commentFilter.BlockedWords = blockedWords
+
+	err = uu.userRepository.UpdateUserCommentFilter(ctx, userId, commentFilter)
+	if err != nil {
+		return domain.ErrInternalServerError
+	}
+	return nil
+}
+
+func (uu *userUsecase) findCommentFilterOwner(ctx context.Context, userId string, tokenString string) (*domain.User, error) {
+	userIdToken, err := uu.headerHelper.GetUserIdFromToken(tokenString)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	filter := bson.M{"_id": userId}
+	queryResult, err := uu.userRepository.FindUser(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	if len(*queryResult) == 0 {
+		return nil, domain.ErrUserNotFound
+	}
+	if userIdToken != userId {
+		return nil, domain.ErrUnauthorizedCommentFilter
+	}
+
+	user, err := uu.userRepository.FindOneUser(ctx, filter)
+	if err != nil {
+		return nil, domain.ErrInternalServerError
+	}
+	return user, nil
+}
@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"context"
 	"errors"
 	"instagram-go/domain"
 	"instagram-go/domain/mocks"
@@ -35,10 +36,10 @@ func (us *UserUsecaseSuite) SetupTest() {
 
 func (us *UserUsecaseSuite) TestInsertUserFindUserError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(nil, errors.New("FindUser return error"))
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindUser return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.InsertUser(mockUser)
+	result := userUsecase.InsertUser(context.TODO(), mockUser)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -46,7 +47,7 @@ func (us *UserUsecaseSuite) TestInsertUserFindUserError() {
 
 func (us *UserUsecaseSuite) TestInsertUserUserNameConflict() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{{
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{{
 		"_id":                  "user1",
 		"username":             "username1",
 		"fullname":             "fullname1",
@@ -56,7 +57,7 @@ func (us *UserUsecaseSuite) TestInsertUserUserNameConflict() {
 	}}, nil)
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.InsertUser(mockUser)
+	result := userUsecase.InsertUser(context.TODO(), mockUser)
 
 	expectedError := domain.ErrUsernameConflict.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "should have return %s but got %s", expectedError, result.Error())
@@ -64,23 +65,35 @@ func (us *UserUsecaseSuite) TestInsertUserUserNameConflict() {
 
 func (us *UserUsecaseSuite) TestInsertUserError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
-	us.mockUserRepo.On("InsertUser", mock.AnythingOfType("*domain.User")).Return(errors.New("InsertUser return error"))
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	us.mockUserRepo.On("InsertUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(errors.New("InsertUser return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.InsertUser(mockUser)
+	result := userUsecase.InsertUser(context.TODO(), mockUser)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "should have return %s but got %s", expectedError, result.Error())
 }
 
+func (us *UserUsecaseSuite) TestInsertUserInsertUserUsernameConflict() {
+	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	us.mockUserRepo.On("InsertUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(domain.ErrUsernameConflict)
+
+	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
+	result := userUsecase.InsertUser(context.TODO(), mockUser)
+
+	expectedError := domain.ErrUsernameConflict.Error()
+	assert.EqualErrorf(us.T(), result, expectedError, "should have return %s but got %s", expectedError, result.Error())
+}
+
 func (us *UserUsecaseSuite) TestInsertUserSuccessful() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
-	us.mockUserRepo.On("InsertUser", mock.AnythingOfType("*domain.User")).Return(nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	us.mockUserRepo.On("InsertUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil)
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.InsertUser(mockUser)
+	result := userUsecase.InsertUser(context.TODO(), mockUser)
 
 	assert.NoErrorf(us.T(), result, "should have not returned error but got %s", result)
 }
@@ -90,7 +103,7 @@ func (us *UserUsecaseSuite) TestUpdateUserMkDirAllError() {
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(errors.New("MkDirAll return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "test", nil)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "test", nil)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -101,7 +114,7 @@ func (us *UserUsecaseSuite) TestUpdateUserGetUserTokenError() {
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("user1id", errors.New("GetUserIdFromToken return error"))
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "test", nil)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "test", nil)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -111,10 +124,10 @@ func (us *UserUsecaseSuite) TestUpdateUserFindUserError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("user1id", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(nil, errors.New("FindUser return error"))
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindUser return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "test", nil)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "test", nil)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -124,10 +137,10 @@ func (us *UserUsecaseSuite) TestUpdateUserUserNotFoundError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("user1id", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "test", nil)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "test", nil)
 
 	expectedError := domain.ErrUserNotFound.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -137,7 +150,7 @@ func (us *UserUsecaseSuite) TestUpdateUserUnauthorizedUserUpdateError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid3", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
 			"_id":              "userid1",
 			"username":         "user1",
@@ -149,7 +162,7 @@ func (us *UserUsecaseSuite) TestUpdateUserUnauthorizedUserUpdateError() {
 	}, nil)
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "test", nil)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "test", nil)
 
 	expectedError := domain.ErrUnauthorizedUserUpdate.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -159,7 +172,7 @@ func (us *UserUsecaseSuite) TestUpdateUserResizeAndSaveFileToLocaleError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
 			"_id":              "userid1",
 			"username":         "user1",
@@ -174,7 +187,7 @@ func (us *UserUsecaseSuite) TestUpdateUserResizeAndSaveFileToLocaleError() {
 	us.mockFileOsHelper.On("ResizeAndSaveFileToLocale", mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("jpg.jpg", errors.New("ResizeAndSaveFIleToLocale return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "token", file)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "token", file)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -184,7 +197,7 @@ func (us *UserUsecaseSuite) TestUpdateUserDecodeImageError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
 			"_id":              "userid1",
 			"username":         "user1",
@@ -198,7 +211,7 @@ func (us *UserUsecaseSuite) TestUpdateUserDecodeImageError() {
 	us.mockFileOsHelper.On("DecodeImage", file).Return(nil, "jpg.jpg", errors.New("DecodeImage return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "token", file)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "token", file)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -208,7 +221,7 @@ func (us *UserUsecaseSuite) TestUpdateUserFindOneUserError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
 			"_id":              "userid1",
 			"username":         "username1",
@@ -218,10 +231,10 @@ func (us *UserUsecaseSuite) TestUpdateUserFindOneUserError() {
 			"profile_pictures": nil,
 		},
 	}, nil)
-	us.mockUserRepo.On("FindOneUser", mock.AnythingOfType("M")).Return(nil, errors.New("FindOneUser return error"))
+	us.mockUserRepo.On("FindOneUser", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindOneUser return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "token", nil)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "token", nil)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -231,7 +244,7 @@ func (us *UserUsecaseSuite) TestUpdateUserError() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
 			"_id":              "userid1",
 			"username":         "username1",
@@ -242,11 +255,11 @@ func (us *UserUsecaseSuite) TestUpdateUserError() {
 		},
 	}, nil)
 	foundUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
-	us.mockUserRepo.On("FindOneUser", mock.AnythingOfType("M")).Return(foundUser, nil)
-	us.mockUserRepo.On("UpdateUser", mock.AnythingOfType("*domain.User")).Return(errors.New("UpdateUser return error"))
+	us.mockUserRepo.On("FindOneUser", mock.Anything, mock.AnythingOfType("M")).Return(foundUser, nil)
+	us.mockUserRepo.On("UpdateUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(errors.New("UpdateUser return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "token", nil)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "token", nil)
 
 	expectedError := domain.ErrInternalServerError.Error()
 	assert.EqualErrorf(us.T(), result, expectedError, "Should have return %s but got %s", expectedError, result.Error())
@@ -256,7 +269,7 @@ func (us *UserUsecaseSuite) TestUpdateUserSuccessful() {
 	mockUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
 	us.mockFileOsHelper.On("MkDirAll", mock.AnythingOfType("string"), mock.AnythingOfType("fs.FileMode")).Return(nil)
 	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{
 		{
 			"_id":              "userid1",
 			"username":         "username1",
@@ -267,30 +280,30 @@ func (us *UserUsecaseSuite) TestUpdateUserSuccessful() {
 		},
 	}, nil)
 	foundUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
-	us.mockUserRepo.On("FindOneUser", mock.AnythingOfType("M")).Return(foundUser, nil)
-	us.mockUserRepo.On("UpdateUser", mock.AnythingOfType("*domain.User")).Return(nil)
+	us.mockUserRepo.On("FindOneUser", mock.Anything, mock.AnythingOfType("M")).Return(foundUser, nil)
+	us.mockUserRepo.On("UpdateUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil)
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	result := userUsecase.UpdateUser(mockUser, "token", nil)
+	result := userUsecase.UpdateUser(context.TODO(), mockUser, "token", nil)
 
 	assert.NoErrorf(us.T(), result, "should have not returned error but got %s", result)
 }
 
 func (us *UserUsecaseSuite) TestVerfiyCredentialFindUserError() {
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(nil, errors.New("FindUser return error"))
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindUser return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	_, err := userUsecase.VerifyCredential("username1", "password1")
+	_, err := userUsecase.VerifyCredential(context.TODO(), "username1", "password1")
 	expectedError := domain.ErrInternalServerError.Error()
 
 	assert.EqualErrorf(us.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
 }
 
 func (us *UserUsecaseSuite) TestVerifyCredentialUserNotFoundError() {
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&[]bson.M{}, nil)
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	_, err := userUsecase.VerifyCredential("username1", "password1")
+	_, err := userUsecase.VerifyCredential(context.TODO(), "username1", "password1")
 	expectedError := domain.ErrUserNotFound.Error()
 
 	assert.EqualErrorf(us.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -300,11 +313,11 @@ func (us *UserUsecaseSuite) TestVerifyCredentialFindOneUserError() {
 	foundUsers := []bson.M{
 		{"_id": "userid1", "username": "username1", "full_name": "fullname1", "password": "password1", "email": "email1@gmail.com", "profile_pictures": nil},
 	}
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&foundUsers, nil)
-	us.mockUserRepo.On("FindOneUser", mock.AnythingOfType("M")).Return(nil, errors.New("FindOneUser return error"))
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&foundUsers, nil)
+	us.mockUserRepo.On("FindOneUser", mock.Anything, mock.AnythingOfType("M")).Return(nil, errors.New("FindOneUser return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	_, err := userUsecase.VerifyCredential("username1", "password1")
+	_, err := userUsecase.VerifyCredential(context.TODO(), "username1", "password1")
 	expectedError := domain.ErrInternalServerError.Error()
 
 	assert.EqualErrorf(us.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -315,12 +328,12 @@ func (us *UserUsecaseSuite) TestVerifyCredentialCompareHashAndPasswordError() {
 		{"_id": "userid1", "username": "username1", "full_name": "fullname1", "password": "password1", "email": "email1@gmail.com", "profile_pictures": nil},
 	}
 	foundUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&foundUsers, nil)
-	us.mockUserRepo.On("FindOneUser", mock.AnythingOfType("M")).Return(foundUser, nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&foundUsers, nil)
+	us.mockUserRepo.On("FindOneUser", mock.Anything, mock.AnythingOfType("M")).Return(foundUser, nil)
 	us.mockAuthenticationHelper.On("CompareHashAndPassword", mock.AnythingOfType("[]uint8"), mock.AnythingOfType("[]uint8")).Return(errors.New("CompareHashAndPassword return error"))
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	_, err := userUsecase.VerifyCredential("username1", "password1")
+	_, err := userUsecase.VerifyCredential(context.TODO(), "username1", "password1")
 	expectedError := domain.ErrPasswordWrong.Error()
 
 	assert.EqualErrorf(us.T(), err, expectedError, "Should have return %s but got %s", expectedError, err.Error())
@@ -331,11 +344,80 @@ func (us *UserUsecaseSuite) TestVerifyCredentialSuccessful() {
 		{"_id": "userid1", "username": "username1", "full_name": "fullname1", "password": "password1", "email": "email1@gmail.com", "profile_pictures": nil},
 	}
 	foundUser := domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil)
-	us.mockUserRepo.On("FindUser", mock.AnythingOfType("M")).Return(&foundUsers, nil)
-	us.mockUserRepo.On("FindOneUser", mock.AnythingOfType("M")).Return(foundUser, nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, mock.AnythingOfType("M")).Return(&foundUsers, nil)
+	us.mockUserRepo.On("FindOneUser", mock.Anything, mock.AnythingOfType("M")).Return(foundUser, nil)
 	us.mockAuthenticationHelper.On("CompareHashAndPassword", mock.AnythingOfType("[]uint8"), mock.AnythingOfType("[]uint8")).Return(nil)
 
 	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
-	_, err := userUsecase.VerifyCredential("username1", "password1")
+	_, err := userUsecase.VerifyCredential(context.TODO(), "username1", "password1")
+	assert.NoErrorf(us.T(), err, "should have not returned error but got %s", err)
+}
+
+func (us *UserUsecaseSuite) TestFindCommentFilterUnauthorizedCommentFilter() {
+	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid2", nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, bson.M{"_id": "userid1"}).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+
+	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
+	_, err := userUsecase.FindCommentFilter(context.TODO(), "userid1", "token1")
+
+	expectedError := domain.ErrUnauthorizedCommentFilter.Error()
+	assert.EqualErrorf(us.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (us *UserUsecaseSuite) TestFindCommentFilterDefaultSuccessful() {
+	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, bson.M{"_id": "userid1"}).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+	us.mockUserRepo.On("FindOneUser", mock.Anything, bson.M{"_id": "userid1"}).Return(domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil), nil)
+
+	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
+	commentFilter, err := userUsecase.FindCommentFilter(context.TODO(), "userid1", "token1")
+
+	assert.NoErrorf(us.T(), err, "should have not returned error but got %s", err)
+	assert.Equalf(us.T(), domain.DefaultCommentFilter(), commentFilter, "Should have return %v but got %v", domain.DefaultCommentFilter(), commentFilter)
+}
+
+func (us *UserUsecaseSuite) TestUpdateCommentFilterInvalidCommentFilter() {
+	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
+	err := userUsecase.UpdateCommentFilter(context.TODO(), "userid1", domain.NewCommentFilter(nil, true, "delete"), "token1")
+
+	expectedError := domain.ErrInvalidCommentFilter.Error()
+	assert.EqualErrorf(us.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (us *UserUsecaseSuite) TestUpdateCommentFilterUserNotFound() {
+	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, bson.M{"_id": "userid1"}).Return(&[]bson.M{}, nil)
+
+	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
+	err := userUsecase.UpdateCommentFilter(context.TODO(), "userid1", domain.DefaultCommentFilter(), "token1")
+
+	expectedError := domain.ErrUserNotFound.Error()
+	assert.EqualErrorf(us.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (us *UserUsecaseSuite) TestUpdateCommentFilterError() {
+	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, bson.M{"_id": "userid1"}).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+	us.mockUserRepo.On("FindOneUser", mock.Anything, bson.M{"_id": "userid1"}).Return(domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil), nil)
+	us.mockUserRepo.On("UpdateUserCommentFilter", mock.Anything, "userid1", mock.AnythingOfType("*domain.CommentFilter")).Return(errors.New("UpdateUserCommentFilter return error"))
+
+	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
+	err := userUsecase.UpdateCommentFilter(context.TODO(), "userid1", domain.DefaultCommentFilter(), "token1")
+
+	expectedError := domain.ErrInternalServerError.Error()
+	assert.EqualErrorf(us.T(), err, expectedError, "Should have return %s but got %s", expectedError, err)
+}
+
+func (us *UserUsecaseSuite) TestUpdateCommentFilterSuccessful() {
+	us.mockHeaderHelper.On("GetUserIdFromToken", mock.AnythingOfType("string")).Return("userid1", nil)
+	us.mockUserRepo.On("FindUser", mock.Anything, bson.M{"_id": "userid1"}).Return(&[]bson.M{{"_id": "userid1"}}, nil)
+	us.mockUserRepo.On("FindOneUser", mock.Anything, bson.M{"_id": "userid1"}).Return(domain.NewUser("userid1", "username1", "fullname1", "password1", "email1@gmail.com", nil), nil)
+	expectedCommentFilter := domain.NewCommentFilter([]string{"spoiler", "ending"}, false, domain.CommentFilterActionReject)
+	us.mockUserRepo.On("UpdateUserCommentFilter", mock.Anything, "userid1", expectedCommentFilter).Return(nil)
+
+	userUsecase := usecase.NewUserUsecase(us.mockUserRepo, us.mockAuthenticationHelper, us.mockHeaderHelper, us.mockFileOsHelper)
+	err := userUsecase.UpdateCommentFilter(context.TODO(), "userid1", domain.NewCommentFilter([]string{" spoiler ", "", "ending", "Spoiler"}, false, domain.CommentFilterActionReject), "token1")
+
 	assert.NoErrorf(us.T(), err, "should have not returned error but got %s", err)
+	us.mockUserRepo.AssertCalled(us.T(), "UpdateUserCommentFilter", mock.Anything, "userid1", expectedCommentFilter)
 }